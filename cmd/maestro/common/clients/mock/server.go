@@ -28,6 +28,16 @@ func NewMaestroServer() *Server {
 		// Resource Bundle endpoints
 		case method == "GET" && path == "/api/maestro/v1/resource-bundles":
 			handleListResourceBundles(w, r)
+		case method == "GET" && strings.HasSuffix(path, "/revisions"):
+			handleListResourceBundleRevisions(w, r)
+		case method == "POST" && strings.HasSuffix(path, "/rollback"):
+			handleRollbackResourceBundle(w, r)
+		case method == "POST" && path == "/api/maestro/v1/resource-bundles" && r.URL.Query().Get("dryRun") == "true":
+			handleDryRunCreateResourceBundle(w, r)
+		case method == "PATCH" && strings.HasPrefix(path, "/api/maestro/v1/resource-bundles/") && r.URL.Query().Get("dryRun") == "true":
+			handleDryRunUpdateResourceBundle(w, r)
+		case method == "GET" && strings.HasPrefix(path, "/api/maestro/v1/resource-bundles/") && r.URL.Query().Get("waitFor") != "":
+			handleWaitResourceBundle(w, r)
 		case method == "GET" && strings.HasPrefix(path, "/api/maestro/v1/resource-bundles/"):
 			handleGetResourceBundle(w, r)
 		case method == "DELETE" && strings.HasPrefix(path, "/api/maestro/v1/resource-bundles/"):
@@ -131,6 +141,45 @@ func handleGetResourceBundle(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func handleWaitResourceBundle(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/resource-bundles/")
+	timeout, err := time.ParseDuration(r.URL.Query().Get("timeout"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	bundle := openapi.ResourceBundle{
+		Id:           openapi.PtrString(id),
+		Name:         openapi.PtrString("test-bundle-1"),
+		ConsumerName: openapi.PtrString("test-consumer"),
+		Version:      openapi.PtrInt32(1),
+	}
+
+	switch id {
+	case "bundle-1":
+		bundle.Rollout = &openapi.ResourceBundleRollout{
+			Phase:           openapi.PtrString("Available"),
+			ObservedVersion: openapi.PtrInt32(1),
+			UpToDate:        openapi.PtrBool(true),
+		}
+		json.NewEncoder(w).Encode(bundle)
+	case "pending":
+		// the rollout phase is never reached, so the wait times out
+		time.Sleep(timeout)
+		bundle.Rollout = &openapi.ResourceBundleRollout{
+			Phase:           openapi.PtrString("Pending"),
+			ObservedVersion: openapi.PtrInt32(0),
+			UpToDate:        openapi.PtrBool(false),
+		}
+		json.NewEncoder(w).Encode(bundle)
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
 func handleDeleteResourceBundle(w http.ResponseWriter, r *http.Request) {
 	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/resource-bundles/")
 
@@ -148,6 +197,171 @@ func handleDeleteResourceBundle(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func handleListResourceBundleRevisions(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/resource-bundles/"), "/revisions")
+
+	switch id {
+	case "bundle-1":
+		now := time.Now()
+		list := openapi.ResourceBundleRevisionList{
+			Kind: "ResourceBundleRevisionList",
+			Items: []openapi.ResourceBundleRevision{
+				{
+					Id:               openapi.PtrString("revision-2"),
+					ResourceBundleId: openapi.PtrString("bundle-1"),
+					Version:          openapi.PtrInt32(2),
+					Source:           openapi.PtrString("maestro"),
+					Author:           openapi.PtrString("alice"),
+					CreatedAt:        &now,
+				},
+				{
+					Id:               openapi.PtrString("revision-1"),
+					ResourceBundleId: openapi.PtrString("bundle-1"),
+					Version:          openapi.PtrInt32(1),
+					Source:           openapi.PtrString("maestro"),
+					Author:           openapi.PtrString("bob"),
+					CreatedAt:        &now,
+				},
+			},
+			Page:  1,
+			Size:  2,
+			Total: 2,
+		}
+		json.NewEncoder(w).Encode(list)
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func handleRollbackResourceBundle(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/resource-bundles/"), "/rollback")
+
+	var rollback openapi.ResourceBundleRollbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&rollback); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch id {
+	case "bundle-1":
+		if rollback.Version > 2 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		now := time.Now()
+		bundle := openapi.ResourceBundle{
+			Id:           openapi.PtrString("bundle-1"),
+			Name:         openapi.PtrString("test-bundle-1"),
+			ConsumerName: openapi.PtrString("test-consumer"),
+			Version:      openapi.PtrInt32(3),
+			CreatedAt:    &now,
+			UpdatedAt:    &now,
+		}
+		json.NewEncoder(w).Encode(bundle)
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	case "conflict":
+		w.WriteHeader(http.StatusConflict)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func handleDryRunCreateResourceBundle(w http.ResponseWriter, r *http.Request) {
+	var bundle openapi.ResourceBundle
+	if err := json.NewDecoder(r.Body).Decode(&bundle); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch bundle.GetConsumerName() {
+	case "test-consumer":
+		diff := openapi.ResourceBundleDiff{
+			Kind:           openapi.PtrString("ResourceBundleDiff"),
+			ConsumerName:   openapi.PtrString("test-consumer"),
+			Action:         openapi.PtrString("create"),
+			CurrentVersion: openapi.PtrInt32(0),
+			Changed:        openapi.PtrBool(true),
+		}
+		for range bundle.Manifests {
+			diff.Manifests = append(diff.Manifests, openapi.ResourceBundleManifestDiff{
+				Change:     openapi.PtrString("added"),
+				ApiVersion: openapi.PtrString("v1"),
+				Kind:       openapi.PtrString("ConfigMap"),
+				Namespace:  openapi.PtrString("default"),
+				Name:       openapi.PtrString("test"),
+			})
+		}
+		json.NewEncoder(w).Encode(diff)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+func handleDryRunUpdateResourceBundle(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/resource-bundles/")
+
+	switch id {
+	case "bundle-1":
+		diff := openapi.ResourceBundleDiff{
+			Kind:           openapi.PtrString("ResourceBundleDiff"),
+			Id:             openapi.PtrString("bundle-1"),
+			ConsumerName:   openapi.PtrString("test-consumer"),
+			Action:         openapi.PtrString("update"),
+			CurrentVersion: openapi.PtrInt32(1),
+			Changed:        openapi.PtrBool(true),
+			Manifests: []openapi.ResourceBundleManifestDiff{
+				{
+					Change:     openapi.PtrString("changed"),
+					ApiVersion: openapi.PtrString("v1"),
+					Kind:       openapi.PtrString("ConfigMap"),
+					Namespace:  openapi.PtrString("default"),
+					Name:       openapi.PtrString("test"),
+					Fields: []openapi.ResourceBundleFieldDiff{
+						{Path: openapi.PtrString("data.key"), OldValue: openapi.PtrString(`"old"`), NewValue: openapi.PtrString(`"new"`)},
+					},
+				},
+			},
+		}
+		json.NewEncoder(w).Encode(diff)
+	case "invalid":
+		diff := openapi.ResourceBundleDiff{
+			Kind:             openapi.PtrString("ResourceBundleDiff"),
+			Id:               openapi.PtrString("invalid"),
+			ConsumerName:     openapi.PtrString("test-consumer"),
+			Action:           openapi.PtrString("update"),
+			CurrentVersion:   openapi.PtrInt32(1),
+			Changed:          openapi.PtrBool(false),
+			ValidationErrors: []string{"manifests[0]: kind: Required value: field not set"},
+		}
+		json.NewEncoder(w).Encode(diff)
+	case "not-found":
+		w.WriteHeader(http.StatusNotFound)
+	case "conflict":
+		w.WriteHeader(http.StatusConflict)
+	case "unauthorized":
+		w.WriteHeader(http.StatusUnauthorized)
+	case "forbidden":
+		w.WriteHeader(http.StatusForbidden)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
 func handleListConsumers(w http.ResponseWriter, r *http.Request) {
 	page := r.URL.Query().Get("page")
 	size := r.URL.Query().Get("size")
@@ -275,6 +489,18 @@ func handleUpdateConsumer(w http.ResponseWriter, r *http.Request) {
 func handleDeleteConsumer(w http.ResponseWriter, r *http.Request) {
 	id := strings.TrimPrefix(r.URL.Path, "/api/maestro/v1/consumers/")
 
+	switch r.URL.Query().Get("deletionPolicy") {
+	case "", "Restrict", "Orphan":
+	case "Cascade":
+		if id == "consumer-1" {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	switch id {
 	case "consumer-1":
 		w.WriteHeader(http.StatusNoContent)
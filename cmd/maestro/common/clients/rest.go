@@ -3,8 +3,10 @@ package clients
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
@@ -111,6 +113,38 @@ func (c *RESTClient) GetResourceBundle(ctx context.Context, id string) (*openapi
 	}
 }
 
+// WaitResourceBundle waits until the resource bundle reaches the rollout phase or the timeout expires on the server,
+// and returns the resource bundle, the caller should check the rollout phase of the returned resource bundle
+func (c *RESTClient) WaitResourceBundle(ctx context.Context, id, phase string, timeout time.Duration) (*openapi.ResourceBundle, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, id).
+		WaitFor(phase).
+		Timeout(timeout.String()).
+		Execute()
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode resource bundle response: %w", err)
+		}
+		return result, nil
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("bad request, err=%w", err)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("resource bundle not found")
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
 // DeleteResourceBundle deletes a resource bundle by ID
 func (c *RESTClient) DeleteResourceBundle(ctx context.Context, id string) error {
 	resp, err := c.client.DefaultAPI.ApiMaestroV1ResourceBundlesIdDelete(ctx, id).Execute()
@@ -134,6 +168,113 @@ func (c *RESTClient) DeleteResourceBundle(ctx context.Context, id string) error
 	}
 }
 
+// ListResourceBundleRevisions lists the revisions of a resource bundle, the latest revision first
+func (c *RESTClient) ListResourceBundleRevisions(ctx context.Context, id string) (*openapi.ResourceBundleRevisionList, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRevisionsGet(ctx, id).Execute()
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode resource bundle revision list response: %w", err)
+		}
+		return result, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("resource bundle not found")
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
+// RollbackResourceBundle rolls back a resource bundle to the revision of the given version
+func (c *RESTClient) RollbackResourceBundle(ctx context.Context, id string, version int32) (*openapi.ResourceBundle, error) {
+	result, resp, err := c.client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRollbackPost(ctx, id).
+		ResourceBundleRollbackRequest(*openapi.NewResourceBundleRollbackRequest(version)).
+		Execute()
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode resource bundle response: %w", err)
+		}
+		return result, nil
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("bad request")
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("resource bundle or revision not found")
+	case http.StatusConflict:
+		return nil, fmt.Errorf("conflict - resource bundle is being deleted")
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
+// DryRunCreateResourceBundle returns the changes that creating the resource bundle would apply without creating it
+func (c *RESTClient) DryRunCreateResourceBundle(ctx context.Context, bundle openapi.ResourceBundle) (*openapi.ResourceBundleDiff, error) {
+	_, resp, err := c.client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).
+		ResourceBundle(bundle).
+		DryRun(true).
+		Execute()
+	return decodeResourceBundleDiff(resp, err)
+}
+
+// DryRunUpdateResourceBundle returns the changes that updating the resource bundle would apply without updating it
+func (c *RESTClient) DryRunUpdateResourceBundle(ctx context.Context, id string, patch openapi.ResourceBundlePatchRequest) (*openapi.ResourceBundleDiff, error) {
+	_, resp, err := c.client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, id).
+		ResourceBundlePatchRequest(patch).
+		DryRun(true).
+		Execute()
+	return decodeResourceBundleDiff(resp, err)
+}
+
+// decodeResourceBundleDiff decodes the ResourceBundleDiff of a dry run response, the generated client decodes the
+// response as a resource bundle, so the diff is decoded from the response body again.
+func decodeResourceBundleDiff(resp *http.Response, err error) (*openapi.ResourceBundleDiff, error) {
+	if resp == nil {
+		return nil, fmt.Errorf("no HTTP response received, err=%w", err)
+	}
+
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		diff := &openapi.ResourceBundleDiff{}
+		if err := json.NewDecoder(resp.Body).Decode(diff); err != nil {
+			return nil, fmt.Errorf("failed to decode resource bundle diff response: %w", err)
+		}
+		return diff, nil
+	case http.StatusBadRequest:
+		return nil, fmt.Errorf("bad request, err=%w", err)
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("resource bundle not found")
+	case http.StatusConflict:
+		return nil, fmt.Errorf("conflict - resource bundle version is not the latest or resource bundle is being deleted")
+	case http.StatusUnauthorized:
+		return nil, fmt.Errorf("authentication failed")
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("permission denied")
+	default:
+		return nil, fmt.Errorf("unexpected status code %d, err=%w", resp.StatusCode, err)
+	}
+}
+
 // ListConsumers lists consumers with pagination and filtering
 func (c *RESTClient) ListConsumers(ctx context.Context, page, size int, search string) (*openapi.ConsumerList, error) {
 	req := c.client.DefaultAPI.ApiMaestroV1ConsumersGet(ctx).
@@ -251,9 +392,15 @@ func (c *RESTClient) UpdateConsumer(ctx context.Context, id string, consumer ope
 	}
 }
 
-// DeleteConsumer deletes a consumer by ID
-func (c *RESTClient) DeleteConsumer(ctx context.Context, id string) error {
-	resp, err := c.client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, id).Execute()
+// DeleteConsumer deletes a consumer by ID with the given deletion policy, the server default policy is used
+// if the deletion policy is empty
+func (c *RESTClient) DeleteConsumer(ctx context.Context, id, deletionPolicy string) error {
+	req := c.client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, id)
+	if deletionPolicy != "" {
+		req = req.DeletionPolicy(deletionPolicy)
+	}
+
+	resp, err := req.Execute()
 	if resp == nil {
 		return fmt.Errorf("no HTTP response received, err=%w", err)
 	}
@@ -261,7 +408,7 @@ func (c *RESTClient) DeleteConsumer(ctx context.Context, id string) error {
 	defer resp.Body.Close()
 
 	switch resp.StatusCode {
-	case http.StatusNoContent:
+	case http.StatusNoContent, http.StatusAccepted:
 		return nil
 	case http.StatusNotFound:
 		return fmt.Errorf("consumer not found")
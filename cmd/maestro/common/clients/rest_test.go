@@ -536,16 +536,30 @@ func TestDeleteConsumer(t *testing.T) {
 	}
 
 	tests := []struct {
-		name        string
-		id          string
-		wantErr     bool
-		errContains string
+		name           string
+		id             string
+		deletionPolicy string
+		wantErr        bool
+		errContains    string
 	}{
 		{
 			name:    "delete existing consumer",
 			id:      "consumer-1",
 			wantErr: false,
 		},
+		{
+			name:           "cascade delete existing consumer",
+			id:             "consumer-1",
+			deletionPolicy: "Cascade",
+			wantErr:        false,
+		},
+		{
+			name:           "delete with invalid deletion policy",
+			id:             "consumer-1",
+			deletionPolicy: "Unknown",
+			wantErr:        true,
+			errContains:    "unexpected status code 400",
+		},
 		{
 			name:        "delete non-existent consumer",
 			id:          "not-found",
@@ -575,7 +589,7 @@ func TestDeleteConsumer(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
-			err := client.DeleteConsumer(ctx, tt.id)
+			err := client.DeleteConsumer(ctx, tt.id, tt.deletionPolicy)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DeleteConsumer() error = %v, wantErr %v", err, tt.wantErr)
@@ -76,10 +76,143 @@ func PrintResourceBundle(w io.Writer, bundle *openapi.ResourceBundle) (err error
 	fmt.Fprintf(printer.writer, "Created\t%s\n", formatTime(bundle.CreatedAt))
 	fmt.Fprintf(printer.writer, "Updated\t%s\n", formatTime(bundle.UpdatedAt))
 	fmt.Fprintf(printer.writer, "Status\t%s\n", getStatusFromMap(bundle.Status))
+	if bundle.Rollout != nil {
+		fmt.Fprintf(printer.writer, "Rollout\t%s\n", getStringPtr(bundle.Rollout.Phase))
+	}
+
+	return nil
+}
+
+// PrintResourceBundleRollout prints the rollout of a resource bundle and the rollout of each manifest as a table
+func PrintResourceBundleRollout(w io.Writer, bundle *openapi.ResourceBundle) (err error) {
+	if bundle == nil {
+		return fmt.Errorf("resource bundle is required")
+	}
+
+	printer := NewTablePrinter(w)
+	defer func() {
+		if flushErr := printer.Flush(); err == nil && flushErr != nil {
+			err = flushErr
+		}
+	}()
+
+	rollout := bundle.Rollout
+	if rollout == nil {
+		rollout = &openapi.ResourceBundleRollout{}
+	}
+
+	fmt.Fprintln(printer.writer, "FIELD\tVALUE")
+	fmt.Fprintf(printer.writer, "ID\t%s\n", getStringPtr(bundle.Id))
+	fmt.Fprintf(printer.writer, "Version\t%d\n", getInt32Ptr(bundle.Version))
+	fmt.Fprintf(printer.writer, "ObservedVersion\t%d\n", getInt32Ptr(rollout.ObservedVersion))
+	fmt.Fprintf(printer.writer, "Phase\t%s\n", getStringPtr(rollout.Phase))
+
+	if len(rollout.Manifests) > 0 {
+		fmt.Fprintln(printer.writer, "")
+		fmt.Fprintln(printer.writer, "MANIFEST\tPHASE\tAPPLIED\tAVAILABLE\tMESSAGE")
+		for _, manifest := range rollout.Manifests {
+			name := getStringPtr(manifest.Name)
+			if namespace := getStringPtr(manifest.Namespace); namespace != "" {
+				name = namespace + "/" + name
+			}
+			fmt.Fprintf(printer.writer, "%s %s\t%s\t%s\t%s\t%s\n", getStringPtr(manifest.Kind), name,
+				getStringPtr(manifest.Phase), getStringPtr(manifest.Applied), getStringPtr(manifest.Available),
+				getStringPtr(manifest.Message))
+		}
+	}
+
+	return nil
+}
+
+// PrintResourceBundleRevisionList prints the revisions of a resource bundle as a table
+func PrintResourceBundleRevisionList(w io.Writer, revisions []openapi.ResourceBundleRevision) (err error) {
+	printer := NewTablePrinter(w)
+	defer func() {
+		if flushErr := printer.Flush(); err == nil && flushErr != nil {
+			err = flushErr
+		}
+	}()
+
+	// Print header
+	fmt.Fprintln(printer.writer, "VERSION\tSOURCE\tAUTHOR\tCREATED\tMANIFESTS")
+
+	// Print rows
+	for _, revision := range revisions {
+		version := fmt.Sprintf("%d", getInt32Ptr(revision.Version))
+		source := getStringPtr(revision.Source)
+		author := getStringPtr(revision.Author)
+		created := formatTime(revision.CreatedAt)
+
+		fmt.Fprintf(printer.writer, "%s\t%s\t%s\t%s\t%d\n",
+			version, source, author, created, len(revision.Manifests))
+	}
+
+	return nil
+}
+
+// PrintResourceBundleDiff prints the changes of a resource bundle dry run, the added, removed and changed
+// manifests are prefixed with "+", "-" and "~", and the changed fields are listed under their manifest
+func PrintResourceBundleDiff(w io.Writer, diff *openapi.ResourceBundleDiff) error {
+	if diff == nil {
+		return fmt.Errorf("resource bundle diff is required")
+	}
+
+	id := getStringPtr(diff.Id)
+	if id == "" {
+		id = "<new>"
+	}
+	fmt.Fprintf(w, "Resource bundle %s on consumer %s (%s, current version %d)\n",
+		id, getStringPtr(diff.ConsumerName), getStringPtr(diff.Action), getInt32Ptr(diff.CurrentVersion))
+
+	for _, manifest := range diff.Manifests {
+		prefix := " "
+		switch getStringPtr(manifest.Change) {
+		case "added":
+			prefix = "+"
+		case "removed":
+			prefix = "-"
+		case "changed":
+			prefix = "~"
+		}
+		name := getStringPtr(manifest.Name)
+		if namespace := getStringPtr(manifest.Namespace); namespace != "" {
+			name = namespace + "/" + name
+		}
+		fmt.Fprintf(w, "%s %s %s %s\n", prefix, getStringPtr(manifest.ApiVersion), getStringPtr(manifest.Kind), name)
+		for _, field := range manifest.Fields {
+			fmt.Fprintf(w, "    %s\n", formatFieldDiff(field))
+		}
+	}
+	for _, field := range diff.Fields {
+		fmt.Fprintf(w, "~ %s\n", formatFieldDiff(field))
+	}
+
+	if diff.Changed == nil || !*diff.Changed {
+		fmt.Fprintln(w, "No changes")
+	}
+
+	if len(diff.ValidationErrors) > 0 {
+		fmt.Fprintln(w, "Validation errors:")
+		for _, validationErr := range diff.ValidationErrors {
+			fmt.Fprintf(w, "  %s\n", validationErr)
+		}
+	}
 
 	return nil
 }
 
+// formatFieldDiff formats a field change as "path: old -> new", a missing value is shown as "<none>"
+func formatFieldDiff(field openapi.ResourceBundleFieldDiff) string {
+	oldValue, newValue := "<none>", "<none>"
+	if field.OldValue != nil {
+		oldValue = *field.OldValue
+	}
+	if field.NewValue != nil {
+		newValue = *field.NewValue
+	}
+	return fmt.Sprintf("%s: %s -> %s", getStringPtr(field.Path), oldValue, newValue)
+}
+
 // PrintConsumerList prints a list of consumers as a table
 func PrintConsumerList(w io.Writer, consumers []openapi.Consumer) (err error) {
 	printer := NewTablePrinter(w)
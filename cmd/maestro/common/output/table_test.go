@@ -92,6 +92,105 @@ func TestPrintResourceBundleList(t *testing.T) {
 	}
 }
 
+func TestPrintResourceBundleRevisionList(t *testing.T) {
+	now := time.Now()
+	revisions := []openapi.ResourceBundleRevision{
+		{
+			Id:        openapi.PtrString("revision-2"),
+			Version:   openapi.PtrInt32(2),
+			Source:    openapi.PtrString("maestro"),
+			Author:    openapi.PtrString("alice"),
+			CreatedAt: &now,
+			Manifests: []map[string]interface{}{{"kind": "ConfigMap"}},
+		},
+		{
+			Id:        openapi.PtrString("revision-1"),
+			Version:   openapi.PtrInt32(1),
+			Source:    openapi.PtrString("grpc-source"),
+			CreatedAt: &now,
+		},
+	}
+
+	var buf bytes.Buffer
+	err := PrintResourceBundleRevisionList(&buf, revisions)
+
+	if err != nil {
+		t.Fatalf("PrintResourceBundleRevisionList() error = %v", err)
+	}
+
+	output := buf.String()
+
+	// Verify header is present
+	if !strings.Contains(output, "VERSION") {
+		t.Error("PrintResourceBundleRevisionList() output missing VERSION header")
+	}
+	if !strings.Contains(output, "AUTHOR") {
+		t.Error("PrintResourceBundleRevisionList() output missing AUTHOR header")
+	}
+
+	// Verify data is present
+	if !strings.Contains(output, "alice") {
+		t.Error("PrintResourceBundleRevisionList() output missing alice")
+	}
+	if !strings.Contains(output, "grpc-source") {
+		t.Error("PrintResourceBundleRevisionList() output missing grpc-source")
+	}
+}
+
+func TestPrintResourceBundleDiff(t *testing.T) {
+	diff := &openapi.ResourceBundleDiff{
+		Id:             openapi.PtrString("bundle-1"),
+		ConsumerName:   openapi.PtrString("consumer1"),
+		Action:         openapi.PtrString("update"),
+		CurrentVersion: openapi.PtrInt32(2),
+		Changed:        openapi.PtrBool(true),
+		Manifests: []openapi.ResourceBundleManifestDiff{
+			{
+				Change:     openapi.PtrString("changed"),
+				ApiVersion: openapi.PtrString("apps/v1"),
+				Kind:       openapi.PtrString("Deployment"),
+				Namespace:  openapi.PtrString("default"),
+				Name:       openapi.PtrString("nginx"),
+				Fields: []openapi.ResourceBundleFieldDiff{
+					{Path: openapi.PtrString("spec.replicas"), OldValue: openapi.PtrString("1"), NewValue: openapi.PtrString("2")},
+				},
+			},
+			{
+				Change:     openapi.PtrString("added"),
+				ApiVersion: openapi.PtrString("v1"),
+				Kind:       openapi.PtrString("ConfigMap"),
+				Namespace:  openapi.PtrString("default"),
+				Name:       openapi.PtrString("config"),
+			},
+		},
+		ValidationErrors: []string{"manifests[1]: kind: Required value"},
+	}
+
+	var buf bytes.Buffer
+	if err := PrintResourceBundleDiff(&buf, diff); err != nil {
+		t.Fatalf("PrintResourceBundleDiff() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{
+		"~ apps/v1 Deployment default/nginx",
+		"spec.replicas: 1 -> 2",
+		"+ v1 ConfigMap default/config",
+		"manifests[1]: kind: Required value",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("PrintResourceBundleDiff() output missing %q", want)
+		}
+	}
+	if strings.Contains(output, "No changes") {
+		t.Error("PrintResourceBundleDiff() output should not contain No changes")
+	}
+
+	if err := PrintResourceBundleDiff(&buf, nil); err == nil {
+		t.Error("PrintResourceBundleDiff() should fail without a diff")
+	}
+}
+
 func TestPrintResourceBundle(t *testing.T) {
 	now := time.Now()
 	bundle := &openapi.ResourceBundle{
@@ -138,6 +237,45 @@ func TestPrintResourceBundle(t *testing.T) {
 	}
 }
 
+func TestPrintResourceBundleRollout(t *testing.T) {
+	bundle := &openapi.ResourceBundle{
+		Id:      openapi.PtrString("bundle-1"),
+		Version: openapi.PtrInt32(2),
+		Rollout: &openapi.ResourceBundleRollout{
+			Phase:           openapi.PtrString("Degraded"),
+			ObservedVersion: openapi.PtrInt32(2),
+			UpToDate:        openapi.PtrBool(true),
+			Manifests: []openapi.ResourceBundleManifestRollout{
+				{
+					Kind:      openapi.PtrString("Deployment"),
+					Namespace: openapi.PtrString("default"),
+					Name:      openapi.PtrString("web"),
+					Phase:     openapi.PtrString("Degraded"),
+					Applied:   openapi.PtrString("True"),
+					Available: openapi.PtrString("False"),
+					Message:   openapi.PtrString("Resource is not available"),
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := PrintResourceBundleRollout(&buf, bundle); err != nil {
+		t.Fatalf("PrintResourceBundleRollout() error = %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"bundle-1", "Degraded", "Deployment default/web", "Resource is not available"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("PrintResourceBundleRollout() output missing %q", want)
+		}
+	}
+
+	if err := PrintResourceBundleRollout(&buf, nil); err == nil {
+		t.Error("PrintResourceBundleRollout() should fail without a resource bundle")
+	}
+}
+
 func TestPrintConsumerList(t *testing.T) {
 	now := time.Now()
 	labels := map[string]string{
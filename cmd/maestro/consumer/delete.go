@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/pkg/api"
 )
 
 func newDeleteCommand() *cobra.Command {
@@ -21,11 +22,21 @@ func newDeleteCommand() *cobra.Command {
 By default, this command will prompt for confirmation before deleting.
 Use the --yes flag to skip the confirmation prompt.
 
-Note: A consumer cannot be deleted if it has existing resource bundles.
+Note: By default, a consumer cannot be deleted if it has existing resource bundles.
+Use the --deletion-policy flag to choose how the resource bundles are handled:
+- Restrict: the consumer cannot be deleted if it has resource bundles (default)
+- Cascade: the resource bundles of the consumer are deleted first, the consumer is
+  removed once the agent confirms the deletion of its resource bundles
+- Orphan: the resource bundles are removed from Maestro without telling the agent,
+  and then the consumer is removed. This is the force deletion, use it only when
+  the agent of the consumer is gone
+The --cascade flag is a shorthand of --deletion-policy=Cascade.
 
 Examples:
   maestro consumer delete <consumer-id>
-  maestro consumer delete <consumer-id> --yes`,
+  maestro consumer delete <consumer-id> --yes
+  maestro consumer delete <consumer-id> --cascade
+  maestro consumer delete <consumer-id> --deletion-policy=Orphan`,
 		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := runDelete(cmd, args); err != nil {
@@ -36,6 +47,8 @@ Examples:
 	}
 
 	cmd.Flags().BoolP("yes", "y", false, "Skip confirmation prompt")
+	cmd.Flags().Bool("cascade", false, "Delete the resource bundles of the consumer before removing the consumer")
+	cmd.Flags().String("deletion-policy", "", "How the resource bundles of the consumer are handled: Restrict, Cascade or Orphan")
 
 	return cmd
 }
@@ -46,10 +59,21 @@ func runDelete(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to read --yes flag: %w", err)
 	}
+	policy, err := deletionPolicy(cmd)
+	if err != nil {
+		return err
+	}
 
 	// Confirmation prompt
 	if !skipConfirm {
-		fmt.Printf("Are you sure you want to delete consumer %s? (y/N): ", consumerID)
+		switch policy {
+		case api.ConsumerDeletionPolicyCascade:
+			fmt.Printf("Are you sure you want to delete consumer %s and all of its resource bundles? (y/N): ", consumerID)
+		case api.ConsumerDeletionPolicyOrphan:
+			fmt.Printf("Are you sure you want to delete consumer %s and remove its resource bundles without deleting them on the agent? (y/N): ", consumerID)
+		default:
+			fmt.Printf("Are you sure you want to delete consumer %s? (y/N): ", consumerID)
+		}
 		reader := bufio.NewReader(os.Stdin)
 		response, err := reader.ReadString('\n')
 		if err != nil {
@@ -77,10 +101,43 @@ func runDelete(cmd *cobra.Command, args []string) error {
 
 	// Delete the consumer
 	ctx := context.Background()
-	if err := restClient.DeleteConsumer(ctx, consumerID); err != nil {
+	if err := restClient.DeleteConsumer(ctx, consumerID, string(policy)); err != nil {
 		return err
 	}
 
+	if policy == api.ConsumerDeletionPolicyCascade {
+		fmt.Printf("Consumer %s is being deleted, it will be removed once its resource bundles are deleted\n", consumerID)
+		return nil
+	}
+
 	fmt.Printf("Consumer %s deleted successfully\n", consumerID)
 	return nil
 }
+
+// deletionPolicy returns the deletion policy of the --deletion-policy and the --cascade flags, it is empty if
+// none of them is set so the server default policy is used.
+func deletionPolicy(cmd *cobra.Command) (api.ConsumerDeletionPolicy, error) {
+	cascade, err := cmd.Flags().GetBool("cascade")
+	if err != nil {
+		return "", fmt.Errorf("failed to read --cascade flag: %w", err)
+	}
+	value, err := cmd.Flags().GetString("deletion-policy")
+	if err != nil {
+		return "", fmt.Errorf("failed to read --deletion-policy flag: %w", err)
+	}
+
+	if value == "" {
+		if cascade {
+			return api.ConsumerDeletionPolicyCascade, nil
+		}
+		return "", nil
+	}
+	policy, err := api.ParseConsumerDeletionPolicy(value)
+	if err != nil {
+		return "", err
+	}
+	if cascade && policy != api.ConsumerDeletionPolicyCascade {
+		return "", fmt.Errorf("--cascade cannot be used with --deletion-policy=%s", policy)
+	}
+	return policy, nil
+}
@@ -19,6 +19,8 @@ func TestRunDelete(t *testing.T) {
 		name        string
 		args        []string
 		skipConfirm bool
+		cascade     bool
+		policy      string
 		wantErr     bool
 		errContains string
 	}{
@@ -28,6 +30,52 @@ func TestRunDelete(t *testing.T) {
 			skipConfirm: true,
 			wantErr:     false,
 		},
+		{
+			name:        "successful cascade delete",
+			args:        []string{"consumer-1"},
+			skipConfirm: true,
+			cascade:     true,
+			wantErr:     false,
+		},
+		{
+			name:        "cascade delete non-existent consumer",
+			args:        []string{"not-found"},
+			skipConfirm: true,
+			cascade:     true,
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name:        "successful orphan delete",
+			args:        []string{"consumer-1"},
+			skipConfirm: true,
+			policy:      "Orphan",
+			wantErr:     false,
+		},
+		{
+			name:        "successful cascade delete with --deletion-policy flag",
+			args:        []string{"consumer-1"},
+			skipConfirm: true,
+			policy:      "Cascade",
+			wantErr:     false,
+		},
+		{
+			name:        "unsupported deletion policy",
+			args:        []string{"consumer-1"},
+			skipConfirm: true,
+			policy:      "Force",
+			wantErr:     true,
+			errContains: "unsupported deletion policy",
+		},
+		{
+			name:        "cascade with another deletion policy",
+			args:        []string{"consumer-1"},
+			skipConfirm: true,
+			cascade:     true,
+			policy:      "Orphan",
+			wantErr:     true,
+			errContains: "--cascade cannot be used",
+		},
 		{
 			name:        "delete non-existent consumer",
 			args:        []string{"not-found"},
@@ -52,6 +100,8 @@ func TestRunDelete(t *testing.T) {
 			cmd := &cobra.Command{}
 			clients.AddRESTClientFlags(cmd)
 			cmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
+			cmd.Flags().Bool("cascade", false, "Cascade deletion")
+			cmd.Flags().String("deletion-policy", "", "Deletion policy")
 
 			// Parse flags to initialize them
 			if err := cmd.ParseFlags([]string{}); err != nil {
@@ -61,6 +111,12 @@ func TestRunDelete(t *testing.T) {
 			if tt.skipConfirm {
 				cmd.Flags().Set("yes", "true")
 			}
+			if tt.cascade {
+				cmd.Flags().Set("cascade", "true")
+			}
+			if tt.policy != "" {
+				cmd.Flags().Set("deletion-policy", tt.policy)
+			}
 
 			err := runDelete(cmd, tt.args)
 
@@ -105,6 +161,8 @@ func TestRunDelete_WithConfirmation(t *testing.T) {
 		cmd := &cobra.Command{}
 		clients.AddRESTClientFlags(cmd)
 		cmd.Flags().BoolP("yes", "y", false, "Skip confirmation")
+		cmd.Flags().Bool("cascade", false, "Cascade deletion")
+		cmd.Flags().String("deletion-policy", "", "Deletion policy")
 
 		// Parse flags to initialize them
 		if err := cmd.ParseFlags([]string{}); err != nil {
@@ -11,10 +11,13 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/klog/v2"
 	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
+	ceoptions "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/builder"
 
 	envtypes "github.com/openshift-online/maestro/cmd/maestro/environments/types"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/client/cloudevents"
+	"github.com/openshift-online/maestro/pkg/client/cloudevents/kafka"
 	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
 	"github.com/openshift-online/maestro/pkg/config"
 	"github.com/openshift-online/maestro/pkg/errors"
@@ -127,10 +130,39 @@ func (e *Env) Seed() *errors.ServiceError {
 
 func (e *Env) LoadServices() {
 	e.Services.Generic = NewGenericServiceLocator(e)
+	e.Services.AuditEvents = NewAuditEventServiceLocator(e)
 	e.Services.Resources = NewResourceServiceLocator(e)
 	e.Services.Events = NewEventServiceLocator(e)
 	e.Services.StatusEvents = NewStatusEventServiceLocator(e)
 	e.Services.Consumers = NewConsumerServiceLocator(e)
+	e.Services.Placements = NewPlacementServiceLocator(e)
+	e.Services.ConsumerHeartbeats = NewConsumerHeartbeatServiceLocator(e)
+}
+
+// loadCloudEventsSourceOptions builds the CloudEvents source options of the message broker, the kafka transport is
+// provided by maestro, the other message brokers are provided by the sdk-go.
+func (e *Env) loadCloudEventsSourceOptions() (*ceoptions.CloudEventsSourceOptions, error) {
+	if e.Config.MessageBroker.MessageBrokerType == "kafka" {
+		kafkaOptions, err := kafka.BuildKafkaOptionsFromFlags(e.Config.MessageBroker.MessageBrokerConfig)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load kafka config: %v", err)
+		}
+		return kafka.NewSourceOptions(kafkaOptions, e.Config.MessageBroker.ClientID, e.Config.MessageBroker.SourceID,
+			config.SubscriptionType(e.Config.EventServer.SubscriptionType)), nil
+	}
+
+	_, brokerConfig, err := builder.NewConfigLoader(e.Config.MessageBroker.MessageBrokerType, e.Config.MessageBroker.MessageBrokerConfig).
+		LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to load cloudevent config: %v", err)
+	}
+
+	cloudEventsSourceOptions, err := builder.BuildCloudEventsSourceOptions(brokerConfig,
+		e.Config.MessageBroker.ClientID, e.Config.MessageBroker.SourceID, workpayload.ManifestBundleEventDataType)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to build cloudevent source options: %v", err)
+	}
+	return cloudEventsSourceOptions, nil
 }
 
 func (e *Env) LoadClients() error {
@@ -142,18 +174,12 @@ func (e *Env) LoadClients() error {
 		if !e.Config.MessageBroker.Disable {
 			// For gRPC message broker type, Maestro server does not require the source client to publish resources or subscribe to resource status.
 			if e.Config.MessageBroker.MessageBrokerType != "grpc" {
-				_, config, err := builder.NewConfigLoader(e.Config.MessageBroker.MessageBrokerType, e.Config.MessageBroker.MessageBrokerConfig).
-					LoadConfig()
-				if err != nil {
-					return fmt.Errorf("Unable to load cloudevent config: %v", err)
-				}
-
-				cloudEventsSourceOptions, err := builder.BuildCloudEventsSourceOptions(config,
-					e.Config.MessageBroker.ClientID, e.Config.MessageBroker.SourceID, workpayload.ManifestBundleEventDataType)
+				cloudEventsSourceOptions, err := e.loadCloudEventsSourceOptions()
 				if err != nil {
-					return fmt.Errorf("Unable to build cloudevent source options: %v", err)
+					return err
 				}
-				e.Clients.CloudEventsSource, err = cloudevents.NewSourceClient(cloudEventsSourceOptions, e.Services.Resources())
+				e.Clients.CloudEventsSource, err = cloudevents.NewSourceClient(cloudEventsSourceOptions, e.Services.Resources(),
+					e.Services.ConsumerHeartbeats())
 				if err != nil {
 					return fmt.Errorf("Unable to create cloudevent source client: %v", err)
 				}
@@ -167,25 +193,75 @@ func (e *Env) LoadClients() error {
 			klog.V(4).Info("Using Mock GRPC Authorizer")
 			e.Clients.GRPCAuthorizer = grpcauthorizer.NewMockGRPCAuthorizer()
 		} else {
-			kubeConfig, err := clientcmd.BuildConfigFromFlags("", e.Config.GRPCServer.GRPCAuthorizerConfig)
-			if err != nil {
-				klog.Warningf("Unable to load kubeconfig from file %s: %v, falling back to in-cluster config", e.Config.GRPCServer.GRPCAuthorizerConfig, err)
-				kubeConfig, err = rest.InClusterConfig()
-				if err != nil {
-					return fmt.Errorf("Unable to retrieve kube client config: %v", err)
-				}
-			}
-			kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+			kubeClient, err := newKubeClient(e.Config.GRPCServer.GRPCAuthorizerConfig)
 			if err != nil {
-				return fmt.Errorf("Unable to create kube client: %v", err)
+				return err
 			}
 			e.Clients.GRPCAuthorizer = grpcauthorizer.NewKubeGRPCAuthorizer(kubeClient)
 		}
 	}
 
+	// Create REST authenticator and authorizer based on configuration
+	switch e.Config.HTTPServer.AuthNType {
+	case auth.AuthNTypeMock:
+		klog.V(4).Info("Using Mock REST Authenticator")
+		e.Clients.RESTAuthenticator = auth.NewMockAuthenticator()
+	case auth.AuthNTypeToken:
+		kubeClient, err := newKubeClient(e.Config.HTTPServer.AuthNKubeConfig)
+		if err != nil {
+			return err
+		}
+		e.Clients.RESTAuthenticator = auth.NewTokenAuthenticator(grpcauthorizer.NewKubeGRPCAuthorizer(kubeClient))
+	case auth.AuthNTypeMTLS:
+		if !e.Config.HTTPServer.EnableHTTPS {
+			return fmt.Errorf("https must be enabled when using mtls REST authentication type")
+		}
+		if e.Config.HTTPServer.ClientCAFile == "" {
+			return fmt.Errorf("no client CA file specified when using mtls REST authentication type")
+		}
+		e.Clients.RESTAuthenticator = auth.NewCertificateAuthenticator()
+	case auth.AuthNTypeJWT:
+		if e.Config.HTTPServer.JWKSURL == "" {
+			return fmt.Errorf("no JWKS URL specified when using jwt REST authentication type")
+		}
+		e.Clients.RESTAuthenticator = auth.NewJWTAuthenticator(e.Config.HTTPServer.JWKSURL,
+			e.Config.HTTPServer.JWTUsernameClaim, e.Config.HTTPServer.JWTGroupsClaim)
+	default:
+		return fmt.Errorf("unsupported REST authentication type %s", e.Config.HTTPServer.AuthNType)
+	}
+
+	if e.Config.HTTPServer.AuthorizerConfig == "" {
+		klog.V(4).Info("Using Mock REST Authorizer")
+		e.Clients.RESTAuthorizer = auth.NewMockAuthorizer()
+	} else {
+		authzConfig, err := auth.LoadAuthorizationConfig(e.Config.HTTPServer.AuthorizerConfig)
+		if err != nil {
+			return err
+		}
+		e.Clients.RESTAuthorizer = auth.NewRuleAuthorizer(authzConfig)
+	}
+
 	return nil
 }
 
+// newKubeClient creates a kube client from the given kubeconfig file, the in-cluster config is used if the
+// kubeconfig file cannot be loaded.
+func newKubeClient(kubeConfigFile string) (kubernetes.Interface, error) {
+	kubeConfig, err := clientcmd.BuildConfigFromFlags("", kubeConfigFile)
+	if err != nil {
+		klog.Warningf("Unable to load kubeconfig from file %s: %v, falling back to in-cluster config", kubeConfigFile, err)
+		kubeConfig, err = rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("Unable to retrieve kube client config: %v", err)
+		}
+	}
+	kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create kube client: %v", err)
+	}
+	return kubeClient, nil
+}
+
 func (e *Env) Teardown() {
 	if e.Name != envtypes.TestingEnv {
 		if err := e.Database.SessionFactory.Close(); err != nil {
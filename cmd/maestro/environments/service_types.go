@@ -1,6 +1,10 @@
 package environments
 
 import (
+	"log"
+	"sync"
+	"time"
+
 	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/pkg/db"
 	"github.com/openshift-online/maestro/pkg/services"
@@ -8,13 +12,25 @@ import (
 
 type ResourceServiceLocator func() services.ResourceService
 
+// NewResourceServiceLocator loads the admission chain of the resource services, the environment fails to
+// initialize if the admission config is invalid.
 func NewResourceServiceLocator(env *Env) ResourceServiceLocator {
+	admission, err := services.NewAdmissionChainFromConfig(env.Config.Admission, env.Config.Policy)
+	if err != nil {
+		log.Fatalf("Failed to load admission chain: %s", err)
+	}
 	return func() services.ResourceService {
 		return services.NewResourceService(
 			db.NewAdvisoryLockFactory(env.Database.SessionFactory),
 			dao.NewResourceDao(&env.Database.SessionFactory),
+			dao.NewConsumerDao(&env.Database.SessionFactory),
 			env.Services.Events(),
 			env.Services.Generic(),
+			services.ResourceServiceOptions{
+				Revisions:   dao.NewResourceRevisionDao(&env.Database.SessionFactory),
+				Admission:   admission,
+				AuditEvents: env.Services.AuditEvents(),
+			},
 		)
 	}
 }
@@ -49,6 +65,66 @@ func NewConsumerServiceLocator(env *Env) ConsumerServiceLocator {
 	return func() services.ConsumerService {
 		return services.NewConsumerService(
 			dao.NewConsumerDao(&env.Database.SessionFactory),
+			dao.NewResourceDao(&env.Database.SessionFactory),
+			dao.NewPlacementDao(&env.Database.SessionFactory),
+			env.Services.Resources(),
+			env.Services.AuditEvents(),
+		)
+	}
+}
+
+type PlacementServiceLocator func() services.PlacementService
+
+func NewPlacementServiceLocator(env *Env) PlacementServiceLocator {
+	return func() services.PlacementService {
+		return services.NewPlacementService(
+			db.NewAdvisoryLockFactory(env.Database.SessionFactory),
+			dao.NewPlacementDao(&env.Database.SessionFactory),
+			dao.NewResourceDao(&env.Database.SessionFactory),
+			env.Services.Consumers(),
+			env.Services.Resources(),
 		)
 	}
 }
+
+type ConsumerHeartbeatServiceLocator func() services.ConsumerHeartbeatService
+
+// NewConsumerHeartbeatServiceLocator returns the same service to all the callers, the service keeps the agent
+// heartbeats observed by the instance in memory until they are saved.
+func NewConsumerHeartbeatServiceLocator(env *Env) ConsumerHeartbeatServiceLocator {
+	var once sync.Once
+	var service services.ConsumerHeartbeatService
+	return func() services.ConsumerHeartbeatService {
+		once.Do(func() {
+			service = services.NewConsumerHeartbeatService(
+				env.Config.MessageBroker.ClientID,
+				dao.NewConsumerHeartbeatDao(&env.Database.SessionFactory),
+				dao.NewConsumerDao(&env.Database.SessionFactory),
+				time.Duration(env.Config.HealthCheck.ConsumerHeartbeatInterval)*time.Second,
+				time.Duration(env.Config.HealthCheck.ConsumerHeartbeatTimeout)*time.Second,
+			)
+		})
+		return service
+	}
+}
+
+type AuditEventServiceLocator func() services.AuditEventService
+
+// NewAuditEventServiceLocator returns the same service to all the callers, the service queues the audit events
+// in memory until they are delivered to the sinks. The environment fails to initialize if a sink cannot be
+// created from the audit config.
+func NewAuditEventServiceLocator(env *Env) AuditEventServiceLocator {
+	sinks, err := services.NewAuditSinksFromConfig(env.Config.Audit)
+	if err != nil {
+		log.Fatalf("Failed to create audit sinks: %s", err)
+	}
+
+	var once sync.Once
+	var service services.AuditEventService
+	return func() services.AuditEventService {
+		once.Do(func() {
+			service = services.NewAuditEventService(dao.NewAuditEventDao(&env.Database.SessionFactory), sinks...)
+		})
+		return service
+	}
+}
@@ -3,6 +3,7 @@ package environments
 import (
 	"sync"
 
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/client/cloudevents"
 	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
 	"github.com/openshift-online/maestro/pkg/config"
@@ -42,11 +43,17 @@ type Services struct {
 	Events       EventServiceLocator
 	StatusEvents StatusEventServiceLocator
 	Consumers    ConsumerServiceLocator
+	Placements   PlacementServiceLocator
+
+	ConsumerHeartbeats ConsumerHeartbeatServiceLocator
+	AuditEvents        AuditEventServiceLocator
 }
 
 type Clients struct {
 	GRPCAuthorizer    grpcauthorizer.GRPCAuthorizer
 	CloudEventsSource cloudevents.SourceClient
+	RESTAuthenticator auth.Authenticator
+	RESTAuthorizer    auth.Authorizer
 }
 
 type ConfigDefaults struct {
@@ -18,11 +18,15 @@ func NewResourceBundleCommand() *cobra.Command {
 Resource bundles are collections of Kubernetes manifests that are deployed to consumer clusters.
 
 Commands:
-  apply  - Create or update a resource bundle via gRPC
-  get    - Get a resource bundle by ID via REST API
-  list   - List resource bundles via REST API
-  delete - Delete a resource bundle via gRPC
-  status - Get resource bundle status via REST API`,
+  apply    - Create or update a resource bundle via gRPC
+  diff     - Show the changes that applying a resource bundle would make via REST API
+  get      - Get a resource bundle by ID via REST API
+  list     - List resource bundles via REST API
+  delete   - Delete a resource bundle via gRPC
+  status   - Get resource bundle status via REST API
+  wait     - Wait for a resource bundle to reach a rollout phase via REST API
+  history  - Show the revision history of a resource bundle via REST API
+  rollback - Roll back a resource bundle to a revision via REST API`,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			// Suppress verbose logs by default for CLI commands
 			// Only suppress if user hasn't set -v flag
@@ -39,10 +43,14 @@ Commands:
 	// Add subcommands
 	cmd.AddCommand(
 		newApplyCommand(),
+		newDiffCommand(),
 		newGetCommand(),
 		newListCommand(),
 		newDeleteCommand(),
 		newStatusCommand(),
+		newWaitCommand(),
+		newHistoryCommand(),
+		newRollbackCommand(),
 	)
 
 	return cmd
@@ -0,0 +1,131 @@
+package resourcebundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+func newDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff -f <file>",
+		Short: "Show the changes that applying a resource bundle would make",
+		Long: `Show the changes that applying a resource bundle manifest file (JSON format) would make.
+
+The manifest file has the same format as the apply command. It is sent to the server
+in a dry run, the server validates it and compares it with the stored resource bundle
+without creating or updating it:
+- If 'id' is not specified in the manifest, the changes of creating a new resource
+  bundle are shown
+- If 'id' is specified, the changes of updating the existing resource bundle are shown
+
+The added, removed and changed manifests are prefixed with "+", "-" and "~", and the
+changed fields of each manifest are listed with their old and new values. The command
+fails if the resource bundle would be rejected by the validation.
+
+Examples:
+  maestro resourcebundle diff -f bundle.json
+  maestro resourcebundle diff -f bundle.json --output json`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runDiff(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringP("file", "f", "", "Path to the manifest file (required)")
+	cmd.MarkFlagRequired("file")
+	output.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runDiff(cmd *cobra.Command, _ []string) error {
+	// Read and parse the manifest file
+	filePath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return fmt.Errorf("failed to read --file flag: %w", err)
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read manifest file: %w", err)
+	}
+
+	// Parse manifest file (JSON format only)
+	var bundle openapi.ResourceBundle
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return fmt.Errorf("failed to parse manifest file: %w", err)
+	}
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	ctx := context.Background()
+
+	// Dry run the update if the ID was provided, otherwise dry run the create
+	var diff *openapi.ResourceBundleDiff
+	if bundle.Id != nil && *bundle.Id != "" {
+		patch := openapi.ResourceBundlePatchRequest{
+			Metadata:        bundle.Metadata,
+			Manifests:       bundle.Manifests,
+			ManifestConfigs: bundle.ManifestConfigs,
+			DeleteOption:    bundle.DeleteOption,
+		}
+		// the changes are compared with the current version if the manifest file has no version
+		if bundle.Version != nil && *bundle.Version != 0 {
+			patch.Version = *bundle.Version
+		} else {
+			current, err := restClient.GetResourceBundle(ctx, *bundle.Id)
+			if err != nil {
+				return fmt.Errorf("cannot get resource bundle %q: %w", *bundle.Id, err)
+			}
+			patch.Version = current.GetVersion()
+		}
+		diff, err = restClient.DryRunUpdateResourceBundle(ctx, *bundle.Id, patch)
+		if err != nil {
+			return fmt.Errorf("cannot diff resource bundle %q: %w", *bundle.Id, err)
+		}
+	} else {
+		diff, err = restClient.DryRunCreateResourceBundle(ctx, bundle)
+		if err != nil {
+			return fmt.Errorf("cannot diff resource bundle: %w", err)
+		}
+	}
+
+	// Output the result
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable {
+		err = output.PrintResourceBundleDiff(os.Stdout, diff)
+	} else {
+		err = output.PrintJSON(os.Stdout, diff)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(diff.ValidationErrors) > 0 {
+		return fmt.Errorf("the resource bundle is invalid")
+	}
+	return nil
+}
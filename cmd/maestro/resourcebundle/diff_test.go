@@ -0,0 +1,139 @@
+package resourcebundle
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func TestRunDiff(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		manifest    string
+		output      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "diff of a new resource bundle",
+			manifest: `{
+				"consumer_name": "test-consumer",
+				"manifests": [
+					{
+						"apiVersion": "v1",
+						"kind": "ConfigMap",
+						"metadata": {"name": "test", "namespace": "default"}
+					}
+				]
+			}`,
+			output:  "table",
+			wantErr: false,
+		},
+		{
+			name: "diff of an existing resource bundle with json format",
+			manifest: `{
+				"id": "bundle-1",
+				"consumer_name": "test-consumer",
+				"manifests": [
+					{
+						"apiVersion": "v1",
+						"kind": "ConfigMap",
+						"metadata": {"name": "test", "namespace": "default"},
+						"data": {"key": "new"}
+					}
+				]
+			}`,
+			output:  "json",
+			wantErr: false,
+		},
+		{
+			name: "invalid resource bundle",
+			manifest: `{
+				"id": "invalid",
+				"version": 1,
+				"manifests": [{"apiVersion": "v1"}]
+			}`,
+			output:      "table",
+			wantErr:     true,
+			errContains: "invalid",
+		},
+		{
+			name: "diff with non-existent id",
+			manifest: `{
+				"id": "not-found",
+				"manifests": [{"apiVersion": "v1", "kind": "ConfigMap"}]
+			}`,
+			output:      "table",
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name: "version conflict",
+			manifest: `{
+				"id": "conflict",
+				"version": 1,
+				"manifests": [{"apiVersion": "v1", "kind": "ConfigMap"}]
+			}`,
+			output:      "table",
+			wantErr:     true,
+			errContains: "conflict",
+		},
+		{
+			name:        "invalid json format",
+			manifest:    `{invalid json}`,
+			output:      "table",
+			wantErr:     true,
+			errContains: "failed to parse",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server, nil)
+			defer cleanup()
+
+			// Create temporary manifest file
+			tmpDir := t.TempDir()
+			manifestFile := filepath.Join(tmpDir, "manifest.json")
+			if err := os.WriteFile(manifestFile, []byte(tt.manifest), 0644); err != nil {
+				t.Fatalf("Failed to create manifest file: %v", err)
+			}
+
+			cmd := &cobra.Command{}
+			clients.AddRESTClientFlags(cmd)
+			output.AddFormatFlag(cmd)
+			cmd.Flags().StringP("file", "f", "", "Path to the manifest file")
+
+			// Parse flags to initialize them
+			if err := cmd.ParseFlags([]string{}); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			cmd.Flags().Set("file", manifestFile)
+			cmd.Flags().Set(output.FlagOutput, tt.output)
+
+			err := runDiff(cmd, []string{})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runDiff() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("runDiff() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package resourcebundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func newHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history <id>",
+		Short: "Show the revision history of a resource bundle",
+		Long: `Show the revision history of a resource bundle by its ID.
+
+A revision is recorded each time the resource bundle is created or its manifests are
+updated, the latest revision is shown first. Use the json output to see the manifests
+of each revision.
+
+Examples:
+  maestro resourcebundle history 2faPrp3ZoCMkzdHnBBWd9wqwVXd
+  maestro resourcebundle history 2faPrp3ZoCMkzdHnBBWd9wqwVXd --output json`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runHistory(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	output.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	bundleID := args[0]
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// List the revisions of the resource bundle
+	ctx := context.Background()
+	revisions, err := restClient.ListResourceBundleRevisions(ctx, bundleID)
+	if err != nil {
+		return err
+	}
+
+	// Output the result
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable {
+		return output.PrintResourceBundleRevisionList(os.Stdout, revisions.Items)
+	}
+
+	return output.PrintJSON(os.Stdout, revisions)
+}
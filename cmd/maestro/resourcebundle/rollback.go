@@ -0,0 +1,84 @@
+package resourcebundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+const flagToVersion = "to-version"
+
+func newRollbackCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollback <id>",
+		Short: "Roll back a resource bundle to a previous revision",
+		Long: `Roll back a resource bundle to one of its revisions.
+
+The manifests of the revision are applied again as a new version of the resource
+bundle, so the rollback is recorded in the revision history too. Use the history
+command to find the version of the revision to roll back to.
+
+Examples:
+  maestro resourcebundle rollback 2faPrp3ZoCMkzdHnBBWd9wqwVXd --to-version 2
+  maestro resourcebundle rollback 2faPrp3ZoCMkzdHnBBWd9wqwVXd --to-version 2 --output json`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runRollback(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().Int32(flagToVersion, 0, "The version of the revision to roll back to (required)")
+	output.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runRollback(cmd *cobra.Command, args []string) error {
+	bundleID := args[0]
+	version, err := cmd.Flags().GetInt32(flagToVersion)
+	if err != nil {
+		return fmt.Errorf("failed to read --%s flag: %w", flagToVersion, err)
+	}
+	if version <= 0 {
+		return fmt.Errorf("--%s must be a positive version", flagToVersion)
+	}
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// Roll back the resource bundle
+	ctx := context.Background()
+	bundle, err := restClient.RollbackResourceBundle(ctx, bundleID, version)
+	if err != nil {
+		return err
+	}
+
+	// Output the result
+	format, err := output.GetFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	if format == output.FormatTable {
+		return output.PrintResourceBundle(os.Stdout, bundle)
+	}
+
+	return output.PrintJSON(os.Stdout, bundle)
+}
@@ -0,0 +1,99 @@
+package resourcebundle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func TestRunRollback(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		args        []string
+		toVersion   string
+		output      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:      "successful rollback with table format",
+			args:      []string{"bundle-1"},
+			toVersion: "1",
+			output:    "table",
+			wantErr:   false,
+		},
+		{
+			name:      "successful rollback with json format",
+			args:      []string{"bundle-1"},
+			toVersion: "1",
+			output:    "json",
+			wantErr:   false,
+		},
+		{
+			name:        "missing version",
+			args:        []string{"bundle-1"},
+			output:      "table",
+			wantErr:     true,
+			errContains: "--to-version",
+		},
+		{
+			name:        "revision not found",
+			args:        []string{"bundle-1"},
+			toVersion:   "5",
+			output:      "table",
+			wantErr:     true,
+			errContains: "not found",
+		},
+		{
+			name:        "resource bundle is being deleted",
+			args:        []string{"conflict"},
+			toVersion:   "1",
+			output:      "table",
+			wantErr:     true,
+			errContains: "being deleted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server, nil)
+			defer cleanup()
+
+			cmd := &cobra.Command{}
+			clients.AddRESTClientFlags(cmd)
+			output.AddFormatFlag(cmd)
+			cmd.Flags().Int32(flagToVersion, 0, "Version")
+
+			// Parse flags to initialize them
+			if err := cmd.ParseFlags([]string{}); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			cmd.Flags().Set(output.FlagOutput, tt.output)
+			if tt.toVersion != "" {
+				cmd.Flags().Set(flagToVersion, tt.toVersion)
+			}
+
+			err := runRollback(cmd, tt.args)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runRollback() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("runRollback() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package resourcebundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+const (
+	flagFor         = "for"
+	flagWaitTimeout = "wait-timeout"
+
+	// maxWaitRequestTimeout is the longest time that a single wait request waits on the server, a longer
+	// wait is split into several requests.
+	maxWaitRequestTimeout = time.Minute
+)
+
+func newWaitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wait <id>",
+		Short: "Wait for a resource bundle to reach a rollout phase",
+		Long: `Wait until a resource bundle reaches a rollout phase at its current version.
+
+The rollout phase is computed by the server from the status reported by the agent,
+a resource bundle is Applied or Available only after the agent reports the status of
+its current version. The command exits with an error if the phase is not reached
+before the wait timeout, so it can be used to block a pipeline on a rollout.
+
+Examples:
+  maestro resourcebundle wait 2faPrp3ZoCMkzdHnBBWd9wqwVXd
+  maestro resourcebundle wait 2faPrp3ZoCMkzdHnBBWd9wqwVXd --for Applied --wait-timeout 10m`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runWait(cmd, args); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().String(flagFor, "Available", "The rollout phase to wait for, one of Applied or Available")
+	cmd.Flags().Duration(flagWaitTimeout, 5*time.Minute, "The maximum duration to wait for the rollout phase")
+	output.AddFormatFlag(cmd)
+
+	return cmd
+}
+
+func runWait(cmd *cobra.Command, args []string) error {
+	bundleID := args[0]
+	phase, err := cmd.Flags().GetString(flagFor)
+	if err != nil {
+		return fmt.Errorf("failed to read --%s flag: %w", flagFor, err)
+	}
+	if phase != "Applied" && phase != "Available" {
+		return fmt.Errorf("--%s must be one of Applied or Available", flagFor)
+	}
+	waitTimeout, err := cmd.Flags().GetDuration(flagWaitTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to read --%s flag: %w", flagWaitTimeout, err)
+	}
+	if waitTimeout <= 0 {
+		return fmt.Errorf("--%s must be a positive duration", flagWaitTimeout)
+	}
+
+	// Load REST client configuration
+	cfg, err := clients.LoadRESTConfigFromFlags(cmd)
+	if err != nil {
+		return err
+	}
+	// the client must not time out before the server returns a wait request
+	requestTimeout := min(waitTimeout, maxWaitRequestTimeout)
+	if cfg.Timeout < requestTimeout+10*time.Second {
+		cfg.Timeout = requestTimeout + 10*time.Second
+	}
+
+	// Create REST client
+	restClient, err := clients.NewRESTClient(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create REST client: %w", err)
+	}
+
+	// Wait for the rollout phase, the server returns the resource bundle once the phase is reached or
+	// the request timeout expires
+	ctx := context.Background()
+	deadline := time.Now().Add(waitTimeout)
+	remaining := waitTimeout
+	for {
+		bundle, err := restClient.WaitResourceBundle(ctx, bundleID, phase, min(remaining, maxWaitRequestTimeout))
+		if err != nil {
+			return err
+		}
+
+		rollout := bundle.GetRollout()
+		current := rollout.GetPhase()
+		if current == phase || (phase == "Applied" && current == "Available") {
+			format, err := output.GetFormat(cmd)
+			if err != nil {
+				return err
+			}
+			if format == output.FormatTable {
+				return output.PrintResourceBundleRollout(os.Stdout, bundle)
+			}
+			return output.PrintJSON(os.Stdout, bundle)
+		}
+
+		remaining = time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("timed out waiting for resource bundle %s to be %s, the current phase is %s", bundleID, phase, current)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package resourcebundle
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients"
+	"github.com/openshift-online/maestro/cmd/maestro/common/clients/mock"
+	"github.com/openshift-online/maestro/cmd/maestro/common/output"
+)
+
+func TestRunWait(t *testing.T) {
+	server := mock.NewMaestroServer()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		args        []string
+		phase       string
+		waitTimeout string
+		output      string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "available with table format",
+			args:    []string{"bundle-1"},
+			output:  "table",
+			wantErr: false,
+		},
+		{
+			name:    "applied is reached by an available resource bundle",
+			args:    []string{"bundle-1"},
+			phase:   "Applied",
+			output:  "json",
+			wantErr: false,
+		},
+		{
+			name:        "invalid phase",
+			args:        []string{"bundle-1"},
+			phase:       "Degraded",
+			output:      "table",
+			wantErr:     true,
+			errContains: "--for",
+		},
+		{
+			name:        "timed out",
+			args:        []string{"pending"},
+			waitTimeout: "1s",
+			output:      "table",
+			wantErr:     true,
+			errContains: "the current phase is Pending",
+		},
+		{
+			name:        "resource bundle not found",
+			args:        []string{"not-found"},
+			output:      "table",
+			wantErr:     true,
+			errContains: "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := setupTestEnv(t, server, nil)
+			defer cleanup()
+
+			cmd := &cobra.Command{}
+			clients.AddRESTClientFlags(cmd)
+			output.AddFormatFlag(cmd)
+			cmd.Flags().String(flagFor, "Available", "Phase")
+			cmd.Flags().Duration(flagWaitTimeout, 5*time.Minute, "Timeout")
+
+			// Parse flags to initialize them
+			if err := cmd.ParseFlags([]string{}); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			cmd.Flags().Set(output.FlagOutput, tt.output)
+			if tt.phase != "" {
+				cmd.Flags().Set(flagFor, tt.phase)
+			}
+			if tt.waitTimeout != "" {
+				cmd.Flags().Set(flagWaitTimeout, tt.waitTimeout)
+			}
+
+			err := runWait(cmd, tt.args)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("runWait() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr && tt.errContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errContains) {
+					t.Errorf("runWait() error = %v, should contain %v", err, tt.errContains)
+				}
+			}
+		})
+	}
+}
@@ -47,7 +47,12 @@ func runServer(cmd *cobra.Command, args []string) {
 	}
 
 	// Create event broadcaster to broadcast resource status update events to subscribers
-	eventBroadcaster := event.NewEventBroadcaster()
+	grpcServerConfig := environments.Environment().Config.GRPCServer
+	eventBroadcaster := event.NewEventBroadcaster(event.QueueOptions{
+		Size:     grpcServerConfig.SubscriberQueueSize,
+		Overflow: event.OverflowPolicy(grpcServerConfig.SubscriberOverflowPolicy),
+		Coalesce: grpcServerConfig.SubscriberCoalesce,
+	})
 
 	// initialize context and logger
 	logger := klog.NewKlogr().WithName("maestro-server")
@@ -120,6 +125,12 @@ func runServer(cmd *cobra.Command, args []string) {
 	// Start the event broadcaster
 	go eventBroadcaster.Start(ctx)
 
+	// Start saving the consumer agent heartbeats observed by this instance
+	go environments.Environment().Services.ConsumerHeartbeats().Start(ctx)
+
+	// Start delivering the audit events to the audit sinks
+	go environments.Environment().Services.AuditEvents().Start(ctx)
+
 	// Run the servers
 	go apiserver.Start(ctx)
 	go metricsServer.Start(ctx)
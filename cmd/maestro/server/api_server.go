@@ -2,9 +2,12 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/ghodss/yaml"
@@ -15,13 +18,15 @@ import (
 	"github.com/openshift-online/maestro/cmd/maestro/common"
 	"github.com/openshift-online/maestro/cmd/maestro/environments"
 	"github.com/openshift-online/maestro/data/generated/openapi"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/errors"
 	"github.com/openshift-online/maestro/pkg/event"
 )
 
 type apiServer struct {
-	httpServer *http.Server
-	grpcServer *GRPCServer
+	httpServer       *http.Server
+	grpcServer       *GRPCServer
+	watchBroadcaster *event.WatchBroadcaster // watch broadcaster to broadcast resource changes to REST watchers
 }
 
 var _ Server = &apiServer{}
@@ -31,7 +36,9 @@ func env() *environments.Env {
 }
 
 func NewAPIServer(ctx context.Context, eventBroadcaster *event.EventBroadcaster) Server {
-	s := &apiServer{}
+	s := &apiServer{
+		watchBroadcaster: event.NewWatchBroadcaster(),
+	}
 
 	mainRouter := s.routes(ctx)
 
@@ -45,6 +52,7 @@ func NewAPIServer(ctx context.Context, eventBroadcaster *event.EventBroadcaster)
 			http.MethodGet,
 			http.MethodPatch,
 			http.MethodPost,
+			http.MethodPut,
 		}),
 		gorillahandlers.AllowedHeaders([]string{
 			"Authorization",
@@ -89,6 +97,21 @@ func (s apiServer) Serve(ctx context.Context, listener net.Listener) {
 			)
 		}
 
+		if env().Config.HTTPServer.AuthNType == auth.AuthNTypeMTLS {
+			caPEM, err := os.ReadFile(env().Config.HTTPServer.ClientCAFile)
+			if err != nil {
+				check(ctx, fmt.Errorf("failed to read client CA file: %v", err), "Can't start https server")
+			}
+			certPool := x509.NewCertPool()
+			if ok := certPool.AppendCertsFromPEM(caPEM); !ok {
+				check(ctx, fmt.Errorf("failed to append client CA to cert pool"), "Can't start https server")
+			}
+			s.httpServer.TLSConfig = &tls.Config{
+				ClientCAs:  certPool,
+				ClientAuth: tls.RequireAndVerifyClientCert,
+			}
+		}
+
 		// Serve with TLS
 		logger.Info("Serving with TLS", "port", env().Config.HTTPServer.BindPort)
 		err = s.httpServer.ServeTLS(listener, env().Config.HTTPServer.HTTPSCertFile, env().Config.HTTPServer.HTTPSKeyFile)
@@ -117,6 +140,9 @@ func (s apiServer) Start(ctx context.Context) {
 		go s.grpcServer.Start(ctx)
 	}
 
+	// start the resource watch event source to serve the REST watch requests
+	newResourceWatchEventSource(s.watchBroadcaster).Start(ctx)
+
 	listener, err := s.Listen()
 	if err != nil {
 		logger.Error(err, "Unable to start API server")
@@ -13,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 	"k8s.io/klog/v2"
 
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
 )
 
@@ -25,9 +26,12 @@ const (
 	contextGroupsKey contextKey = "groups"
 )
 
+// newContextWithIdentity returns a copy of the context that carries the user and groups of the gRPC client, the
+// user is also carried as the identity of the caller so that the services record it, e.g. in the audit events.
 func newContextWithIdentity(ctx context.Context, user string, groups []string) context.Context {
 	ctx = context.WithValue(ctx, contextUserKey, user)
-	return context.WithValue(ctx, contextGroupsKey, groups)
+	ctx = context.WithValue(ctx, contextGroupsKey, groups)
+	return auth.NewContextWithIdentity(ctx, &auth.Identity{User: user, Groups: groups})
 }
 
 // identityFromCertificate retrieves the user and groups from the client certificate if they are present.
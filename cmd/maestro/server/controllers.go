@@ -15,19 +15,35 @@ func NewControllersServer(ctx context.Context, eventServer EventServer, eventFil
 	logger := klog.FromContext(ctx)
 
 	s := &ControllersServer{
-		StatusController: controllers.NewStatusController(
-			env().Services.StatusEvents(),
-			dao.NewInstanceDao(&env().Database.SessionFactory),
-			dao.NewEventInstanceDao(&env().Database.SessionFactory),
+		StatusController:    controllers.NewStatusController(env().Services.StatusEvents()),
+		PlacementController: controllers.NewPlacementController(env().Services.Placements()),
+		EventRetentionController: controllers.NewEventRetentionController(
+			db.NewAdvisoryLockFactory(env().Database.SessionFactory),
+			dao.NewEventPartitionDao(&env().Database.SessionFactory),
+			env().Config.EventController.EventRetention,
+			env().Config.EventController.StatusEventRetention,
 		),
 	}
 
 	// disable the spec controller if the message broker is disabled
 	if !env().Config.MessageBroker.Disable {
 		logger.V(4).Info("Message broker is enabled, setting up kind controller manager")
-		s.KindControllerManager = controllers.NewKindControllerManager(
+		eventControllerConfig := env().Config.EventController
+		flow, err := controllers.NewResourceEventFlow(
+			controllers.FairQueuing(eventControllerConfig.FairQueuing),
+			env().Services.Resources(),
+		)
+		if err != nil {
+			logger.Error(err, "Unable to set up the fair queuing of the kind controller manager, the events are queued in order")
+		}
+		s.KindControllerManager = controllers.NewKindControllerManagerWithConfig(
 			eventFilter,
 			env().Services.Events(),
+			controllers.EventQueueConfig{
+				Workers:         eventControllerConfig.Workers,
+				Flow:            flow,
+				PriorityClasses: eventControllerConfig.PriorityClasses,
+			},
 		)
 
 		s.KindControllerManager.Add(&controllers.ControllerConfig{
@@ -49,8 +65,10 @@ func NewControllersServer(ctx context.Context, eventServer EventServer, eventFil
 }
 
 type ControllersServer struct {
-	KindControllerManager *controllers.KindControllerManager
-	StatusController      *controllers.StatusController
+	KindControllerManager    *controllers.KindControllerManager
+	StatusController         *controllers.StatusController
+	PlacementController      *controllers.PlacementController
+	EventRetentionController *controllers.EventRetentionController
 
 	DB db.SessionFactory
 }
@@ -72,6 +90,18 @@ func (s ControllersServer) Start(ctx context.Context) {
 	logger.Info("Status controller listening for status events")
 	go env().Database.SessionFactory.NewListener(ctx, "status_events", s.StatusController.AddStatusEvent)
 
+	if s.PlacementController != nil {
+		logger.Info("Placement controller reconciling placements")
+		go s.PlacementController.Run(ctx)
+		logger.Info("Placement controller listening for placement changes")
+		go env().Database.SessionFactory.NewListener(ctx, dao.PlacementChannel, s.PlacementController.AddPlacement)
+	}
+
+	if s.EventRetentionController != nil {
+		logger.Info("Event retention controller maintaining event partitions")
+		go s.EventRetentionController.Run(ctx)
+	}
+
 	// block until the context is done
 	<-ctx.Done()
 }
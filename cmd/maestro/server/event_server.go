@@ -59,6 +59,8 @@ type MessageQueueEventServer struct {
 	statusEventService services.StatusEventService
 	sourceClient       cloudevents.SourceClient
 	statusDispatcher   dispatcher.Dispatcher
+	// consumerHeartbeatService records the status updates as the agent heartbeats
+	consumerHeartbeatService services.ConsumerHeartbeatService
 }
 
 func NewMessageQueueEventServer(eventBroadcaster *event.EventBroadcaster, statusDispatcher dispatcher.Dispatcher) EventServer {
@@ -72,6 +74,8 @@ func NewMessageQueueEventServer(eventBroadcaster *event.EventBroadcaster, status
 		statusEventService: env().Services.StatusEvents(),
 		sourceClient:       env().Clients.CloudEventsSource,
 		statusDispatcher:   statusDispatcher,
+
+		consumerHeartbeatService: env().Services.ConsumerHeartbeats(),
 	}
 }
 
@@ -104,6 +108,7 @@ func (s *MessageQueueEventServer) startSubscription(ctx context.Context) {
 			logger.Info("skipping resource status update as it is not owned by the current instance")
 			return nil
 		}
+		s.consumerHeartbeatService.RecordStatus(resource.ConsumerName, resource.AgentVersion)
 
 		// handle the resource status update according status update type
 		if err := HandleStatusUpdate(subCtx, resource, s.resourceService, s.statusEventService); err != nil {
@@ -260,6 +265,7 @@ func HandleStatusUpdate(ctx context.Context, resource *api.Resource, resourceSer
 		if updated {
 			_, sErr := statusEventService.Create(ctx, &api.StatusEvent{
 				ResourceID:      resource.ID,
+				ResourceSource:  resource.Source,
 				StatusEventType: api.StatusUpdateEventType,
 			})
 			if sErr != nil {
@@ -298,6 +304,8 @@ func broadcastStatusEvent(ctx context.Context,
 			Type:    statusEvent.ResourceType,
 			Payload: statusEvent.Payload,
 			Status:  statusEvent.Status,
+			// the observers of the consumer receive the status of the deleted resource
+			ConsumerName: consumerNameFromPayload(statusEvent.Payload),
 		}
 	} else {
 		resource, sErr = resourceService.Get(ctx, resourceID)
@@ -311,6 +319,9 @@ func broadcastStatusEvent(ctx context.Context,
 		}
 	}
 
+	// the subscribers use the revision to resume their subscriptions
+	resource.StatusRevision = statusEvent.Revision
+
 	// broadcast the resource status to subscribers
 	logger.Info("Broadcast the resource status",
 		"source", resource.Source, "statusEventType", statusEvent.StatusEventType)
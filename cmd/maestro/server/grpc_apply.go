@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+const (
+	// fieldManagerMetadataKey is the gRPC metadata key that a source client sets to the name of its field manager to
+	// publish a create or update request with the server-side apply semantics, the manifest bundle of the request is
+	// merged into the stored one instead of replacing it.
+	fieldManagerMetadataKey = "maestro-field-manager"
+
+	// forceApplyMetadataKey is the gRPC metadata key that a source client sets to "true" to take over the fields that
+	// are owned by the other field managers instead of failing the apply with a conflict.
+	forceApplyMetadataKey = "maestro-force-apply"
+)
+
+// fieldManagerFromContext returns the field manager of the server-side apply and whether the apply is forced, the
+// field manager is empty if the source client does not request the server-side apply.
+func fieldManagerFromContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	managers := md.Get(fieldManagerMetadataKey)
+	if len(managers) == 0 {
+		return "", false
+	}
+	force := md.Get(forceApplyMetadataKey)
+	return managers[0], len(force) > 0 && force[0] == "true"
+}
+
+// publishApply applies the resource of the create or update request as the field manager. The conflicts with the
+// other field managers are returned with the Aborted code, and the message names the owning managers.
+func (svr *GRPCServer) publishApply(ctx context.Context, manager string, force bool, res *api.Resource) error {
+	if _, serviceErr := svr.resourceService.Apply(ctx, manager, res, force); serviceErr != nil {
+		if serviceErr.Code == errors.ErrorConflict {
+			return status.Errorf(codes.Aborted, "failed to apply resource: %s", serviceErr.Reason)
+		}
+		return fmt.Errorf("failed to apply resource: %v", serviceErr)
+	}
+	return nil
+}
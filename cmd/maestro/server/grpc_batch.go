@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"k8s.io/klog/v2"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// batchRequestAction is the action of the CloudEvents that create, update or delete resources in a batch,
+// the data of the event is a resourceBatch.
+const batchRequestAction types.EventAction = "batch_request"
+
+// resourceBatch is the data of a batch request event. Each of the events is a create, update or delete request
+// event of a resource from the same source as the batch request event.
+type resourceBatch struct {
+	// Atomic applies all of the events or none of them, the events are applied independently if it is false.
+	Atomic bool       `json:"atomic"`
+	Events []ce.Event `json:"events"`
+}
+
+// publishBatch applies the events of a batch request event. The Publish response has no payload, so the failed
+// events are reported by the returned error, which lists the index, id and failure reason of each of them.
+func (svr *GRPCServer) publishBatch(ctx context.Context, evt *ce.Event) error {
+	batch := &resourceBatch{}
+	if err := evt.DataAs(batch); err != nil {
+		return fmt.Errorf("failed to decode resource batch: %v", err)
+	}
+	if len(batch.Events) == 0 {
+		return fmt.Errorf("the resource batch has no events")
+	}
+
+	operations := make([]services.ResourceOperation, 0, len(batch.Events))
+	for i := range batch.Events {
+		operations = append(operations, svr.prepareOperation(ctx, evt.Source(), &batch.Events[i]))
+	}
+
+	// the batch is applied in a transaction that is resolved after the batch, the same as the REST requests in
+	// the TransactionMiddleware, so the events are committed together with the resources.
+	txCtx, err := db.NewContext(ctx, svr.sessionFactory)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction for resource batch: %v", err)
+	}
+	defer db.Resolve(txCtx)
+	ctx = txCtx
+
+	results, serviceErr := svr.resourceService.Batch(ctx, operations, batch.Atomic)
+	if serviceErr != nil {
+		return fmt.Errorf("failed to apply resource batch: %v", serviceErr)
+	}
+
+	failures := []string{}
+	for i, result := range results {
+		if result.Error != nil {
+			failures = append(failures, fmt.Sprintf("[%d] %s: %s", i, batch.Events[i].ID(), result.Error.Reason))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to apply %d of %d events in the resource batch: %s",
+			len(failures), len(results), strings.Join(failures, "; "))
+	}
+
+	klog.FromContext(ctx).Info("applied resource batch", "events", len(results), "atomic", batch.Atomic)
+	return nil
+}
+
+// prepareOperation converts a request event of a batch to a resource operation, the error of the resource
+// operation is set if the event is invalid.
+func (svr *GRPCServer) prepareOperation(ctx context.Context, source string, evt *ce.Event) services.ResourceOperation {
+	// the source of the batch is authorized, the events in the batch must not be published for other sources.
+	if evt.Source() != source {
+		return services.ResourceOperation{
+			Error: errors.Forbidden("the source %s of the event is not the source %s of the batch", evt.Source(), source),
+		}
+	}
+
+	eventType, err := types.ParseCloudEventsType(evt.Type())
+	if err != nil {
+		return services.ResourceOperation{
+			Error: errors.Validation("failed to parse cloud event type %s, %v", evt.Type(), err),
+		}
+	}
+
+	res, err := decodeResourceSpec(evt)
+	if err != nil {
+		return services.ResourceOperation{Error: errors.Validation("failed to decode cloudevent: %v", err)}
+	}
+
+	switch eventType.Action {
+	case types.CreateRequestAction:
+		return services.ResourceOperation{Type: api.CreateEventType, Resource: res}
+	case types.UpdateRequestAction:
+		if res.Version == 0 {
+			// the resource version is not guaranteed to be increased by source client,
+			// using the latest resource version.
+			found, serviceErr := svr.resourceService.Get(ctx, res.ID)
+			if serviceErr != nil {
+				return services.ResourceOperation{Type: api.UpdateEventType, Error: serviceErr}
+			}
+			res.Version = found.Version
+		}
+		return services.ResourceOperation{Type: api.UpdateEventType, Resource: res}
+	case types.DeleteRequestAction:
+		return services.ResourceOperation{Type: api.DeleteEventType, Resource: res}
+	default:
+		return services.ResourceOperation{Error: errors.Validation("unsupported action %s in the resource batch", eventType.Action)}
+	}
+}
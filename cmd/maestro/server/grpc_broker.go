@@ -24,6 +24,7 @@ import (
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/client/cloudevents"
+	"github.com/openshift-online/maestro/pkg/controllers"
 	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/pkg/event"
 	"github.com/openshift-online/maestro/pkg/services"
@@ -34,20 +35,26 @@ const source = "maestro"
 var _ EventServer = &GRPCBroker{}
 
 type GRPCBrokerService struct {
-	resourceService    services.ResourceService
-	statusEventService services.StatusEventService
+	resourceService          services.ResourceService
+	statusEventService       services.StatusEventService
+	consumerHeartbeatService services.ConsumerHeartbeatService
 }
 
 func NewGRPCBrokerService(resourceService services.ResourceService,
-	statusEventService services.StatusEventService) *GRPCBrokerService {
+	statusEventService services.StatusEventService,
+	consumerHeartbeatService services.ConsumerHeartbeatService) *GRPCBrokerService {
 	return &GRPCBrokerService{
-		resourceService:    resourceService,
-		statusEventService: statusEventService,
+		resourceService:          resourceService,
+		statusEventService:       statusEventService,
+		consumerHeartbeatService: consumerHeartbeatService,
 	}
 }
 
 // List the cloudEvent from the service
 func (s *GRPCBrokerService) List(ctx context.Context, listOpts types.ListOptions) ([]*ce.Event, error) {
+	// the spec resync request of the agent is a heartbeat of the agent
+	s.consumerHeartbeatService.RecordHeartbeat(listOpts.ClusterName, "")
+
 	resources, err := s.resourceService.List(ctx, listOpts)
 	if err != nil {
 		return nil, err
@@ -72,6 +79,7 @@ func (s *GRPCBrokerService) HandleStatusUpdate(ctx context.Context, evt *ce.Even
 	if err != nil {
 		return fmt.Errorf("failed to decode cloudevent: %v", err)
 	}
+	s.consumerHeartbeatService.RecordStatus(resource.ConsumerName, resource.AgentVersion)
 
 	// handle the resource status update according status update type
 	if err := HandleStatusUpdate(ctx, resource, s.resourceService, s.statusEventService); err != nil {
@@ -88,15 +96,16 @@ func (s *GRPCBrokerService) RegisterHandler(ctx context.Context, handler server.
 
 // GRPCBroker is a gRPC broker that implements the CloudEventServiceServer interface.
 // It broadcasts resource spec to Maestro agents and listens for resource status updates from them.
-// TODO: Add support for multiple gRPC broker instances. When there are multiple instances of the Maestro server,
-// the work agent may be load-balanced across any instance. Each instance needs to handle the resource spec to
-// ensure all work agents receive all the resource spec.
+// When there are multiple instances of the Maestro server, the work agent may be load-balanced across any instance.
+// Each instance records the consumers whose agents are connected to it, and the spec events of a consumer are only
+// handled by the instance that the agent of the consumer is connected to.
 type GRPCBroker struct {
 	instanceID         string
 	bindAddress        string
 	grpcServer         *grpc.Server
 	eventServer        server.AgentEventServer
 	eventInstanceDao   dao.EventInstanceDao
+	connections        *consumerConnections
 	resourceService    services.ResourceService
 	eventService       services.EventService
 	statusEventService services.StatusEventService
@@ -176,18 +185,29 @@ func NewGRPCBroker(ctx context.Context, eventBroadcaster *event.EventBroadcaster
 		HeartbeatDisabled:      config.HeartbeatDisable,
 		HeartbeatCheckInterval: config.HeartbeatCheckInterval,
 	})
-	pbv1.RegisterCloudEventServiceServer(grpcServer, eventServer)
-	svc := NewGRPCBrokerService(resourceService, statusEventService)
+	instanceID := env().Config.MessageBroker.ClientID
+	eventService := env().Services.Events()
+	connections := newConsumerConnections(instanceID, dao.NewConsumerConnectionDao(&sessionFactory), eventService)
+	consumerHeartbeatService := env().Services.ConsumerHeartbeats()
+	pbv1.RegisterCloudEventServiceServer(grpcServer, &heartbeatCloudEventServiceServer{
+		CloudEventServiceServer: &connectionCloudEventServiceServer{
+			CloudEventServiceServer: eventServer,
+			connections:             connections,
+		},
+		consumerHeartbeatService: consumerHeartbeatService,
+	})
+	svc := NewGRPCBrokerService(resourceService, statusEventService, consumerHeartbeatService)
 	eventServer.RegisterService(context.Background(), workpayload.ManifestBundleEventDataType, svc)
 
 	return &GRPCBroker{
-		instanceID:         env().Config.MessageBroker.ClientID,
+		instanceID:         instanceID,
 		bindAddress:        env().Config.HTTPServer.Hostname + ":" + config.BrokerBindPort,
 		grpcServer:         grpcServer,
 		eventServer:        eventServer,
 		eventInstanceDao:   dao.NewEventInstanceDao(&sessionFactory),
+		connections:        connections,
 		resourceService:    resourceService,
-		eventService:       env().Services.Events(),
+		eventService:       eventService,
 		statusEventService: statusEventService,
 		eventBroadcaster:   eventBroadcaster,
 	}
@@ -201,6 +221,11 @@ func (bkr *GRPCBroker) Start(ctx context.Context) {
 		check(ctx, err, "Failed to start gRPC broker listener")
 	}
 
+	// the agents that were connected to the previous run of this instance have to reconnect
+	if err := bkr.connections.consumerConnectionDao.DeleteByInstanceIDs(ctx, []string{bkr.instanceID}); err != nil {
+		check(ctx, err, "Failed to remove the stale consumer connections")
+	}
+
 	go func() {
 		if err := bkr.grpcServer.Serve(ln); err != nil {
 			check(ctx, err, "gRPC broker terminated with errors")
@@ -275,6 +300,8 @@ func (s *GRPCBroker) OnStatusUpdate(ctx context.Context, eventID, resourceID str
 // PredicateEvent checks if the event should be processed by the current instance
 // by verifying the resource consumer name is in the subscriber list, ensuring the
 // event will be only processed when the consumer is subscribed to the current broker.
+// The event is skipped if the agent of the consumer is not connected to the current instance, it is notified
+// again once the agent connects to an instance.
 func (s *GRPCBroker) PredicateEvent(ctx context.Context, eventID string) (bool, error) {
 	logger := klog.FromContext(ctx)
 	evt, err := s.eventService.Get(ctx, eventID)
@@ -284,7 +311,7 @@ func (s *GRPCBroker) PredicateEvent(ctx context.Context, eventID string) (bool,
 
 	// fast return if the event is already reconciled
 	if evt.ReconciledDate != nil {
-		return false, nil
+		return false, controllers.ErrEventSkipped
 	}
 
 	resource, svcErr := s.resourceService.Get(ctx, evt.SourceID)
@@ -297,13 +324,27 @@ func (s *GRPCBroker) PredicateEvent(ctx context.Context, eventID string) (bool,
 			if _, svcErr := s.eventService.Replace(ctx, evt); svcErr != nil {
 				return false, fmt.Errorf("failed to mark event with id (%s) as reconciled: %s", evt.ID, svcErr)
 			}
-			return false, nil
+			return false, controllers.ErrEventSkipped
 		}
 		return false, fmt.Errorf("failed to get resource %s: %s", evt.SourceID, svcErr.Error())
 	}
 
 	// check if the consumer is subscribed to the broker
-	return s.eventServer.Subscribers().Has(resource.ConsumerName), nil
+	if s.eventServer.Subscribers().Has(resource.ConsumerName) {
+		return true, nil
+	}
+
+	// the agent is connecting to the current instance, retry the event until the agent is subscribed
+	connected, err := s.connections.isConnectedTo(ctx, resource.ConsumerName, s.instanceID)
+	if err != nil {
+		return false, err
+	}
+	if connected {
+		return false, nil
+	}
+
+	logger.V(4).Info("The consumer is not connected to this instance, skip the event", "consumer", resource.ConsumerName)
+	return false, controllers.ErrEventSkipped
 }
 
 // DecodeResourceStatus translates a CloudEvent into a resource containing the status JSON map.
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+	pbv1 "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protobuf/v1"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// consumerConnections records the consumers whose agents are connected to the current instance, so that the spec
+// events of a consumer are routed to the instance that owns the subscription stream of its agent.
+type consumerConnections struct {
+	mu sync.Mutex
+	// streams is the number of the subscription streams by consumer name, an agent may reconnect before its
+	// previous stream is closed.
+	streams map[string]int
+
+	instanceID            string
+	consumerConnectionDao dao.ConsumerConnectionDao
+	eventService          services.EventService
+}
+
+func newConsumerConnections(instanceID string, consumerConnectionDao dao.ConsumerConnectionDao,
+	eventService services.EventService) *consumerConnections {
+	return &consumerConnections{
+		streams:               map[string]int{},
+		instanceID:            instanceID,
+		consumerConnectionDao: consumerConnectionDao,
+		eventService:          eventService,
+	}
+}
+
+// connect records the connection of the consumer and notifies its unreconciled spec events again, so that the
+// events that were skipped while the agent was not connected are handled by the current instance.
+func (c *consumerConnections) connect(ctx context.Context, consumerName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.streams[consumerName] > 0 {
+		c.streams[consumerName]++
+		return nil
+	}
+
+	if err := c.consumerConnectionDao.Create(ctx, &api.ConsumerConnection{
+		ConsumerName: consumerName,
+		InstanceID:   c.instanceID,
+	}); err != nil {
+		return fmt.Errorf("failed to record the connection of consumer %s: %v", consumerName, err)
+	}
+	c.streams[consumerName] = 1
+
+	if svcErr := c.eventService.NotifyUnreconciledEvents(ctx, consumerName); svcErr != nil {
+		c.disconnectLocked(ctx, consumerName)
+		return svcErr.AsError()
+	}
+	return nil
+}
+
+// disconnect removes the connection of the consumer once all of its subscription streams are closed.
+func (c *consumerConnections) disconnect(ctx context.Context, consumerName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.disconnectLocked(ctx, consumerName)
+}
+
+func (c *consumerConnections) disconnectLocked(ctx context.Context, consumerName string) {
+	c.streams[consumerName]--
+	if c.streams[consumerName] > 0 {
+		return
+	}
+	delete(c.streams, consumerName)
+
+	if err := c.consumerConnectionDao.Delete(ctx, consumerName, c.instanceID); err != nil {
+		klog.FromContext(ctx).Error(err, "Failed to remove the connection of consumer", "consumer", consumerName)
+	}
+}
+
+// isConnectedTo checks if the agent of the consumer is connecting or connected to the instance.
+func (c *consumerConnections) isConnectedTo(ctx context.Context, consumerName, instanceID string) (bool, error) {
+	connections, err := c.consumerConnectionDao.FindByConsumerName(ctx, consumerName)
+	if err != nil {
+		return false, fmt.Errorf("failed to find the connections of consumer %s: %v", consumerName, err)
+	}
+	for _, connection := range connections {
+		if connection.InstanceID == instanceID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// connectionCloudEventServiceServer records the consumer connections of the agent subscriptions until their
+// subscription streams are closed.
+type connectionCloudEventServiceServer struct {
+	pbv1.CloudEventServiceServer
+	connections *consumerConnections
+}
+
+func (s *connectionCloudEventServiceServer) Subscribe(req *pbv1.SubscriptionRequest, stream pbv1.CloudEventService_SubscribeServer) error {
+	if clusterName := req.GetClusterName(); clusterName != "" {
+		// the connection is removed with a new context, since the stream context is already done by then
+		ctx := klog.NewContext(context.Background(), klog.FromContext(stream.Context()))
+		if err := s.connections.connect(stream.Context(), clusterName); err != nil {
+			klog.FromContext(ctx).Error(err, "Failed to connect consumer", "consumer", clusterName)
+			return status.Errorf(codes.Unavailable, "failed to connect consumer %s", clusterName)
+		}
+		defer s.connections.disconnect(ctx, clusterName)
+	}
+	return s.CloudEventServiceServer.Subscribe(req, stream)
+}
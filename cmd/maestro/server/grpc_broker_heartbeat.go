@@ -0,0 +1,22 @@
+package server
+
+import (
+	pbv1 "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protobuf/v1"
+
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// heartbeatCloudEventServiceServer records the agent subscriptions as the agent connections, the agent is
+// connected to the instance until its subscription stream is closed.
+type heartbeatCloudEventServiceServer struct {
+	pbv1.CloudEventServiceServer
+	consumerHeartbeatService services.ConsumerHeartbeatService
+}
+
+func (s *heartbeatCloudEventServiceServer) Subscribe(req *pbv1.SubscriptionRequest, stream pbv1.CloudEventService_SubscribeServer) error {
+	if clusterName := req.GetClusterName(); clusterName != "" {
+		s.consumerHeartbeatService.Connect(clusterName)
+		defer s.consumerHeartbeatService.Disconnect(clusterName)
+	}
+	return s.CloudEventServiceServer.Subscribe(req, stream)
+}
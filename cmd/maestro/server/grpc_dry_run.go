@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+)
+
+const (
+	// dryRunMetadataKey is the gRPC metadata key that a source client sets to "true" to publish a create or
+	// update request in a dry run, the request is validated and compared with the stored resource without
+	// being applied.
+	dryRunMetadataKey = "maestro-dry-run"
+
+	// dryRunDiffMetadataKey is the gRPC response header that carries the JSON encoded ResourceBundleDiff of a
+	// dry run, it is a binary header because the manifests may have non-ASCII values.
+	dryRunDiffMetadataKey = "maestro-dry-run-diff-bin"
+)
+
+// isDryRunFromContext returns true if the source client requests a dry run.
+func isDryRunFromContext(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(dryRunMetadataKey)
+	return len(values) > 0 && values[0] == "true"
+}
+
+// publishDryRun computes the changes of the create or update request and sends them back in the response
+// header, an InvalidArgument error is returned if the resource would be rejected by the validation.
+func (svr *GRPCServer) publishDryRun(ctx context.Context, action types.EventAction, res *api.Resource) error {
+	var eventType api.EventType
+	switch action {
+	case types.CreateRequestAction:
+		eventType = api.CreateEventType
+	case types.UpdateRequestAction:
+		eventType = api.UpdateEventType
+		if res.Version == 0 {
+			// the resource version is not guaranteed to be increased by source client,
+			// using the latest resource version.
+			found, err := svr.resourceService.Get(ctx, res.ID)
+			if err != nil {
+				return fmt.Errorf("failed to get resource: %v", err)
+			}
+			res.Version = found.Version
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "dry run is not supported for the action %s", action)
+	}
+
+	diff, serviceErr := svr.resourceService.DryRun(ctx, eventType, res)
+	if serviceErr != nil {
+		return fmt.Errorf("failed to dry run resource: %v", serviceErr)
+	}
+
+	diffJSON, err := json.Marshal(presenters.PresentResourceDiff(diff))
+	if err != nil {
+		return fmt.Errorf("failed to marshal resource diff: %v", err)
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(dryRunDiffMetadataKey, string(diffJSON))); err != nil {
+		return fmt.Errorf("failed to set dry run header: %v", err)
+	}
+
+	if len(diff.ValidationErrors) > 0 {
+		return status.Errorf(codes.InvalidArgument, "the resource is invalid: %s", strings.Join(diff.ValidationErrors, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	cetypes "github.com/cloudevents/sdk-go/v2/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	pbv1 "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options/grpc/protobuf/v1"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/event"
+)
+
+const (
+	// observeSource is the source of the subscription requests of the observers, an observer receives the status
+	// of the resources on the observed consumers whatever source created them.
+	observeSource = "*"
+
+	// consumerSelectorMetadataKey is the gRPC metadata key that an observer uses to observe the consumers by a label
+	// selector, otherwise the observer observes the consumer of the cluster name of its subscription request.
+	consumerSelectorMetadataKey = "maestro-consumer-selector"
+
+	// observeAction is the authorization action of the observers on the consumers.
+	observeAction = "observe"
+
+	// allConsumers is the consumer that an observer is authorized on to observe the consumers by a label selector,
+	// since the selected consumers change over time.
+	allConsumers = "*"
+)
+
+// observedConsumersResyncPeriod is the period to find the consumers that match the label selector of an observer
+// again, so that the observer follows the created and relabeled consumers.
+var observedConsumersResyncPeriod = 30 * time.Second
+
+// consumerSelectorFromContext returns the consumer label selector of the observer, false is returned if the
+// observer does not observe the consumers by a label selector.
+func consumerSelectorFromContext(ctx context.Context) (labels.Selector, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, false, nil
+	}
+
+	values := md.Get(consumerSelectorMetadataKey)
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+
+	selector, err := labels.Parse(values[0])
+	if err != nil {
+		return nil, false, status.Errorf(codes.InvalidArgument, "invalid %s %q: %v", consumerSelectorMetadataKey, values[0], err)
+	}
+
+	return selector, true, nil
+}
+
+// authorizeObserver checks if the observer is authorized to observe the consumer of its subscription request, or
+// all of the consumers if it observes the consumers by a label selector.
+func (svr *GRPCServer) authorizeObserver(ctx context.Context, subReq *pbv1.SubscriptionRequest, bySelector bool) error {
+	consumer := subReq.ClusterName
+	if bySelector {
+		consumer = allConsumers
+	}
+
+	user := ctx.Value(contextUserKey).(string)
+	groups := ctx.Value(contextGroupsKey).([]string)
+	allowed, err := svr.grpcAuthorizer.AccessReview(ctx, observeAction, "consumer", consumer, user, groups)
+	if err != nil {
+		return fmt.Errorf("failed to authorize the request: %v", err)
+	}
+	if !allowed {
+		return fmt.Errorf("unauthorized to observe the consumer %s", consumer)
+	}
+	return nil
+}
+
+// newObserverFilter returns the filter of the resources on the consumers that the observer observes. The consumers
+// that match the label selector of the observer are found again periodically until the context is done.
+func (svr *GRPCServer) newObserverFilter(ctx context.Context, subReq *pbv1.SubscriptionRequest,
+	selector labels.Selector) (event.ResourceFilter, error) {
+	if selector == nil {
+		consumer := subReq.ClusterName
+		return func(res *api.Resource) bool {
+			return res.ConsumerName == consumer
+		}, nil
+	}
+
+	observed := &observedConsumers{}
+	if err := observed.resync(ctx, svr, selector); err != nil {
+		return nil, err
+	}
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := observed.resync(ctx, svr, selector); err != nil {
+			klog.FromContext(ctx).Error(err, "Failed to find the observed consumers", "selector", selector.String())
+		}
+	}, observedConsumersResyncPeriod)
+
+	return observed.has, nil
+}
+
+// observedConsumers is the set of the consumers that match the label selector of an observer.
+type observedConsumers struct {
+	mu    sync.RWMutex
+	names sets.Set[string]
+}
+
+func (o *observedConsumers) resync(ctx context.Context, svr *GRPCServer, selector labels.Selector) error {
+	consumers, svcErr := svr.consumerService.FindByLabelSelector(ctx, selector)
+	if svcErr != nil {
+		return fmt.Errorf("failed to find consumers by label selector %s: %s", selector, svcErr)
+	}
+
+	names := sets.New[string]()
+	for _, consumer := range consumers {
+		names.Insert(consumer.Name)
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.names = names
+	return nil
+}
+
+func (o *observedConsumers) has(res *api.Resource) bool {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.names.Has(res.ConsumerName)
+}
+
+// consumerNameFromPayload returns the consumer name from the clustername extension of the resource spec payload,
+// an empty string is returned if the payload has no consumer name. It is used for the deleted resources that
+// are built from their status events.
+func consumerNameFromPayload(payload datatypes.JSONMap) string {
+	evt, err := api.JSONMAPToCloudEvent(payload)
+	if err != nil {
+		return ""
+	}
+
+	consumerName, err := cetypes.ToString(evt.Extensions()[types.ExtensionClusterName])
+	if err != nil {
+		return ""
+	}
+	return consumerName
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	cetypes "github.com/cloudevents/sdk-go/v2/types"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+// patchRequestAction is the action of the CloudEvents that patch the manifest bundle of a resource, the data of the
+// event is a JSON patch (RFC 6902) or a JSON merge patch (RFC 7386) and the data content type of the event is
+// application/json-patch+json or application/merge-patch+json.
+const patchRequestAction types.EventAction = "patch_request"
+
+// decodeResourcePatch decodes the resource to update from a patch request event. The resourceid extension is
+// required, the resourceversion extension is optional and the patch is applied to the latest version if it is not
+// set.
+func decodeResourcePatch(evt *ce.Event) (*api.Resource, error) {
+	evtExtensions := evt.Context.GetExtensions()
+
+	resourceID, err := cetypes.ToString(evtExtensions[types.ExtensionResourceID])
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resourceid extension: %v", err)
+	}
+
+	resource := &api.Resource{
+		Meta:      api.Meta{ID: resourceID},
+		Source:    evt.Source(),
+		Patch:     evt.Data(),
+		PatchType: api.PatchType(evt.DataContentType()),
+	}
+
+	if value, ok := evtExtensions[types.ExtensionResourceVersion]; ok {
+		resourceVersion, err := cetypes.ToInteger(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get resourceversion extension: %v", err)
+		}
+		resource.Version = resourceVersion
+	}
+
+	if len(resource.Patch) == 0 {
+		return nil, fmt.Errorf("the patch of resource %s is empty", resourceID)
+	}
+	return resource, nil
+}
+
+// publishPatch applies the patch of a patch request event to the manifest bundle of the resource, or dry runs it.
+func (svr *GRPCServer) publishPatch(ctx context.Context, evt *ce.Event, dryRun bool) error {
+	res, err := decodeResourcePatch(evt)
+	if err != nil {
+		return fmt.Errorf("failed to decode cloudevent: %v", err)
+	}
+
+	if dryRun {
+		return svr.publishDryRun(ctx, types.UpdateRequestAction, res)
+	}
+
+	if _, serviceErr := svr.resourceService.Update(ctx, res); serviceErr != nil {
+		return fmt.Errorf("failed to patch resource: %v", serviceErr)
+	}
+	return nil
+}
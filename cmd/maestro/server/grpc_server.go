@@ -4,9 +4,11 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
 	"os"
+	"strconv"
 	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
@@ -14,8 +16,10 @@ import (
 	cetypes "github.com/cloudevents/sdk-go/v2/types"
 	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"k8s.io/klog/v2"
 	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
@@ -29,7 +33,9 @@ import (
 	"github.com/openshift-online/maestro/pkg/client/cloudevents"
 	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
 	"github.com/openshift-online/maestro/pkg/config"
+	"github.com/openshift-online/maestro/pkg/db"
 	"github.com/openshift-online/maestro/pkg/event"
+	loggertracing "github.com/openshift-online/maestro/pkg/logger"
 	"github.com/openshift-online/maestro/pkg/services"
 )
 
@@ -39,11 +45,16 @@ type GRPCServer struct {
 	grpcServer             *grpc.Server
 	eventBroadcaster       *event.EventBroadcaster
 	resourceService        services.ResourceService
+	statusEventService     services.StatusEventService
+	consumerService        services.ConsumerService
+	sessionFactory         db.SessionFactory
+	instanceID             string
 	disableAuthorizer      bool
 	grpcAuthorizer         grpcauthorizer.GRPCAuthorizer
 	bindAddress            string
 	heartbeatCheckInterval time.Duration
 	heartbeatDisable       bool
+	statusBookmarkInterval time.Duration
 }
 
 // NewGRPCServer creates a new GRPCServer
@@ -140,11 +151,16 @@ func NewGRPCServer(
 		grpcServer:             grpc.NewServer(grpcServerOptions...),
 		eventBroadcaster:       eventBroadcaster,
 		resourceService:        resourceService,
+		statusEventService:     env().Services.StatusEvents(),
+		consumerService:        env().Services.Consumers(),
+		sessionFactory:         env().Database.SessionFactory,
+		instanceID:             env().Config.MessageBroker.ClientID,
 		disableAuthorizer:      disableTLS,
 		grpcAuthorizer:         grpcAuthorizer,
 		bindAddress:            env().Config.HTTPServer.Hostname + ":" + config.ServerBindPort,
 		heartbeatCheckInterval: config.HeartbeatCheckInterval,
 		heartbeatDisable:       config.HeartbeatDisable,
+		statusBookmarkInterval: config.StatusBookmarkInterval,
 	}
 }
 
@@ -178,6 +194,11 @@ func (svr *GRPCServer) Publish(ctx context.Context, pubReq *pbv1.PublishRequest)
 	logger = sdkgologging.SetLogTracingByCloudEvent(logger, evt)
 	ctx = klog.NewContext(ctx, logger)
 
+	// the event ID is the request ID of the audit events if the client does not trace the operation
+	if loggertracing.GetOperationID(ctx) == "" {
+		ctx = context.WithValue(ctx, loggertracing.OpIDKey, evt.ID())
+	}
+
 	if !svr.disableAuthorizer {
 		// check if the event is from the authorized source
 		user := ctx.Value(contextUserKey).(string)
@@ -216,11 +237,49 @@ func (svr *GRPCServer) Publish(ctx context.Context, pubReq *pbv1.PublishRequest)
 		return &emptypb.Empty{}, nil
 	}
 
+	dryRun := isDryRunFromContext(ctx)
+
+	// handle resource batch request
+	if eventType.Action == batchRequestAction {
+		if dryRun {
+			return nil, fmt.Errorf("dry run is not supported for the batch request")
+		}
+		if err := svr.publishBatch(ctx, evt); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	// handle resource patch request
+	if eventType.Action == patchRequestAction {
+		if err := svr.publishPatch(ctx, evt, dryRun); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
 	res, err := decodeResourceSpec(evt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode cloudevent: %v", err)
 	}
 
+	// nothing is applied in a dry run, the changes are sent back in the response header
+	if dryRun {
+		if err := svr.publishDryRun(ctx, eventType.Action, res); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
+	// the create and update requests of a field manager are merged into the stored resource
+	if manager, force := fieldManagerFromContext(ctx); manager != "" &&
+		(eventType.Action == types.CreateRequestAction || eventType.Action == types.UpdateRequestAction) {
+		if err := svr.publishApply(ctx, manager, force, res); err != nil {
+			return nil, err
+		}
+		return &emptypb.Empty{}, nil
+	}
+
 	switch eventType.Action {
 	case types.CreateRequestAction:
 		_, err := svr.resourceService.Create(ctx, res)
@@ -253,27 +312,63 @@ func (svr *GRPCServer) Publish(ctx context.Context, pubReq *pbv1.PublishRequest)
 	return &emptypb.Empty{}, nil
 }
 
-// Subscribe implements the Subscribe method of the CloudEventServiceServer interface
+// Subscribe implements the Subscribe method of the CloudEventServiceServer interface.
+// A subscriber can resume its subscription by setting the maestro-since-revision metadata with the revision of
+// the last status bookmark it received, the missed status changes after the revision are replayed before the
+// live status changes, and the status bookmark events are sent to the subscriber periodically.
 func (svr *GRPCServer) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv1.CloudEventService_SubscribeServer) error {
+	// an observer subscribes the status of the resources on the consumers from all of the sources
+	observing := subReq.Source == observeSource
+	selector, bySelector, err := consumerSelectorFromContext(subServer.Context())
+	if err != nil {
+		return err
+	}
+	if observing && bySelector == (subReq.ClusterName != "") {
+		return status.Errorf(codes.InvalidArgument,
+			"either the cluster name or the %s must be specified to observe the consumers", consumerSelectorMetadataKey)
+	}
+
 	if !svr.disableAuthorizer {
-		// check if the client is authorized to subscribe the event from the source
-		ctx := subServer.Context()
-		user := ctx.Value(contextUserKey).(string)
-		groups := ctx.Value(contextGroupsKey).([]string)
-		allowed, err := svr.grpcAuthorizer.AccessReview(ctx, "sub", "source", subReq.Source, user, groups)
-		if err != nil {
-			return fmt.Errorf("failed to authorize the request: %v", err)
-		}
-		if !allowed {
-			return fmt.Errorf("unauthorized to subscribe the event from source %s", subReq.Source)
+		if observing {
+			if err := svr.authorizeObserver(subServer.Context(), subReq, bySelector); err != nil {
+				return err
+			}
+		} else {
+			// check if the client is authorized to subscribe the event from the source
+			ctx := subServer.Context()
+			user := ctx.Value(contextUserKey).(string)
+			groups := ctx.Value(contextGroupsKey).([]string)
+			allowed, err := svr.grpcAuthorizer.AccessReview(ctx, "sub", "source", subReq.Source, user, groups)
+			if err != nil {
+				return fmt.Errorf("failed to authorize the request: %v", err)
+			}
+			if !allowed {
+				return fmt.Errorf("unauthorized to subscribe the event from source %s", subReq.Source)
+			}
 		}
 	}
 
+	sinceRevision, resumable, err := sinceRevisionFromContext(subServer.Context())
+	if err != nil {
+		return err
+	}
+	if observing && resumable {
+		return status.Errorf(codes.InvalidArgument, "the %s is not supported to observe the consumers", sinceRevisionMetadataKey)
+	}
+
 	ctx, cancel := context.WithCancel(subServer.Context())
 	defer cancel()
 
-	// TODO make the channel size configurable
-	eventCh := make(chan *pbv1.CloudEvent, 100)
+	var filter event.ResourceFilter
+	if observing {
+		if filter, err = svr.newObserverFilter(ctx, subReq, selector); err != nil {
+			return err
+		}
+	}
+
+	// the status events are queued for the subscriber by the event broadcaster, the channel is not buffered so that
+	// the queue of the subscriber reflects how far the subscriber lags behind.
+	eventCh := make(chan *pbv1.CloudEvent)
 	heartbeatCh := make(chan *pbv1.CloudEvent, 10)
 	sendErrCh := make(chan error, 1)
 
@@ -315,7 +410,7 @@ func (svr *GRPCServer) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv
 		}
 	}()
 
-	clientID := svr.eventBroadcaster.Register(ctx, subReq.Source, func(res *api.Resource) error {
+	sendStatus := func(res *api.Resource) error {
 		evt, err := encodeResourceStatus(res)
 		if err != nil {
 			return fmt.Errorf("failed to encode cloudevent: %v", err)
@@ -346,7 +441,78 @@ func (svr *GRPCServer) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv
 		}
 
 		return nil
-	})
+	}
+
+	// register the subscriber before replaying the missed status changes to avoid missing the live status
+	// changes, the live status changes are held until the replay is finished.
+	gate := &statusReplayGate{replaying: resumable}
+	// the status changes that were dropped from the queue of the subscriber are replayed once the subscriber catches
+	// up, the queued status changes that were covered by the replay are skipped. The resync and the handler are
+	// called in turn by the event broadcaster.
+	var resyncedRevision int64
+	resync := func(sinceRevision int64) (int64, error) {
+		replayedRevision, err := svr.replayStatusRevisions(ctx, subReq.Source, sinceRevision, sendStatus)
+		if err != nil {
+			return sinceRevision, err
+		}
+		resyncedRevision = replayedRevision
+		return replayedRevision, nil
+	}
+	// the bookmarks are queued with the status changes, so a bookmark is sent after the status changes up to its
+	// revision that are queued for the subscriber.
+	bookmark := func(revision int64) error {
+		pbEvt := &pbv1.CloudEvent{}
+		if err := grpcprotocol.WritePBMessage(ctx, binding.ToMessage(newStatusBookmarkEvent(revision)), pbEvt); err != nil {
+			return fmt.Errorf("failed to convert status bookmark event to protobuf: %v", err)
+		}
+		select {
+		case eventCh <- pbEvt:
+		case <-ctx.Done():
+		}
+		return nil
+	}
+	handler := func(res *api.Resource) error {
+		if gate.hold(res) {
+			return nil
+		}
+		if res.StatusRevision > 0 && res.StatusRevision <= resyncedRevision {
+			return nil
+		}
+		return sendStatus(res)
+	}
+	var clientID string
+	var disconnectedCh <-chan error
+	if observing {
+		clientID, disconnectedCh = svr.eventBroadcaster.RegisterObserver(ctx, subReq.Source, filter, handler)
+	} else {
+		// the subscriber that does not resume its subscription has received no status changes, the status changes
+		// that are dropped from its queue are replayed from the revision that this instance has handled up to.
+		// The whole journal is replayed if the revision is not found.
+		startRevision := sinceRevision
+		if !resumable {
+			revision, svcErr := svr.statusEventService.FindBookmarkRevision(ctx, svr.instanceID)
+			if svcErr != nil {
+				logger.Error(svcErr, "failed to find status bookmark revision, the dropped status changes are replayed from the start")
+			}
+			startRevision = revision
+		}
+		clientID, disconnectedCh = svr.eventBroadcaster.Register(ctx, subReq.Source, startRevision, handler, resync, bookmark)
+	}
+
+	if resumable {
+		replayedRevision, err := svr.replayStatusRevisions(ctx, subReq.Source, sinceRevision, sendStatus)
+		if err == nil {
+			err = gate.release(replayedRevision, sendStatus)
+		}
+		if err != nil {
+			logger.Error(err, "failed to replay status changes, unregister subscriber", "subscriber", clientID, "sinceRevision", sinceRevision)
+			svr.eventBroadcaster.Unregister(ctx, clientID)
+			return err
+		}
+		logger.Info("replayed status changes", "subscriber", clientID, "sinceRevision", sinceRevision, "replayedRevision", replayedRevision)
+
+		go svr.sendStatusBookmarks(ctx, clientID)
+	}
 
 	if !svr.heartbeatDisable {
 		go func() {
@@ -379,12 +545,52 @@ func (svr *GRPCServer) Subscribe(subReq *pbv1.SubscriptionRequest, subServer pbv
 		logger.Error(err, "failed to send event, unregister subscriber", "subscriber", clientID)
 		svr.eventBroadcaster.Unregister(ctx, clientID)
 		return err
+	case err := <-disconnectedCh:
+		// the subscriber was unregistered by the event broadcaster, it has to resync the status after it reconnects.
+		logger.Error(err, "subscriber is disconnected by the event broadcaster", "subscriber", clientID)
+		if errors.Is(err, event.ErrQueueOverflow) {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return err
 	case <-ctx.Done():
 		svr.eventBroadcaster.Unregister(ctx, clientID)
 		return nil
 	}
 }
 
+// sendStatusBookmarks queues a status bookmark for the subscriber right after the replay and then periodically
+// until the subscription is closed. The bookmarks are queued after the status events of the subscriber, so the
+// status changes up to the bookmark revision have been sent to the subscriber once it receives the bookmark event.
+func (svr *GRPCServer) sendStatusBookmarks(ctx context.Context, clientID string) {
+	logger := klog.FromContext(ctx)
+
+	var ticker *time.Ticker
+	if svr.statusBookmarkInterval > 0 {
+		ticker = time.NewTicker(svr.statusBookmarkInterval)
+		defer ticker.Stop()
+	}
+
+	for {
+		revision, svcErr := svr.statusEventService.FindBookmarkRevision(ctx, svr.instanceID)
+		if svcErr != nil {
+			logger.Error(svcErr, "failed to find status bookmark revision")
+		} else {
+			svr.eventBroadcaster.Bookmark(ctx, clientID, revision)
+		}
+
+		if ticker == nil {
+			<-ctx.Done()
+			return
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // decodeResourceSpec translates a CloudEvent into a resource containing the spec JSON map.
 func decodeResourceSpec(evt *ce.Event) (*api.Resource, error) {
 	evtExtensions := evt.Context.GetExtensions()
@@ -462,6 +668,10 @@ func encodeResourceStatus(resource *api.Resource) (*ce.Event, error) {
 		return nil, err
 	}
 
+	if resource.StatusRevision > 0 {
+		statusEvt.SetExtension(statusRevisionExtension, strconv.FormatInt(resource.StatusRevision, 10))
+	}
+
 	return statusEvt, nil
 }
 
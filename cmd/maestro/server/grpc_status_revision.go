@@ -0,0 +1,182 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+const (
+	// sinceRevisionMetadataKey is the gRPC metadata key that a status subscriber uses to resume its subscription,
+	// the missed status changes after the given revision are replayed before the live status changes. The revision
+	// is not a field of the SubscriptionRequest, which is defined by sdk-go, the clients set it with
+	// grpcsource.WithSinceRevision.
+	sinceRevisionMetadataKey = "maestro-since-revision"
+
+	// statusRevisionExtension is the cloudevent extension that carries the revision of a status change.
+	statusRevisionExtension = "statusrevision"
+
+	// statusBookmarkCloudEventsType is the cloudevent type of the status bookmark events, a bookmark event tells
+	// the subscriber that all of the status changes up to its revision have been sent.
+	statusBookmarkCloudEventsType = "io.openshift-online.maestro.status.bookmark"
+
+	// statusReplayBatchSize is the number of the status revisions that are read from the journal at a time.
+	statusReplayBatchSize = 500
+)
+
+// sinceRevisionFromContext returns the revision that the subscriber resumes its subscription from, false is
+// returned if the subscriber does not request to resume the subscription.
+func sinceRevisionFromContext(ctx context.Context) (int64, bool, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false, nil
+	}
+
+	values := md.Get(sinceRevisionMetadataKey)
+	if len(values) == 0 {
+		return 0, false, nil
+	}
+
+	revision, err := strconv.ParseInt(values[0], 10, 64)
+	if err != nil || revision < 0 {
+		return 0, false, status.Errorf(codes.InvalidArgument, "invalid %s %q", sinceRevisionMetadataKey, values[0])
+	}
+
+	return revision, true, nil
+}
+
+// replayStatusRevisions sends the latest status of the resources that were changed after the given revision
+// to the subscriber in the revision order, and returns the latest replayed revision. The status changes of a
+// resource are compacted, only the latest status of the resource is sent.
+func (svr *GRPCServer) replayStatusRevisions(ctx context.Context, source string, sinceRevision int64,
+	send func(res *api.Resource) error) (int64, error) {
+	firstRevision, svcErr := svr.statusEventService.FirstRevision(ctx)
+	if svcErr != nil {
+		return sinceRevision, fmt.Errorf("failed to get the first status revision: %s", svcErr)
+	}
+	if firstRevision > 0 && sinceRevision < firstRevision-1 {
+		// the missed status changes were purged, the subscriber has to resync the status.
+		return sinceRevision, status.Errorf(codes.OutOfRange,
+			"status revision %d is too old, the oldest resumable revision is %d", sinceRevision, firstRevision-1)
+	}
+
+	latest := map[string]*api.StatusRevision{}
+	replayedRevision := sinceRevision
+	for {
+		revisions, svcErr := svr.statusEventService.FindRevisionsBySource(ctx, source, replayedRevision, statusReplayBatchSize)
+		if svcErr != nil {
+			return sinceRevision, fmt.Errorf("failed to list status revisions: %s", svcErr)
+		}
+		for _, revision := range revisions {
+			latest[revision.ResourceID] = revision
+			replayedRevision = revision.Revision
+		}
+		if len(revisions) < statusReplayBatchSize {
+			break
+		}
+	}
+
+	revisions := make(api.StatusRevisionList, 0, len(latest))
+	for _, revision := range latest {
+		revisions = append(revisions, revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+
+	for _, revision := range revisions {
+		var res *api.Resource
+		if revision.StatusEventType == api.StatusDeleteEventType {
+			res = &api.Resource{
+				Meta: api.Meta{
+					ID: revision.ResourceID,
+				},
+				Source:  revision.ResourceSource,
+				Payload: revision.Payload,
+				Status:  revision.Status,
+			}
+		} else {
+			found, svcErr := svr.resourceService.Get(ctx, revision.ResourceID)
+			if svcErr != nil {
+				if svcErr.Is404() {
+					// the resource is being deleted, its status delete revision is not recorded yet.
+					continue
+				}
+				return sinceRevision, fmt.Errorf("failed to get resource %s: %s", revision.ResourceID, svcErr)
+			}
+			res = found
+		}
+
+		res.StatusRevision = revision.Revision
+		if err := send(res); err != nil {
+			return sinceRevision, err
+		}
+	}
+
+	return replayedRevision, nil
+}
+
+// newStatusBookmarkEvent creates a status bookmark event with the given revision.
+func newStatusBookmarkEvent(revision int64) *ce.Event {
+	evt := ce.NewEvent()
+	evt.SetID(uuid.New().String())
+	evt.SetType(statusBookmarkCloudEventsType)
+	evt.SetSource(statusBookmarkCloudEventsType)
+	evt.SetExtension(statusRevisionExtension, strconv.FormatInt(revision, 10))
+	return &evt
+}
+
+// statusReplayGate holds the live status changes while the missed status changes are being replayed,
+// so that the subscriber receives the status changes in order.
+type statusReplayGate struct {
+	mu        sync.Mutex
+	replaying bool
+	pending   []*api.Resource
+}
+
+// hold holds the status change if the replay is in progress and returns true, otherwise returns false.
+func (g *statusReplayGate) hold(res *api.Resource) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.replaying {
+		return false
+	}
+
+	g.pending = append(g.pending, res)
+	return true
+}
+
+// release sends the held status changes that were not covered by the replay and stops holding the
+// status changes.
+func (g *statusReplayGate) release(replayedRevision int64, send func(res *api.Resource) error) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	defer func() {
+		g.replaying = false
+		g.pending = nil
+	}()
+
+	for _, res := range g.pending {
+		if res.StatusRevision != 0 && res.StatusRevision <= replayedRevision {
+			// the latest status of the resource has been replayed
+			continue
+		}
+		if err := send(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
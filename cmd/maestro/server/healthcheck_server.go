@@ -22,6 +22,7 @@ type HealthCheckServer struct {
 	httpServer        *http.Server
 	lockFactory       db.LockFactory
 	instanceDao       dao.InstanceDao
+	connectionDao     dao.ConsumerConnectionDao
 	instanceID        string
 	heartbeatInterval int
 	brokerType        string
@@ -40,6 +41,7 @@ func NewHealthCheckServer(ctx context.Context) *HealthCheckServer {
 		httpServer:        srv,
 		lockFactory:       db.NewAdvisoryLockFactory(sessionFactory),
 		instanceDao:       dao.NewInstanceDao(&sessionFactory),
+		connectionDao:     dao.NewConsumerConnectionDao(&sessionFactory),
 		instanceID:        env().Config.MessageBroker.ClientID,
 		heartbeatInterval: env().Config.HealthCheck.HeartbeartInterval,
 		brokerType:        env().Config.MessageBroker.MessageBrokerType,
@@ -172,6 +174,11 @@ func (s *HealthCheckServer) checkInstances(ctx context.Context) {
 		if err := s.instanceDao.MarkUnreadyByIDs(ctx, inactiveInstanceIDs); err != nil {
 			logger.Error(err, "Unable to mark inactive maestro instances", "inactiveInstanceIDs", inactiveInstanceIDs)
 		}
+		// the consumers of the inactive instances are not connected to them anymore, the connections are removed so
+		// the events of the consumers are not routed to the instances that are gone.
+		if err := s.connectionDao.DeleteByInstanceIDs(ctx, inactiveInstanceIDs); err != nil {
+			logger.Error(err, "Unable to remove the consumer connections of inactive maestro instances", "inactiveInstanceIDs", inactiveInstanceIDs)
+		}
 	}
 }
 
@@ -29,6 +29,11 @@ func (writer *loggingWriter) WriteHeader(status int) {
 	writer.ResponseWriter.WriteHeader(status)
 }
 
+// Flush sends any buffered data to the client, it is required by the streaming responses.
+func (writer *loggingWriter) Flush() {
+	_ = http.NewResponseController(writer.ResponseWriter).Flush()
+}
+
 func (writer *loggingWriter) log(logLevel int, logMsg string, err error) {
 	switch err {
 	case nil:
@@ -189,3 +189,8 @@ func (w *metricsResponseWrapper) WriteHeader(code int) {
 	w.code = code
 	w.wrapped.WriteHeader(code)
 }
+
+// Flush sends any buffered data to the client, it is required by the streaming responses.
+func (w *metricsResponseWrapper) Flush() {
+	_ = http.NewResponseController(w.wrapped).Flush()
+}
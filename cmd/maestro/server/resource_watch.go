@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/event"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// resourceWatchEventSource listens to the resource spec and status events from the database and
+// broadcasts them to the resource watchers of the current instance.
+// Every instance receives all of the events, so the watchers can see the changes of the resources
+// no matter which instance handles the events.
+type resourceWatchEventSource struct {
+	eventService       services.EventService
+	statusEventService services.StatusEventService
+	watchBroadcaster   *event.WatchBroadcaster
+}
+
+func newResourceWatchEventSource(watchBroadcaster *event.WatchBroadcaster) *resourceWatchEventSource {
+	return &resourceWatchEventSource{
+		eventService:       env().Services.Events(),
+		statusEventService: env().Services.StatusEvents(),
+		watchBroadcaster:   watchBroadcaster,
+	}
+}
+
+// Start starts listening to the resource spec and status events.
+func (s *resourceWatchEventSource) Start(ctx context.Context) {
+	logger := klog.FromContext(ctx).WithName("resource-watch-event-source")
+	ctx = klog.NewContext(ctx, logger)
+
+	logger.Info("Resource watch event source listening for events")
+	env().Database.SessionFactory.NewListener(ctx, "events", func(id string) { s.onEvent(ctx, id) })
+	env().Database.SessionFactory.NewListener(ctx, "status_events", func(id string) { s.onStatusEvent(ctx, id) })
+}
+
+// onEvent translates a resource spec event to the resource watch event.
+func (s *resourceWatchEventSource) onEvent(ctx context.Context, id string) {
+	logger := klog.FromContext(ctx).WithValues("eventID", id)
+
+	evt, svcErr := s.eventService.Get(ctx, id)
+	if svcErr != nil {
+		if !svcErr.Is404() {
+			logger.Error(svcErr, "failed to get event")
+		}
+		return
+	}
+
+	if evt.Source != "Resources" {
+		return
+	}
+
+	watchEvent := &event.WatchEvent{ResourceID: evt.SourceID}
+	switch evt.EventType {
+	case api.CreateEventType:
+		watchEvent.Type = event.WatchEventAdded
+	case api.UpdateEventType, api.DeleteEventType:
+		// the resource is marked as deleting for the delete event, it is deleted until
+		// its deletion is confirmed by the agent
+		watchEvent.Type = event.WatchEventModified
+	default:
+		return
+	}
+
+	s.watchBroadcaster.Broadcast(watchEvent)
+}
+
+// onStatusEvent translates a resource status event to the resource watch event.
+func (s *resourceWatchEventSource) onStatusEvent(ctx context.Context, id string) {
+	logger := klog.FromContext(ctx).WithValues("statusEventID", id)
+
+	statusEvent, svcErr := s.statusEventService.Get(ctx, id)
+	if svcErr != nil {
+		if !svcErr.Is404() {
+			logger.Error(svcErr, "failed to get status event")
+		}
+		return
+	}
+
+	watchEvent := &event.WatchEvent{ResourceID: statusEvent.ResourceID}
+	switch statusEvent.StatusEventType {
+	case api.StatusUpdateEventType:
+		watchEvent.Type = event.WatchEventModified
+	case api.StatusDeleteEventType:
+		watchEvent.Type = event.WatchEventDeleted
+	default:
+		return
+	}
+
+	s.watchBroadcaster.Broadcast(watchEvent)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/openshift-online/maestro/cmd/maestro/server/logging"
 	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/db"
 	"github.com/openshift-online/maestro/pkg/handlers"
 	"github.com/openshift-online/maestro/pkg/logger"
@@ -22,9 +23,13 @@ func (s *apiServer) routes(ctx context.Context) *mux.Router {
 		check(ctx, err, "Can't load OpenAPI specification")
 	}
 
-	resourceBundleHandler := handlers.NewResourceBundleHandler(services.Resources(), services.Generic())
-	consumerHandler := handlers.NewConsumerHandler(services.Consumers(), services.Resources(), services.Generic())
+	resourceBundleHandler := handlers.NewResourceBundleHandler(services.Resources(), services.Generic(), s.watchBroadcaster)
+	consumerHandler := handlers.NewConsumerHandler(services.Consumers(), services.Resources(), services.Generic(),
+		services.ConsumerHeartbeats())
+	placementHandler := handlers.NewPlacementHandler(services.Placements(), services.Generic())
+	auditEventHandler := handlers.NewAuditEventHandler(services.Generic())
 	errorsHandler := handlers.NewErrorsHandler()
+	authMiddleware := auth.NewAuthMiddleware(env().Clients.RESTAuthenticator, env().Clients.RESTAuthorizer)
 
 	// mainRouter is top level "/"
 	mainRouter := mux.NewRouter()
@@ -56,18 +61,40 @@ func (s *apiServer) routes(ctx context.Context) *mux.Router {
 
 	// /api/maestro/v1/resource-bundles
 	apiV1ResourceBundleRouter := apiV1Router.PathPrefix("/resource-bundles").Subrouter()
+	apiV1ResourceBundleRouter.Use(authMiddleware)
 	apiV1ResourceBundleRouter.HandleFunc("", resourceBundleHandler.List).Methods(http.MethodGet)
 	apiV1ResourceBundleRouter.HandleFunc("/{id}", resourceBundleHandler.Get).Methods(http.MethodGet)
+	apiV1ResourceBundleRouter.HandleFunc("", resourceBundleHandler.Create).Methods(http.MethodPost)
+	apiV1ResourceBundleRouter.HandleFunc("/batch", resourceBundleHandler.Batch).Methods(http.MethodPost)
+	apiV1ResourceBundleRouter.HandleFunc("/{id}", resourceBundleHandler.Patch).Methods(http.MethodPatch)
+	apiV1ResourceBundleRouter.HandleFunc("/{id}", resourceBundleHandler.Replace).Methods(http.MethodPut)
 	apiV1ResourceBundleRouter.HandleFunc("/{id}", resourceBundleHandler.Delete).Methods(http.MethodDelete)
+	apiV1ResourceBundleRouter.HandleFunc("/{id}/revisions", resourceBundleHandler.Revisions).Methods(http.MethodGet)
+	apiV1ResourceBundleRouter.HandleFunc("/{id}/rollback", resourceBundleHandler.Rollback).Methods(http.MethodPost)
 
 	//  /api/maestro/v1/consumers
 	apiV1ConsumersRouter := apiV1Router.PathPrefix("/consumers").Subrouter()
+	apiV1ConsumersRouter.Use(authMiddleware)
 	apiV1ConsumersRouter.HandleFunc("", consumerHandler.List).Methods(http.MethodGet)
 	apiV1ConsumersRouter.HandleFunc("/{id}", consumerHandler.Get).Methods(http.MethodGet)
 	apiV1ConsumersRouter.HandleFunc("", consumerHandler.Create).Methods(http.MethodPost)
 	apiV1ConsumersRouter.HandleFunc("/{id}", consumerHandler.Patch).Methods(http.MethodPatch)
 	apiV1ConsumersRouter.HandleFunc("/{id}", consumerHandler.Delete).Methods(http.MethodDelete)
 
+	//  /api/maestro/v1/placements
+	apiV1PlacementsRouter := apiV1Router.PathPrefix("/placements").Subrouter()
+	apiV1PlacementsRouter.Use(authMiddleware)
+	apiV1PlacementsRouter.HandleFunc("", placementHandler.List).Methods(http.MethodGet)
+	apiV1PlacementsRouter.HandleFunc("/{id}", placementHandler.Get).Methods(http.MethodGet)
+	apiV1PlacementsRouter.HandleFunc("", placementHandler.Create).Methods(http.MethodPost)
+	apiV1PlacementsRouter.HandleFunc("/{id}", placementHandler.Patch).Methods(http.MethodPatch)
+	apiV1PlacementsRouter.HandleFunc("/{id}", placementHandler.Delete).Methods(http.MethodDelete)
+
+	//  /api/maestro/v1/audit-events
+	apiV1AuditEventsRouter := apiV1Router.PathPrefix("/audit-events").Subrouter()
+	apiV1AuditEventsRouter.Use(authMiddleware)
+	apiV1AuditEventsRouter.HandleFunc("", auditEventHandler.List).Methods(http.MethodGet)
+
 	return mainRouter
 }
 
@@ -76,7 +103,15 @@ func registerApiMiddleware(router *mux.Router) {
 
 	router.Use(
 		func(next http.Handler) http.Handler {
-			return db.TransactionMiddleware(next, env().Database.SessionFactory)
+			txHandler := db.TransactionMiddleware(next, env().Database.SessionFactory)
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// the watch requests are long-lived, do not hold a database transaction for them
+				if handlers.IsWatchRequest(r) {
+					next.ServeHTTP(w, r)
+					return
+				}
+				txHandler.ServeHTTP(w, r)
+			})
 		},
 	)
 
@@ -77,7 +77,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _openapiYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\x6d\x8f\xdb\xb8\x11\xfe\xbe\xbf\x62\x80\xb6\xf0\xe5\xe0\xb7\xf4\xae\x40\x2b\x24\x07\x24\x77\x97\x22\x87\x5c\x92\xee\x26\x6d\x81\xa2\xf0\xd2\xe4\xc8\xe2\xad\x44\x2a\x24\xb5\xbb\x4e\xdb\xff\x5e\x90\xd4\xbb\x65\xad\xec\xdb\x8d\xdd\xad\xf7\x4b\x2c\x6a\x38\x9c\x21\x9f\x79\x34\x43\x32\x32\x45\x41\x52\x1e\xc0\x37\xd3\xf9\x74\x7e\xc6\x45\x28\x83\x33\x00\xc3\x4d\x8c\x01\x24\x04\xb5\x51\x12\x2e\x50\x5d\x73\x8a\xf0\xe2\xfd\xeb\x33\x00\x86\x9a\x2a\x9e\x1a\x2e\xc5\x36\x91\x6b\x54\xda\xbd\x9e\x4f\xe7\xd3\xa7\x67\x1a\x95\x6d\xb1\x9a\x27\x90\xa9\x38\x80\xc8\x98\x34\x98\xcd\x62\x49\x49\x1c\x49\x6d\x82\x3f\xce\xe7\xf3\x33\x80\x96\x76\x9a\x29\x85\xc2\x00\x93\x09\xe1\xa2\xd9\x5d\x07\xb3\x19\x49\xf9\xd4\xba\xa0\x23\x1e\x9a\x29\x95\xc9\xa6\x8a\x9f\x09\x17\xf0\x55\xaa\x24\xcb\xa8\x6d\x79\x02\xde\x9a\x6e\x65\xda\x90\x15\xde\xa5\xf2\xc2\x90\x15\x17\xab\x42\x51\x4a\x4c\xe4\x7c\xb3\x1a\x66\xf9\x84\xcc\xae\x9f\xce\x14\x6a\x99\x29\x8a\x93\x65\x26\x58\x8c\x4e\x06\x60\x85\xc6\xff\x00\xd0\x59\x92\x10\xb5\x0e\xe0\x1c\x4d\xa6\x84\x06\x02\x31\xd7\x06\x64\x08\x45\x5f\xc8\xfb\x16\x3d\x90\x66\x8a\x9b\x75\xa1\xc1\x3a\xf1\x12\x89\x42\x15\xc0\x3f\xfe\x99\x37\x2a\xd4\xa9\x14\xba\x18\xd0\xfe\x8d\x7e\x3f\x9f\x8f\xaa\xc7\x96\x43\x2f\xe0\xa7\x8b\x77\x6f\x81\x28\x45\xd6\x1d\x83\x83\x5c\xfe\x82\xd4\xe8\x5a\x77\x2a\x85\x41\x61\xea\x1a\x01\x48\x9a\xc6\x9c\x12\xab\x73\xf6\x8b\x96\xa2\xf9\x16\x40\xd3\x08\x13\xd2\x6e\x05\xf8\xad\xc2\x30\x80\xd1\x6f\x66\x54\x26\xa9\x14\x28\x8c\x9e\x79\x59\x3d\x3b\xcf\x4d\x79\xe9\x2c\x79\xc3\xb5\x19\x55\x4e\x7d\x3b\x7f\xda\xe3\x54\x66\x22\x30\xf2\x0a\x05\x70\x0d\x5c\x5c\x93\x98\xb3\x43\xb8\xf0\xa3\x52\x52\x35\xac\xfe\x66\xbb\xd5\x1f\x05\xc9\x4c\x24\x15\xff\x8c\x0c\x8c\x84\x14\x55\x28\x55\x02\x32\x45\xe5\xcc\x3a\x06\x0f\xfe\xd0\x07\xa6\x8f\x02\x6f\x53\xa4\x06\x19\xa0\xed\x07\x92\xba\x30\x3e\xfc\xdc\xa7\x44\x91\x04\x4d\xce\x44\xe0\x82\xa7\xab\x73\x25\x37\x4b\xc9\x0a\x47\x43\x85\x35\xff\xbc\x83\x30\x12\x45\xa3\xc1\xe2\x52\x31\x54\x2f\xd7\x83\xe5\x43\x8e\x31\xd3\x95\x38\x17\x01\x44\x48\x98\x23\x3e\xff\x27\x48\x82\x01\xfc\x7d\xf2\xae\x80\xd6\xe4\xf5\x0f\x67\xdb\x27\xdb\xac\x53\x0c\x40\x1b\xc5\xc5\x6a\x00\xd9\xcd\xfe\xc5\xd9\x7f\xb6\x33\xde\x9f\xd1\x00\xd9\x20\x9a\xe5\x1a\xca\x18\x7d\x18\xaa\x3b\x6f\x8d\x18\xca\x4c\xb0\xc6\xb8\x5f\x14\x9f\x4d\x7a\x3b\x51\xdb\x11\x78\xf0\xed\x76\x0f\xde\xca\x0d\xc4\xde\x70\x13\x81\x4e\x91\xf2\x90\x23\x03\xce\x00\x6f\xb9\x3e\xcc\xb7\xf2\xff\x97\xa6\x39\x7b\x40\xa6\xb3\x13\x17\xa3\xc1\x0d\x0e\xfb\xc1\x35\x6f\xd2\xd8\xaf\x27\xb0\x1e\x0c\xb6\x09\xcc\xdb\xc6\x40\x67\x94\xa2\xd6\x61\x16\xc7\xeb\x3a\x9c\x7b\x20\xf0\x57\x4b\x1b\x6e\x3e\x3c\x04\xf4\xf1\x60\xe0\xc4\x80\x27\x06\xfc\xe2\x0c\xe8\x42\xc9\x16\x76\xdd\xf1\x7c\x38\x4f\xf6\x66\xc4\x76\x92\x46\xa5\xd0\x59\x52\xea\x19\x56\x8a\x96\x9d\xbe\x68\x0d\x5a\x8c\x7a\xc8\xe2\xf3\xfb\xdc\x86\x53\xd9\x79\x14\xcc\xf4\x68\xf2\x99\x1d\x0b\xcf\x1d\x4b\xcf\x9d\x8b\xcf\xdd\xcb\xcf\x1d\x0b\xd0\x54\xea\x4d\xa2\xf9\x5e\x21\x71\xd9\x93\xc0\x9b\x32\xda\x77\xa3\x98\x4f\x19\x6a\xf3\x52\xb2\x9a\x5c\x03\x13\x45\xfc\x02\x23\x86\x94\x22\xb6\x1f\x57\xc8\x02\x30\x2a\xab\xc8\xbd\x03\x1c\xfd\xd0\xe8\x06\xc6\x10\x3e\x19\xf5\x92\x64\x0f\xb9\xf8\x39\x3b\x08\xa4\xdb\xb6\x9f\x92\xcb\x13\x85\xef\xe3\xc1\x9f\x7a\xd0\x5d\x84\x2b\x89\x15\x12\xb6\xfe\x5f\x49\x24\x5f\x08\xc8\xb6\x7d\x7d\x80\xda\x90\xb5\x49\xa5\x89\xb0\x4d\x73\x87\x71\x69\x6b\x52\x38\x68\xcb\xae\xcc\xcb\x1e\x7e\xaf\xae\xc4\xc3\x81\x37\xe9\x3a\xa9\xef\xc4\x1f\xc7\x58\x9c\x96\xe8\x7c\x3c\x55\xe9\x91\x7c\x36\x53\x62\x68\xb4\xc1\x09\x1f\x53\xe6\x92\x38\xf1\x40\x19\x9c\xd7\xcf\xaa\x75\x3d\xb2\x4c\xee\xbd\x9d\x95\x73\xef\x46\x7f\x56\x37\x84\xe7\xb2\xdc\xdb\xce\xbd\xbc\x43\x13\xde\x29\xd7\xfb\xc2\x56\x9f\xb8\xfa\x28\x9c\x78\x84\x09\xeb\xc6\x37\xc6\x11\x8f\x4d\x52\x8f\x22\x41\xbd\xfb\xc0\x65\xbf\x8f\xcd\x8e\x27\x2d\xd5\xee\xc1\xe9\x88\xe5\xc4\x8c\xf7\xeb\xc1\x23\x60\xc6\x3d\xcf\x56\x8e\x84\x61\xf6\x3c\x52\xa9\xde\xd8\x6e\x05\xef\x5c\x58\xfd\x05\xb1\xe4\xc4\x93\x6b\xf5\x67\xc9\x91\x31\xe9\x59\xcd\x6e\x0c\x60\xe9\xc4\xf2\x46\xff\xf0\x4a\xaa\x84\x98\x00\x7e\xfa\xdb\x87\xb3\xc2\xc1\x5c\xe9\x3b\x77\x08\x72\x8e\x21\x2a\x14\x14\x9b\xda\xfd\x09\x49\xde\x94\x2a\x0b\x75\xc3\xeb\x3c\xc7\x59\x7d\x9e\x36\x8e\xb7\xed\xdf\x15\x17\x77\x0b\x45\x76\x82\xfa\x84\xde\xf0\x6a\xa3\x77\xa0\x6d\x83\x06\x4e\xc9\x0a\x37\x85\xb8\x30\xb8\xaa\x21\x49\xf3\xcf\x03\xa4\x8c\x34\x24\xbe\x4b\xac\xac\x2c\x6a\x5f\x14\x6b\x69\xed\xd1\xda\x54\x7b\xb4\x83\xd7\x1e\xdd\x28\xb5\x67\x6e\x30\xf1\x61\xeb\x40\x58\xe8\x25\x71\xfc\x2e\xec\x47\x60\x01\xde\x16\x04\xaa\x0b\x0f\x1d\x13\xdd\x3d\xd5\x36\xd2\x18\x36\x43\xa6\x73\xba\xad\xff\x64\x23\xe6\xb6\x88\x96\xcc\xba\x68\xc2\xac\xa3\x83\x73\xbd\x8e\x91\x1d\xdc\xaf\x9f\xc1\xed\xe4\xb3\x9b\xf9\x2e\xc3\xdc\x51\x63\xa3\xbd\x43\x74\x30\xa1\x34\x6f\x72\x1d\x68\x7d\xdd\x45\x97\x21\x8b\x56\xb0\xf0\x62\x70\x8f\xe2\x4a\x77\x87\x6c\x3b\xc2\xc0\x6f\x7a\x22\x5b\x10\x33\x48\x37\x40\x98\x53\x9f\xad\x7f\x27\x86\x27\xf5\x33\xf7\xbc\x2a\xbe\x1f\x65\x79\x2e\x77\x3f\xca\x12\x34\x84\x11\x43\xba\x54\xb5\xd6\x0b\x20\x21\x82\x87\xa8\xcd\xaf\xc1\xe2\x16\xd5\xde\xa9\x85\xf4\x1f\xdf\x5d\x8c\x59\x50\x29\x42\xbe\x7a\x00\x9b\xb4\x21\x26\xeb\xd4\x5b\x13\xdd\xbc\xdd\xfd\x58\x98\xa1\xeb\x62\x67\x51\x50\x74\xfa\xb8\x27\x3d\x6c\x75\x79\x9b\xd3\x5d\x24\xd1\x03\xff\x98\x2c\x31\x1e\xba\xe6\xce\x29\xc6\xb8\x85\x21\x89\xdf\x6f\x19\xbf\x77\xbc\x6d\xcc\xd1\xd3\xa5\x3f\x46\xb7\xf3\xc7\x1e\x2a\xeb\x17\x42\xf6\x5a\xc5\xe6\x4d\x92\x9d\x97\xae\x27\x00\x37\x31\xbb\x45\x7c\x97\xad\xbf\xae\x6d\xce\x1d\xf3\xbb\x4d\x00\x6d\xf1\xf9\x6e\xe0\xb4\x96\xab\x9d\xc2\x57\xe9\x87\xbf\xef\x59\x56\xab\x5c\x04\x90\x12\x13\xe5\x8f\x8d\x42\xe5\x43\x84\xb6\xac\x72\xff\xe9\x85\x4a\xc5\xda\xf9\x5f\x7d\x67\xb9\x5d\x72\x6c\xc0\xa7\x9e\xa6\x7a\x1b\x6a\x49\xa2\xb5\xe2\x53\x86\x6a\xdd\x65\xc6\x7b\xb2\x42\x10\x59\xb2\x44\x55\xd9\xe2\x6f\x60\xdd\x44\x28\x1a\x0d\x78\x4b\x11\x99\xae\xd5\x85\x76\x94\x7a\x02\xda\x6d\x68\xfb\x33\xcd\x30\x24\x59\x6c\x02\x78\x5a\x36\x25\x5c\xf0\x24\x4b\xaa\xa6\x6a\x1e\x42\x12\x6b\xaf\xbf\x9e\x66\x7b\x2f\x6b\x43\xf7\x7a\xf9\x33\xb9\xb5\xea\x37\x1c\xd5\xb6\x52\x57\xee\xe2\xd9\x9e\x1e\xe4\xff\x79\xac\xe1\xc3\xbc\xcf\x07\x77\x01\xa6\xe5\x85\x6b\xdb\xe2\x47\x97\x92\x96\x77\xff\x9e\x94\x36\x5c\xe4\x4b\xa3\xdd\xa9\xaf\x57\x0c\x54\x71\x83\x8a\x93\xa9\x03\x9d\x5e\x0b\x43\x6e\xed\x1c\x98\x88\xeb\x0a\xcc\xc0\xab\xea\x5e\xf3\x84\xc7\x44\xd9\xd9\x31\xad\x2e\x08\x8b\x9b\x08\x15\x2e\x80\xc6\x24\xd3\x68\x5b\x89\x80\x8b\xbf\xbc\x71\x5f\x5e\x4c\x50\x98\x71\xa9\x28\xd3\xc5\x09\xb4\x75\x55\x17\x2a\x6c\x5d\x0d\xc4\x18\xc5\x97\x99\x41\x0d\x33\xa0\x32\xce\x12\xd1\x94\x22\x94\xca\x4c\x98\x29\x94\xea\x5e\x49\x05\x78\x4b\x92\x34\xc6\x31\x70\x01\xee\x76\x50\xbe\x86\x8a\xe3\x35\x5a\x52\xac\xf7\xd5\x7e\x27\x83\x40\xa6\x51\x59\xe5\x95\x8b\x86\x28\xb7\x2f\xe0\x04\x2e\x93\xf5\x65\x70\x56\xbe\xbc\xbc\xbc\xd4\x9f\xe2\x9a\x17\xbe\x33\xc4\xfc\x0a\x61\x94\xac\x7f\x37\xaa\x8b\x56\xfd\x3e\x6c\x4e\x3a\x50\x22\x80\xc4\x5a\xc2\x12\xfd\xde\x02\x32\x90\x36\xb0\x62\x77\xe4\x52\x5c\xf9\x9c\xee\xe1\xa4\xce\x96\x25\x0c\xb4\x27\x3c\x74\xc7\xd5\x97\xa1\x94\xcf\x97\x44\x5d\x8e\xb7\xfa\x54\xef\xbb\xf0\x5c\x39\xbd\xc2\x35\x3c\x87\x51\x28\xe5\x08\x88\x60\x9d\x32\xd7\x24\xce\xd0\x4a\x2d\x89\xda\x32\x0b\xaf\xfd\xf2\xd5\x91\x25\x46\xc6\x92\xf4\x35\x67\xc8\xc6\x20\x15\x70\x2f\xe3\xb5\x71\x0d\x98\xa4\x66\x3d\xb6\x6d\xd5\x46\xd9\xc6\x5a\x9a\x88\x18\xd7\x62\x17\x04\x22\xa2\x21\x45\x95\x70\x6d\xeb\x03\x3b\x41\x1a\x11\x6e\x78\x1c\xc3\xb2\x5a\x67\x1f\xdd\xc8\xa6\x43\xb9\x34\xbf\x71\xd6\x0c\xd1\xbc\xf1\x01\x62\xd4\xaf\xee\x72\x7d\xef\x51\x5a\x28\x1e\x16\xa8\xcb\xcc\xec\x1c\xac\xad\x30\xdd\x11\xc0\xe5\xaa\xba\xd7\x1e\xb7\x45\xa0\x0d\x08\x45\xa2\x69\x37\xfa\xde\xa9\xfd\xc6\x84\x05\x11\x6c\x01\x21\x57\xda\xc0\x70\x23\xc6\xbe\xc7\xdb\x5e\x9b\xee\x2b\x22\x84\x04\xbc\x4d\x63\x4e\xb9\xf1\x2e\x78\x02\x73\x88\x2f\xc8\x65\x30\xd0\xfd\x45\xc9\x26\xce\x7d\xdb\xfd\xc0\x3c\x73\xf6\x68\x77\x6a\x92\x24\x64\xa2\xd1\xfa\x6f\x39\xaf\xb8\xe0\xed\x47\xb3\xab\xb4\xc4\x8d\x40\x05\x78\xe5\x5f\xcb\xd0\x12\xd1\x44\x1b\x95\x51\x93\x29\xab\x51\xb8\xc4\xc9\x65\x9e\xda\xae\x06\x3c\x2b\xdf\x7e\x37\x7d\xe6\xd4\x7e\x07\x42\x1a\xb7\x3d\x54\x29\x7c\xa6\x4d\x21\xf4\x35\x24\x48\x84\x76\xa8\x70\xf2\x4e\x21\x94\x6a\xca\x3e\x3f\x7a\x20\x07\x1e\xd5\x84\x46\x70\x51\x63\x45\x6b\xfb\x0a\x0d\x70\x36\x76\x9b\x94\x63\x48\x63\x22\xbe\xe2\xcc\xd9\x78\xc5\x05\x7b\xe2\x7e\x79\xf2\x84\xaf\xca\xe1\xf4\x93\x06\xba\xca\xdf\x92\x26\x4e\x61\x93\xda\x27\x93\x0a\x3a\xbe\xfb\x73\xce\xc6\x6e\x40\x3b\xde\x94\x33\xff\xaf\x1d\x70\x9c\x13\xf5\xd7\xcd\x5e\x68\x68\xf4\xc6\xbd\x79\xde\xb8\xb3\x50\x0d\xde\x0b\x98\xff\x06\x00\x00\xff\xff\x71\x4d\x52\xfa\xb0\x3f\x00\x00")
+var _openapiYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfb\x8f\xdc\x36\x92\xff\xef\xfd\x57\xf0\x8b\xef\x1d\x26\xd9\xeb\xe9\x99\x3c\x16\xb8\x6b\xc4\x01\x1c\x3b\x3e\x78\xcf\x89\x7d\x33\xce\xe6\x80\xc5\x62\xcc\x96\xaa\xbb\xb9\x96\x48\x85\xa4\x66\xdc\x9b\xcb\xff\x7e\x28\x3e\xf4\xa4\xd4\x92\xa6\x9d\x1e\x4f\x1a\x13\x20\x6e\x89\x8f\x2a\xb2\xf8\xa9\x62\x55\x91\x12\x19\x70\x9a\xb1\x25\xf9\x6a\x71\xb9\xb8\x9c\x31\xbe\x16\xcb\x19\x21\x9a\xe9\x04\x96\x24\xa5\xa0\xb4\x14\xe4\x1a\xe4\x2d\x8b\x80\x3c\x7d\xf3\x72\x46\x48\x0c\x2a\x92\x2c\xd3\x4c\xf0\xae\x22\xb7\x20\x95\x79\x7d\xb9\xb8\x5c\x7c\x31\x53\x20\xf1\x09\xb6\x7c\x4e\x72\x99\x2c\xc9\x56\xeb\x6c\x79\x71\x91\x88\x88\x26\x5b\xa1\xf4\xf2\xdf\x2f\x2f\x2f\x67\x84\x34\x5a\x8f\x72\x29\x81\x6b\x12\x8b\x94\x32\x5e\xaf\xae\x96\x17\x17\x34\x63\x0b\x64\x41\x6d\xd9\x5a\x2f\x22\x91\xb6\x9b\xf8\x81\x32\x4e\x3e\xcb\xa4\x88\xf3\x08\x9f\x7c\x4e\x2c\x35\xe1\xc6\x94\xa6\x1b\xd8\xd7\xe4\xb5\xa6\x1b\xc6\x37\xbe\xa1\x8c\xea\xad\xe1\x0d\xc9\xb9\x70\x03\x72\x71\xfb\xc5\x85\x04\x25\x72\x19\xc1\xf9\x2a\xe7\x71\x02\xa6\x0c\x21\x1b\xd0\xf6\x1f\x84\xa8\x3c\x4d\xa9\xdc\x2d\xc9\x15\xe8\x5c\x72\x45\x28\x49\x98\xd2\x44\xac\x89\xaf\x4b\x5c\x5d\x5f\x03\xa2\x5c\x32\xbd\xf3\x2d\x20\x13\xdf\x01\x95\x20\x97\xe4\x6f\x7f\x77\x0f\x25\xa8\x4c\x70\xe5\x3b\xc4\xbf\xb3\x2f\x2f\x2f\xcf\xca\x9f\x0d\x86\x9e\x92\xbf\x5c\xbf\xfe\x91\x50\x29\xe9\x2e\xd0\x39\x11\xab\x7f\x40\xa4\x55\xa5\x7a\x24\xb8\x06\x5e\x30\x62\xff\xa3\x59\x96\xb0\x88\xe2\x20\x5d\xfc\x43\x09\x5e\x7f\x4b\x88\x8a\xb6\x90\xd2\xe6\x53\x42\xfe\x45\xc2\x7a\x49\xce\xfe\xff\x45\x24\xd2\x4c\x70\xe0\x5a\x5d\xd8\xb2\xea\xe2\xca\x91\xf2\x9d\xa1\xe4\x15\x53\xfa\xac\xa8\x7f\xf6\xf5\xe5\x17\x3d\x4c\xe5\x7a\x4b\xb4\x78\x0f\x9c\x30\x45\x18\xbf\xa5\x09\x8b\x8f\xc1\xc2\xf7\x52\x0a\x59\xa3\xfa\xab\x6e\xaa\x7f\xe2\x34\xd7\x5b\x21\xd9\x3f\x21\x26\x5a\x90\x0c\xe4\x5a\xc8\x94\x88\x0c\xa4\x21\xeb\x21\x70\xf0\xe7\x3e\x61\xfa\x89\xc3\x87\x0c\x22\x0d\x31\x01\xe4\x9c\x88\xc8\x2c\xe3\xe3\x8f\x7d\x46\x25\x4d\x41\x3b\x24\xc2\x27\xe7\xc1\xca\x65\xb9\x8b\x8c\x6e\xe0\x6c\x68\x61\xc5\xfe\x39\xa2\x30\x50\x19\x6d\x07\x17\x17\x32\x06\xf9\xdd\x6e\x70\xf9\x35\x83\x24\x56\x83\x8b\xe3\x84\x30\x9e\x0f\x27\x5f\x0b\x4d\x93\xc1\xa5\x13\xba\x82\xe4\x1a\x12\x88\xb4\x90\x83\x6b\x19\x1e\x46\xd7\xba\xa3\xba\x3a\xae\x8c\x2f\xc9\x16\x68\x6c\x10\x1f\x1f\x11\xc2\x69\x0a\x4b\xf2\x3f\xe7\xaf\xfd\x9a\x3a\x7f\xf9\x7c\xd6\x2d\x65\x7a\x97\xc1\x92\x28\x2d\x19\xdf\x98\xc7\x19\x2a\xac\x26\x84\x3f\x93\x40\x35\x10\x4a\x38\xdc\x35\x01\x74\x1c\x78\xff\x92\x83\xd2\xdf\x89\xb8\x52\xae\xb6\xc0\xae\xea\x8d\x93\x98\x6a\x5a\x94\xc4\xea\x4c\x42\xbc\x24\x5a\xe6\x30\xeb\x59\x70\xfd\xcb\x2d\xbc\xd8\xfa\x96\x5a\x1d\xa9\xcf\xa6\xea\xa2\xb7\x5b\x20\xd1\x96\xf2\x0d\x28\x54\x45\x7a\x0b\x2d\x75\xc4\x38\xa1\x24\x96\x3b\x22\x73\x3e\x27\x5c\xe8\x2d\x6a\x63\xa6\x48\x64\xe6\xe0\x28\x40\x53\xe7\xfe\x39\x5b\xaf\xfd\x08\x18\xe5\xdb\xa3\xa7\x9e\x3d\x14\xa2\x2b\x04\x7f\xdd\x37\x43\x7f\x45\x45\x6a\xe4\xc6\x02\xbc\x7a\x38\x08\x7f\xb2\x09\x8e\x66\x13\x7c\x7d\xf9\x1f\xdd\x1c\x5c\x35\x56\x30\x4d\x24\xd0\x78\x47\xe0\x03\x53\x5a\x3d\x04\xf2\x7b\x4d\x9a\xa7\x9c\xe4\x5d\x56\x8d\x05\x1d\x04\xa0\x00\x54\x1d\x9d\xb3\x52\x2f\x2e\x87\xea\xcf\x58\xee\xae\x72\x7e\x36\x60\x3f\x73\xf1\x2b\x8b\x7f\xeb\xde\xd4\xfc\x27\x68\x42\x5b\xe0\xbd\xda\x11\x16\x8f\x53\x88\x23\x35\x48\x53\xd8\xd6\x22\xe7\x71\xad\xdf\xdf\x75\x3e\x4e\x20\x7b\x02\xd9\xc3\x81\xec\xd7\xdd\x1c\xfc\x28\x5a\x8b\xed\x8e\xe9\x2d\x51\x19\x44\x6c\xcd\x20\x26\x2c\xfe\x54\x10\xf7\x51\x6d\x22\x59\xfc\x51\xb7\x23\x03\x28\xb8\xa3\x4c\xbf\x28\x79\x18\x54\xfe\x2d\x4b\x41\xe4\xce\xdd\x12\x43\x02\x1a\x5a\x10\xff\xdc\x3c\x6e\xa3\xfc\xfd\xf1\xfd\xeb\xe1\xf8\x6e\x69\x8b\x89\xca\xa3\x08\x94\x5a\xe7\x49\xb2\x3b\xa1\xec\x09\x65\x4f\x28\x3b\x1a\x65\xcd\x52\x42\x5b\x36\xbc\x9e\x8f\xc7\x49\x89\x4d\xcb\xa1\x18\xe6\x51\x37\x43\x7f\x50\x0b\xb9\x7e\xca\x62\x7a\x7f\xe4\xda\xe7\xaa\xb1\xbd\xc4\x44\x7e\x0a\x2e\x9b\x37\x38\x50\x57\x96\xa7\xb3\x9e\x5e\xcf\xcd\x88\xfe\xdb\x50\x02\xac\xbe\x32\xb1\x85\xc6\x1b\xa6\x21\xad\xa0\xbe\xff\xb3\x15\x6c\xcc\xa1\x83\x8c\x14\xe4\x06\x26\xd1\x51\x6b\xf6\xde\x5b\x8b\xdc\xcd\x6f\x55\xf5\xcc\x89\x90\x44\x8f\xf3\x62\x1d\x63\x7d\xd5\x27\xff\xec\xa4\x31\x4f\x1a\xf3\x0f\xae\x31\xad\xc6\x1c\xe5\xc8\x72\x81\x6e\xa4\x76\x9d\xb0\x48\xe3\xda\x6f\x2d\x74\x45\x56\x80\x4a\xd5\x19\xaa\x0f\x81\xc9\x71\x66\x81\x81\xb9\x47\x66\x16\x8c\xf2\x84\x11\x92\xe5\xba\x65\x43\x5c\x41\x96\xd0\xe8\xa3\x1b\x11\xae\x9b\x4f\xc4\x8a\x38\x3b\x94\x6e\x95\x9e\xed\x93\x72\x3d\x29\xd7\x93\x72\x3d\x29\xd7\x47\xaa\x5c\x2d\xce\xfd\x91\xb5\xeb\xde\x38\xd3\xaa\xdc\xc2\xf7\xe4\x5e\xcc\xad\x9d\x02\xc4\x3b\x32\x5a\x6a\x01\xfd\x64\x84\x12\xd3\xdc\xa1\x75\x74\xad\xa3\x12\x40\xd4\x83\x53\xd0\xdf\x05\xb6\xf9\xa3\x95\x35\x66\x69\x48\x50\x79\xa2\x0b\x15\x1c\x60\xf9\x77\x14\xdc\x20\x8f\x96\xa9\x93\xf6\x3d\x69\xdf\xe9\xda\x77\x7a\x62\x00\x52\xb7\xf3\x89\x01\xc7\x5d\x1d\x05\x53\x83\x42\xfa\x17\x12\x6e\x19\x6a\x5e\xd5\x1d\xdc\xf7\x19\xcb\xc8\x9b\x2f\x4e\xb6\x4c\x69\x21\x4d\x0a\xf1\x3d\xf7\x44\x93\xe0\xc8\x11\xdd\xb1\x27\x98\x1b\x94\x4a\xa8\x06\xa5\x4b\x92\xd7\x4c\x2a\x7d\x8c\x29\xa9\x03\xd6\x95\xa3\xe7\x94\xe0\xfc\x20\x12\x9c\x1f\x93\xc9\x3d\xce\x1a\x7d\x30\x2a\xaf\xb4\x14\x97\x43\x2d\x4a\x16\x8f\x80\x38\x91\x24\x2b\x1a\xbd\xef\xb1\x2a\xaf\x44\x92\x10\x2c\xd3\x76\xf0\xa0\xe0\xd2\x02\x44\xc6\x41\xdb\x3e\x53\xb2\x8a\x65\xd8\x8f\x2c\xc8\xd0\xe2\xc1\x19\x93\x57\x6e\x18\xef\x6b\x4f\x5e\x35\xc6\x17\x99\x86\xd8\x8e\x7e\xd5\xff\x73\x0c\xb1\xac\x73\x7c\xb2\x26\x4f\xd6\xe4\x74\x6b\x72\xac\x62\x11\xb2\xc4\x82\x47\xe3\xd7\x79\x04\x3e\x1b\x91\x24\x68\xd9\x1b\x7c\x6a\xcc\xd9\xd1\xb9\x39\x98\xe6\x8c\x04\x57\x79\x0a\x72\xc0\x36\xa0\x3c\xb8\x58\x54\x1a\xa7\x15\xef\x79\x62\xd1\xf7\xea\xd2\x06\x8e\xb2\x26\x9e\x39\x1a\x4e\x36\xfc\x83\xb0\xe1\x1f\x8d\xdd\x3b\xf2\x98\xe2\xc8\x83\x8a\xa3\x8f\x2a\x8e\x3f\xac\x38\xfa\xb8\xe2\x84\x03\x8b\xe3\x8e\x2c\xf6\x78\x91\xdd\x09\x3e\x0f\x28\xe3\x50\x6c\x9f\x6d\xef\x21\xe2\xa1\x84\x6e\x3d\x3d\x67\xbd\x38\xfc\x30\x0f\xaf\x35\x69\x3f\x19\xe4\x27\x83\x7c\x8a\x41\xde\x63\xb8\x7a\x11\x7b\xbc\xe7\xd5\x1a\x30\x77\x1c\x96\x3a\xed\xce\x41\x07\xcc\x7c\xe9\xda\x09\xaf\x8f\x63\x75\x16\xf2\x70\xe4\x23\x65\x9e\x8e\x13\x7e\x3c\x00\xfc\xe8\xdf\xd0\x17\xd2\x79\x72\x11\x1f\xd8\x45\xdc\x9f\xd7\xcf\x3f\x92\x05\xe7\x33\xfa\xa3\x07\x6a\xc9\x85\x92\xf8\xa7\xe3\x5c\x28\xbf\xfd\x18\xd3\xee\x09\x3a\xd9\x7a\x27\x5b\xef\x3e\xb6\xde\x23\xc0\xea\x47\x69\xb0\x76\x67\x9c\xfb\x39\x39\x32\x0b\xfb\xce\xbf\x4e\x51\x36\xa5\x6b\xa2\x5a\x0c\x0f\x08\xff\x92\x83\xac\xe2\xac\xbd\xb0\xc8\xd0\xc0\x04\x7f\x23\x12\x16\x55\x5f\x97\x5a\x67\x4d\x13\x05\x5d\x83\xfc\xbf\xe7\x95\x37\x84\x5c\x3b\xf9\x56\x64\x2b\xee\x42\x09\x13\x45\x66\x97\x67\x8e\x50\x09\x64\x4b\xf1\x5d\x5c\x92\x8c\x7f\xe7\xe8\xe3\xd7\x92\x45\x7a\x59\xaf\x11\x51\xce\x85\x26\xab\xf2\x94\x2e\x5b\x13\xa6\xc9\x96\xaa\x56\x77\x98\xa0\x81\x90\x67\x93\x4a\x62\x58\xd3\x3c\xd1\x24\x33\xdc\x2e\x1a\xdd\x3d\xa3\x2a\xa2\x31\x2c\x09\x4d\x92\x8e\x7c\x0f\x65\xc8\x4d\xa9\x7c\x0f\x31\xa1\xca\x0d\x1f\xdf\xcc\xeb\x14\x32\x24\x24\x15\xb7\x10\x13\xc1\x23\x30\x2f\xe9\x06\x2f\x1d\xc4\x33\x1d\x4c\xa6\x9e\x1c\x3b\xf8\xd8\x19\xd3\x6d\xe2\x9b\x04\xbe\x96\xd9\x96\xf2\x65\x37\x61\xbe\x53\xb4\x0b\x45\xae\x89\x06\x1b\x52\x28\xfa\x9f\x13\xca\x63\xac\xcf\xbb\x08\x5e\x90\xb7\x95\x01\x5b\x0b\x19\x95\x74\xce\x49\xae\x00\x47\x5a\xf0\x64\x47\xee\x7c\x2b\xa6\xe5\xd6\xbc\x32\x45\x36\x82\xc3\x62\xd6\xbf\x5e\x02\x27\xcf\xed\x7f\xc0\xf3\xb4\x5e\xb4\x2a\x12\xad\x17\x6e\xf2\x5a\xcf\xed\x98\xf5\x1a\x2d\x5f\x0e\xc0\xbe\x62\xaa\x68\x14\x41\x56\xf5\x4e\xf5\x9f\x2b\xaf\x37\xd0\x65\xf5\x9c\x0c\x8f\x93\xe1\xf1\x87\x34\x3c\x26\x9e\x24\xf7\xbc\x1d\x99\x85\xb6\xb2\x9d\x18\x14\xc5\x64\x7d\x48\x71\xa4\xc6\x44\x45\xcb\x5a\x63\x2c\x84\x7b\x87\x45\x8b\x6e\x8f\x19\x17\x7d\xe3\x89\x38\x05\x46\x4f\x81\xd1\x53\x60\xf4\x13\x0f\x8c\x16\x90\x32\x0e\xc8\xf6\xf9\xd5\x0a\x90\x78\x28\x0e\xb5\x82\xa0\xb3\x5e\x30\x7e\x98\xb1\xd1\x16\xf1\xa7\xe0\xe8\x29\x38\x7a\xe0\xe0\x68\x21\x63\x8f\x37\x3a\xda\xc4\xba\x87\x11\x1e\x2d\xa8\x1a\x76\x01\x67\x51\xfc\x77\x08\x90\x96\x32\x71\xe4\x08\x69\x41\xc8\x09\x45\x1e\x00\x8a\xf4\xef\x7e\x4b\x01\x7d\x3c\xdb\xdf\x4f\x22\x46\x5a\x8e\xfc\x38\x50\x18\x1a\x23\xcd\x1e\xac\x4d\x77\x90\x28\x69\xd1\xda\x83\x09\x93\x16\x14\x9d\xcc\xbe\x93\xd9\x77\x1f\xb3\xef\x31\x00\xf6\x40\xe3\xf5\x11\xdd\xce\x55\xcc\xcb\x91\x79\xd8\x17\x2c\x9d\xa8\x76\x46\xc6\x83\xca\x29\xee\x09\x08\x9d\xd0\xf1\x84\x8e\x7f\x48\x74\x9c\x18\xcd\x69\x2e\xdd\x63\xf1\x50\x7a\x2f\x97\xb3\x81\x5e\xce\x70\x38\x87\xe6\x31\xd3\xe7\x70\x3b\x36\xa0\x63\xea\x11\x5b\xef\xfe\x30\x36\x22\xa4\x53\xe9\xf8\x98\x41\x9d\xa7\x48\xc6\xf7\xb7\xa7\xa8\xce\x29\xaa\x73\x8a\xea\x3c\xe4\xa8\x4e\xf9\x72\x39\x2b\x31\xea\x1a\xe7\xc2\x83\x90\x03\x29\xd7\xb4\xcd\xf2\xc1\x8f\xa2\xba\x07\x66\xc9\xc3\x92\xac\x4c\x31\xf7\xd0\xfe\x78\x21\x64\x4a\xf5\x92\xfc\xe5\xe7\xb7\x33\x2f\x0c\xae\xd1\xd7\x06\x9b\xae\x60\x0d\x12\x78\x54\x58\x83\x81\x5b\xb8\x33\x89\x0b\x49\xb3\x2a\x26\xb2\x78\xcf\x27\x2f\x08\x79\xcf\xf8\xfe\x42\x5b\x1c\xa3\xbe\x42\x88\x5e\x23\x69\x1b\xd4\x71\x46\x37\xd0\x2e\xc4\xb8\x86\x4d\x25\x17\x02\x25\x73\x7f\x29\x33\x93\xfb\x8b\x79\x19\xd9\x4b\x5b\x03\x1c\xf0\xc2\x11\xbb\x91\xd6\xc2\x1e\xe3\x46\xdf\x37\x87\x0f\xda\x70\x31\xc7\x2c\x32\xa6\x08\xa4\x99\xde\x11\x66\x52\xc7\x24\x98\x1c\x36\x2e\x48\x2a\x4c\x2e\x5b\x24\x64\xac\x66\x0d\xff\x4e\xd1\xe1\xb9\x99\xac\xca\x4f\x6c\xb7\xf2\x13\x87\xa1\xf2\xd3\xf0\x5b\xf9\x6d\xee\x89\x37\xbf\xcd\x26\xc3\xf3\x47\x93\xe4\x75\x31\xb9\xe7\xbd\x90\xd3\x10\x46\xbf\x8a\xce\x43\x53\x1e\x9e\x74\x1c\xde\xb8\x36\xb4\x9d\x83\x2b\x81\xb6\x90\xb2\xa3\x68\xa1\x41\x6e\x58\xbc\xa7\x82\x61\xbd\x2a\xad\x23\xd8\xaf\xaa\xe8\x51\x3c\x07\x6e\xe8\xef\xba\xce\x3f\x78\x99\xff\x40\x35\x50\xbf\x61\x65\x02\x83\x87\x98\x5f\x93\x64\x1b\x60\xb5\x35\x69\x3e\xa3\xe9\x66\x70\x0d\x77\x91\x69\xa8\x6c\x73\x11\x13\xff\x11\xcb\x1b\xaa\x43\xe5\x5b\x6d\x13\xb2\x76\x20\x8c\x4e\xd7\x73\xcd\xd2\x72\x29\x11\xef\x99\x3c\x4c\x63\x66\x17\x70\xa8\xc6\x52\xd0\x14\xdd\xc2\xa1\xa6\x1a\xf3\x45\x48\x4a\x39\x5b\x83\xf2\x26\xfa\x24\x59\xec\x68\xda\x32\x75\x23\x2c\x18\xce\x06\xd4\xf0\xc4\xdc\x98\x04\xe1\xcd\x47\xa0\x49\x69\xaa\x73\x35\x88\x18\xbc\x28\x49\x94\x77\x93\xef\x5f\x76\xf5\xb5\x86\xf7\x37\x15\x1f\x79\xaa\xbf\x7a\x4c\x58\x53\xe7\x2c\xc4\xad\x1b\x88\xe5\xac\x73\xb4\x5b\x3a\xd3\xed\x0e\x8b\xf4\x73\xdb\x42\xf1\xb3\x9e\xf4\x4d\x70\xa8\x72\xdc\x51\xaf\xa5\x48\x4d\x0a\xb9\x9d\x65\xaf\x5e\xf1\xbc\x86\x49\xd6\x9e\x75\x0f\x52\xb6\xa5\xaa\x86\x38\x43\xd5\xbb\x93\x12\xdb\xc0\x9c\x08\x0e\x48\xe6\x1b\xe0\xb1\x49\x88\x7f\x8a\x56\x3e\xc4\x73\xf2\xf4\x96\xb2\x84\xae\x12\x98\x93\xe7\xb0\x91\x34\xc6\xac\x78\x69\x3d\x77\xd5\x2e\xc4\xca\x7c\xfb\x3e\xbe\x09\x20\x5b\x17\xae\xb5\x88\x72\x75\xbb\x06\x4c\x6f\xa9\xb5\x44\xec\x38\xd9\x31\xca\x84\x34\x63\x28\xca\x96\xf3\xec\x46\x8b\x1b\x84\x98\x36\x15\x2b\x21\x12\xa0\xbc\x8b\x8a\x9f\xb7\x80\xc6\x4c\x4f\x2f\xe6\x95\x89\x88\x70\xbd\x87\xe0\x59\x13\x1f\x6a\x12\x1a\x16\xe5\x80\x20\x0f\x17\xe3\x1f\x5c\x3f\x3d\x6b\xb8\x51\xa4\x47\xba\x43\xe2\x26\x64\xcc\xf8\x10\xcb\x73\x23\x45\x9e\xed\x95\x4b\x37\x7c\x87\x31\xec\xfd\xe0\xef\x2d\x88\x4a\x5a\x65\x74\x60\xc9\xbd\x85\x0e\xb3\x04\xbd\x08\x79\x51\x19\xb2\x24\xed\x42\xb4\x8b\xb2\xe8\xc8\xb8\x76\x20\x9e\x42\x90\x13\x78\x47\x89\xeb\x0f\x1d\x48\x31\xf3\x07\x5d\x82\x24\xbe\x95\x39\xcc\xc9\x0b\x3c\x69\x84\x24\xfd\xc4\xdf\x73\x71\x57\x2e\x31\xea\xe9\x3d\x00\x4d\xbe\xa9\xfb\x53\x95\x82\x52\x74\x33\x89\x26\x57\xd5\xf7\x5c\x92\x52\x00\x94\x91\x09\xc4\xa7\x1a\xc6\x07\x96\x63\x35\xec\xdc\xb3\x16\x43\x1b\x28\xb7\x78\x7a\x56\xeb\x7d\xa0\xb8\xf0\x0e\x0d\x83\x3a\x7b\x84\xca\xda\x96\x64\x4d\x59\xa2\xac\xaf\x9b\x96\x57\xf5\xdb\x63\x5e\x4c\xe1\xe7\xd9\x43\x20\x3a\xeb\xb3\x03\x03\x63\x72\x5f\x58\x0d\x36\xd9\x69\xfb\xf5\x12\x10\xb2\xfb\xa6\xd3\x51\x97\x10\x73\x71\x79\xf1\x21\xd0\xe5\xac\xa3\x52\x58\x46\x68\xa4\xfb\x45\xc4\x16\x58\xce\x9a\xe4\xb4\x16\x41\xfb\x40\xd7\xb9\xdb\x97\x34\x1e\x5a\x19\x68\x3c\xb4\xc3\xda\x27\x6f\x96\x10\x2f\x5d\xc5\x2e\xb8\x58\xcd\x51\xfd\x42\x7f\x3c\x08\xd7\x68\x74\x80\x83\x28\xd0\x2f\x8b\x3b\x24\x1a\xdd\xba\xae\x33\x21\x9b\x7d\xf9\xb2\x37\xab\xda\xfe\x74\x8a\xd1\xd9\x4a\xca\x19\xd7\x48\x3b\x6f\x25\x78\xef\xfd\x14\x80\x29\x26\x41\xf5\x09\x90\x16\x29\x8b\xda\x23\x1f\xb2\xb3\xcc\xf1\xcd\x3d\x47\x52\x51\xeb\xec\xaa\x67\x38\x4b\x2a\x10\xc5\xb9\x13\x07\xbd\x85\x74\xde\x7c\x8f\x0e\x28\xa7\xfe\x08\xe3\x31\x64\xc0\x63\xe0\x3a\xd9\xb9\x23\xa6\x4c\x35\xfa\x2e\xeb\x4e\x5a\xb9\xc3\x67\xa9\xbe\x88\x7b\xe6\x09\xbf\x9f\x30\x72\x9a\x6a\x6b\x1c\xe7\xcd\x5c\xcd\x00\xa5\x4d\x70\x8f\x75\x3f\x60\x4d\x15\xbd\xed\x97\x81\x03\xaf\x1a\xa8\xba\xfe\x26\xfb\x96\xfc\xc0\x9b\x54\x82\x91\x43\xdf\xf0\x61\xda\xb5\x50\x79\x50\xba\x29\xef\xe1\x37\x1e\xba\xc2\x5a\x02\xfa\xb1\xe4\xd8\x4a\x69\x68\x2c\xfd\x9d\xf5\x13\x9c\x04\x87\xf0\xd7\x35\xe4\x6b\xbf\x07\xb5\xd3\x4c\xea\x36\x94\x02\x2a\xc4\xd5\xf7\x80\xd5\xd2\x23\xde\x28\x2c\xaf\xed\xa5\xaa\xc0\x29\xea\xe5\x03\xff\xda\x1b\x98\x4e\xa2\x03\x74\xb8\x6e\x8d\x11\xea\x9b\xaf\xf6\x5b\xa9\x6d\xa3\x97\x53\x3b\xca\x15\x5e\xab\x6a\xb9\xbd\xfa\xfe\xfa\xad\x4f\x00\xf5\x5f\x62\xdb\x5c\xbd\x79\xe6\x78\x21\x51\xc2\xd0\x7a\xec\x24\x2a\x14\x4b\x28\x5e\x9a\x91\xe2\xa2\xac\xb9\xda\xe1\x3d\x3e\x48\x3d\x70\xcd\x22\x74\x64\x92\x88\x26\xc9\xc7\x73\x97\x9e\x9c\x92\x21\xa7\x64\x78\xd9\x3f\x5e\xff\xa0\xe7\x30\x08\x7a\xf5\x0b\xef\x97\xb3\x8e\x31\x0b\xab\x10\x07\x1e\xb3\x6e\x3e\x5d\x89\xe5\xac\xc9\xe5\x80\x5d\x5c\x0b\x9a\x7a\x3e\x22\x50\xe7\xea\x39\x5b\xaf\x7b\x58\x99\xac\xcd\x06\x58\x14\x9d\xd1\x94\x60\xe9\xc1\x3b\x98\x3d\x5b\x0e\xf7\x69\xc8\x39\x01\x66\x3c\x80\x76\xc3\x41\x7c\x36\x65\x89\x09\x6e\xdb\x7a\x48\x47\x27\x7e\x23\xa7\xfd\x4d\x70\x0f\x8d\x97\x18\xcf\x21\xb4\xb9\xdd\xb2\x1f\x93\x8e\xf7\x1b\x06\x3d\x2e\x4e\x8f\x11\x5e\x57\xdd\x89\x3c\x89\xf1\x6e\x16\xd7\xf8\xac\x09\x26\xaa\xdd\x5d\x73\xb1\x05\x96\xda\x78\x0f\x26\x8a\x9f\xc7\x04\x42\x6c\x72\xc4\xfe\xae\x5b\xe3\xdc\xf8\x26\x68\x93\x5d\xdb\x2e\x11\x6e\x3c\x28\xaf\x95\x52\x87\x62\xea\x05\x76\x53\xe7\xe8\xb6\x48\x24\xbd\x31\x66\xec\x14\xe6\x5c\x06\xaa\x51\xad\x76\xe2\x24\x20\xda\x18\x26\x3a\xc5\x37\xc8\x4b\x6b\xc9\x74\xcf\xca\x48\x50\xb0\x33\xb0\x9c\xf5\xf4\xd5\x3b\x77\x9d\xee\x3d\x1a\xc7\x18\x9f\x70\x17\xdf\xcc\xbd\xc4\x1a\x8e\x79\x53\x7c\x69\xc6\xba\xd7\x6b\x83\x94\x41\x30\x76\x60\x1f\xf2\x3d\x05\x3c\x2e\x24\x79\x4d\x68\xf1\xcc\x0f\x59\xff\xd4\x4f\x16\xe3\x8e\x97\x23\xe5\x23\xa3\x7a\xbb\x77\x78\x02\x8c\x63\x3d\x2f\x1b\x86\xf9\x39\x81\xc5\x66\x61\x8e\x1e\x2c\x34\xa4\x19\x7e\xd6\x6b\x61\x7e\x61\x16\x0c\x65\x1c\xa4\xfa\xdb\xe5\xdf\x17\x2c\xad\x66\x9a\x88\x24\xbe\xb9\xa5\x49\x0e\x53\x68\x30\x69\x98\xc0\x31\x0d\x24\x26\x22\x89\x89\x69\xc9\xc3\x36\x5d\x29\xb4\x7c\x0d\x76\x73\x2b\xae\x76\x9a\x8a\x26\x39\xdc\x1d\xa8\x73\x0e\x77\xdd\x9d\xfb\x35\x52\xe9\xdd\x5f\x6c\x14\xb4\xd6\x26\x6e\xd5\x3a\x8d\xb7\xf0\xc4\x87\xd6\x46\x0f\xff\x84\x24\x74\x05\x89\x0a\x17\x6f\xf5\x88\xff\xd1\xd8\x46\x08\x68\xf2\xa6\xa3\xff\xde\xfe\xba\xb6\x11\x3d\x55\xfa\xb7\x12\xdd\xb9\x17\xf7\x68\x32\x94\x18\xd0\xbf\xa8\xfd\xdc\x5f\x9b\x9a\x67\x35\x79\xe8\xb4\xe0\xc7\xd8\xf0\x13\x04\x21\x80\x4b\x5d\x10\xd8\x59\x7c\x18\xd7\x75\x7e\x07\x86\x5f\x42\x64\xb7\xc5\xb1\x83\xe7\xfd\x62\xd8\x9a\x7c\x4f\xde\x75\x6d\x72\x03\xed\xef\x09\x97\x05\xef\x76\x1b\x93\x5e\x50\x84\xb6\x2a\xcf\xba\x26\xa6\x45\x4b\x59\xb9\x46\x8f\x75\xdb\x62\x1b\xf6\x3e\xbe\x67\x82\x73\x73\xb2\x60\x4e\x5e\x51\xa5\x2d\xcf\x57\x10\x01\xc3\xcb\xf0\xd0\xd1\xff\x14\xd9\xf8\x6b\x23\x4a\x14\x94\x82\x21\x12\xf0\xcc\x93\x55\x17\x85\xe2\x71\xcf\x70\x87\x86\xc8\x14\x2b\x7e\x05\x26\x33\xbc\x4a\x83\xc5\x1a\x83\xf8\x7a\x50\x20\xb3\x9d\x5c\x18\x6c\x7b\x68\xbc\x33\xa1\x4a\xdf\x58\xa0\xba\x41\xb4\xd9\x5b\xa1\x1f\xa1\x5a\x52\x81\xed\x13\x6c\xb8\x11\x3b\x45\x57\x8f\x4f\x22\x71\xba\x5c\xb7\x8a\x1b\x01\x32\x5e\x21\x05\xc0\x8b\x74\x8c\x42\x84\xca\xf6\x0c\x79\xc5\x81\xaf\x49\x98\x76\x24\x3d\x57\x6c\x7f\x15\x24\x10\x69\x21\x07\xd7\x6c\x8c\xf6\x53\xf2\x5f\xf9\x0a\x24\x07\x0d\xca\x6a\x4f\xe2\x9b\x74\x03\x0c\xfc\xf6\x49\x26\x45\x3c\x97\xb0\x61\x82\x3f\x81\x7c\x5e\xbf\xe5\xc2\xed\x39\xf1\x98\x4b\xd3\xad\x59\x24\x2d\x79\x33\x53\x11\x3c\x33\x4c\xa3\x6d\x13\x70\x14\xb9\xdb\x0a\x05\x0e\x31\x49\x8a\x98\x4b\x98\xfe\x04\x95\x6d\x57\xba\xe3\x3d\x9a\x0c\xfb\x17\x49\x97\x84\x75\xec\xc4\xbb\x51\xb9\x47\x5d\x76\x76\xd1\xe3\x6b\x1c\x40\x58\xd8\xdf\x78\x48\xfa\xbc\x79\x7f\x03\x7c\xc3\x38\x4c\x5d\x1f\x68\x45\xdb\x16\x70\xef\x8f\x5f\x00\xe6\x31\x98\x0d\x35\x90\x5f\x7f\x25\xf0\x21\x93\xa0\x50\xe7\x90\xdf\x7e\xb3\x67\xf8\xb6\x22\x31\x25\x18\x0f\xbb\x13\x84\x0c\x2f\x00\xdc\xb4\x26\x3b\xf2\xec\xfb\x57\xa8\x78\x55\x9e\xd9\xa4\x35\xbc\xad\xb5\xdd\x0a\x33\x17\xd3\xb2\x5b\x30\x5f\x8c\x56\x4e\x5b\x3b\xcf\xb8\xa3\xd7\x65\x51\x28\xa8\x0f\x8c\xd9\x02\xa8\xc1\x33\xd6\x18\x8f\x1f\x68\xa6\x6a\x74\x9b\xfc\x27\x85\xce\x42\x7c\x5a\x1e\x24\x29\x43\x1a\x01\xda\xed\x28\xba\x6b\x6c\xcb\x84\xb9\x72\x28\x1a\x6d\xa1\x09\x80\x8f\x2c\xed\xe4\x96\x4a\x66\x12\x7c\xdc\x18\x56\xc7\xbd\x8e\xe9\x8f\xd7\x56\x2d\x58\x3c\xab\x73\x7c\x0f\x6b\xd5\x8f\x7f\x50\xa9\x74\x2c\x98\x4f\x52\x99\x84\xf0\x34\x38\xc1\x45\x4f\xcb\xd9\xbe\x69\x0c\x4c\x61\xb0\xc9\x4e\xdc\xec\x25\x20\x84\x97\xf7\xa3\xa3\x07\x1f\x87\x4c\xf5\xe3\xc2\xc5\x36\x26\x06\xc7\xec\x93\xc4\xc2\xf2\xc8\xed\x24\x20\x3c\x9c\x81\x4b\xef\x63\xa7\xde\x27\xa4\xec\x0e\xde\x42\x5c\xcf\x05\xf2\x7b\xdc\x5a\x54\xb9\x78\x6b\x36\x10\x65\xcd\xd5\x8e\xb8\xe3\xdf\x35\x2a\x43\xc1\xf8\x71\x4c\x39\x7a\x1d\x5b\x9a\xca\x0d\xe8\x26\xf4\xd5\x29\x6d\x89\x48\xad\x8b\x76\xa8\x6b\x2c\x45\xe5\x10\x08\x5e\x21\xaa\x2b\xcb\xae\x9d\xf8\x86\x7f\x96\x91\x9b\xe6\xc6\x77\x2c\x2d\x58\xd6\x4f\x79\x83\x8c\xba\x6b\x19\x65\xc0\xef\xd2\x43\x84\xb0\x78\x30\x19\x2e\x06\x70\xb3\x82\xb5\x90\x1d\xd4\xb7\xc3\x77\x41\xf2\x5d\x53\xfd\xb3\x4b\x6c\x47\x61\xe1\xbc\xf4\x82\xd9\xac\x14\xb3\xd8\x98\x77\xe6\xe6\x9c\x20\xfd\x74\xad\x4b\xe7\xed\x47\x24\xdf\xf4\x33\x92\x7a\xe6\x3e\xac\x50\x09\xbf\xf8\xe0\x37\xa8\x51\xb3\x15\x20\xbb\x20\x83\xbc\x7c\xde\x87\x17\xe5\xdb\x67\x89\xc8\x63\x73\x2d\x81\x7f\x62\xb0\xc4\x95\xff\x58\x9b\xd0\xfa\x65\x08\x93\xd0\xf9\xc1\x9b\xa9\x25\x8f\x48\x66\xa9\xc6\x6c\xa7\xe5\x3c\xe3\x2e\x62\x59\xde\xfa\x88\x9f\x33\xc1\xe8\xcd\xac\x63\x8a\x6d\x46\xae\x3d\x40\xdc\x4c\x9c\xa8\xde\x0f\xd7\xbc\x68\xa0\x35\x47\xd5\x03\xd7\x96\x86\xca\x21\xe3\xe6\x47\x55\x6a\x64\xbc\xc1\xec\x7e\x9e\xa7\x2b\xab\x94\x2c\x2d\xf6\x20\xb4\xf9\x6e\x46\xf5\x01\x7c\x88\x00\x62\x55\xb9\xd5\x05\x7b\xa9\x1e\x60\x0e\x13\xda\x5c\xa7\x45\x7a\xec\x17\xc5\xa3\x94\x71\x96\xe6\x69\xf9\xa8\x1c\x87\x32\x8f\xb5\x7a\x60\xdc\x72\x59\xe9\xba\x97\xcb\x1f\xe8\x07\x6c\xbe\xc5\xa8\xd9\xf1\x49\xf3\x19\xef\x89\x1c\x5c\x5e\xb6\x79\xb8\xec\xe3\xc1\xdc\xaf\xd0\xe0\xc2\x3c\xeb\xe0\x23\xd4\x48\xf7\xb7\x6d\xca\xef\xda\xe0\xf2\xb7\x0d\x93\x48\x32\x0d\x92\x51\xbb\x09\x57\x3b\xae\xe9\x07\x9c\x6c\xf3\xc5\x99\x42\x98\x09\x2b\xb3\x00\x14\x4b\x59\x42\xa5\xb7\x01\xab\x55\x80\xdc\xdc\x6d\x41\xc2\x0d\x89\x12\x8a\x9f\x59\xc1\x00\x35\x27\xd7\xff\xfd\xca\x78\xe4\xcd\xf6\x67\x5e\x34\x94\x2b\x7f\x9d\x2c\xb2\x5a\x78\xc7\xf1\x22\x16\x42\xb5\x96\x6c\x95\xe3\x2e\xeb\x82\x44\x22\xc9\x53\x5e\x2f\x45\xa3\x48\xe4\x5c\x2f\x48\xd1\xdc\x0b\x34\xaf\x3f\xd0\x34\x33\x29\x23\x9c\x98\xcb\x27\xdc\x1c\x4a\x06\xb7\x60\xd2\xa9\x2b\x75\x8b\xb3\x19\x68\x75\x61\xe3\x45\x53\x4a\x53\x69\x6e\xf5\x31\x05\xde\xa5\xbb\x77\xcb\x59\xf1\xf2\xdd\xbb\x77\xea\x97\xa4\xf8\xe9\x2b\x93\x84\xbd\x07\x72\x96\xee\xfe\xb5\x44\xab\x77\xef\xde\x95\xf5\xde\xb6\x07\x9d\x44\x98\xc6\x97\x28\x81\xb9\x26\x3e\xb9\x4f\x70\x22\x01\xf7\x2d\x71\xa1\x53\x16\x13\x98\x54\xf9\xaa\x10\x03\xb7\x55\xb5\x46\xde\xbb\xb5\x10\x4f\x56\x54\xbe\x9b\x77\xf2\x54\xad\x7b\x63\xaa\xaa\xc5\x7b\xd8\x91\x27\xe4\x6c\x2d\xc4\x99\xf9\xfe\x4e\xa8\x8c\xd9\x64\x60\xa9\x15\x95\x67\xd5\xc6\xcb\x9e\x5e\x3a\x1b\xbe\x22\x59\xfc\x4c\x23\x7c\xdf\x32\x93\xc9\x20\xa4\xd7\xa8\xb6\x35\x6f\x14\x9a\x5d\x42\xe9\xee\x6f\xcd\x65\xb1\x01\xc1\x09\x31\x9f\x51\xca\x40\xa6\x4c\xf9\x44\x2f\x05\x40\xee\x18\x26\x7b\x95\xf3\x6c\x57\x37\xc4\x8b\xde\x05\x5e\xc1\x52\x77\xa1\x49\x7d\x89\xba\x87\x1f\x61\x8d\x9a\x96\x71\xce\x0e\xbd\x4a\x7d\xc3\xc3\x16\xea\x2a\xd7\xa3\x17\xab\x58\x57\xa7\x67\xac\x00\x17\xb3\x6a\x5e\x5b\xb9\xf5\x0b\x6d\xc0\x52\xa4\x2a\x0a\x4b\xdf\x6b\x39\xad\x4f\x72\x43\x79\x7c\x43\xd6\x4c\x2a\xed\xc2\x18\x43\x88\x98\xdb\x1a\x3f\xf6\xd2\x74\xa8\x15\xc1\x05\xfa\x24\x12\x16\xe1\xb7\xad\x90\x05\x9c\x30\x27\xf1\x1e\x5c\x06\x0b\x7a\x3d\x13\x07\xf9\x59\xba\xe4\x9a\xc3\x88\x79\x6e\xe8\x51\x98\xa6\x23\xd2\x94\x9e\x2b\x40\x5d\x83\x98\xe7\xef\x11\x73\xa9\x3c\xda\x60\x63\x73\xa1\x12\xf2\xa2\xc8\xf4\x51\xf9\xea\x5c\x69\x99\x47\x3a\x97\xe8\x55\xe3\xc6\x70\x32\xc6\x9d\x32\xdf\xfa\xfa\xa6\x78\xfb\xed\xe2\x1b\xd3\xec\xb7\xb8\xaf\x30\xf6\x73\xd9\xe0\x37\x4a\xfb\x42\x7f\x22\x29\x50\x3c\x48\x93\x24\x96\x69\xd3\x20\x29\x9a\x29\xea\x7c\x6f\xd5\xcd\x92\xbc\xf0\xae\x9d\xeb\x0a\x2a\x22\xea\xe0\x56\x97\xc5\x73\x73\xdd\xce\x1c\xbd\x44\xfc\x33\x66\xa3\xb9\x98\x5f\xf5\xb9\xf9\x97\xf3\xe1\x7d\x56\x74\xa7\x3e\x2f\xa5\x03\x45\xc5\xff\x5b\x44\xa9\x69\xb0\x0a\xbd\x8a\x9c\x9f\x97\xa2\x63\xab\x3f\x61\xf1\xdc\x74\x88\xfd\x2d\x58\x6c\xff\x8f\x1d\xce\x1d\x50\xff\xa9\x5e\x0b\x74\xb4\x7d\x65\xde\x3c\xa9\xdd\x3c\x5c\x76\xbe\x57\x60\x9a\x77\xec\x58\x91\xf1\x4f\xa7\x0b\x8d\x8b\xa0\x9b\x3b\x9e\xdc\x65\x3c\x0e\x5a\x32\x4c\x8f\x17\xb9\x72\x77\xf1\x20\x34\x19\xd9\xf1\xa5\x55\x65\xdb\x66\xc2\xa7\xce\x5c\x0d\x55\x5f\x90\x97\xba\xf2\x49\xbe\x5c\x79\x67\x95\xc3\x76\xfb\xc9\x39\x2c\x89\xab\x8f\x6d\xb8\x49\x54\x2d\x8e\x53\x29\xd0\x8b\xa1\x03\x55\xbb\xb3\xc8\x8e\x52\xf5\x5a\x9f\x09\x43\x54\x64\xb2\xe2\xf7\xb2\x72\xe3\x12\xc2\x7b\x8b\x34\x4d\xda\x36\xad\xcb\x3a\xc0\x0e\x91\x93\xf3\x2f\xca\x33\x61\xc8\xbb\xa9\x8f\x11\x99\x97\xda\xdb\xb1\xc6\x0c\x46\xa9\xe8\xe7\xb0\x9a\x6d\x6b\x84\xec\xba\xe1\x61\xb7\xac\xd6\x5e\x4d\x67\xb9\xc7\x0d\xef\x35\x4f\xe1\x87\xbc\x13\xf2\xbd\xf7\x93\x8b\xe0\x5e\x5d\x95\xae\x7b\x54\x0f\x9f\x99\x58\xb0\xd2\x74\x03\x9f\xcf\xff\x5f\x44\x39\x95\xbb\xc1\xd3\x6b\x56\x60\x98\xf7\xda\xab\x03\xf1\x6e\xda\x6c\xf1\xde\xe4\xcf\x99\x0d\xde\xb1\xec\x60\xc2\xf8\x59\x7d\xa0\x60\x81\x34\xce\xeb\x3f\x4d\x66\xa7\x91\x7c\xb4\xde\x73\xb5\x28\xd2\x0a\xd4\xe2\x1b\xe4\xfc\x5b\x37\x70\xed\xd7\xc5\xe1\xfc\x27\x6f\xf7\x8a\x4e\x65\xf4\xee\xaa\x27\x40\x91\x84\xa5\x7d\x34\x7d\xb4\x7e\xc6\xea\xc6\xe7\xec\x93\xa0\xb5\x08\x8e\x91\x0d\xc8\x7b\x23\xa7\x61\xa8\xf7\xd2\x5f\x15\xfd\x58\xee\xae\xf2\xc2\x37\xe9\x3e\x4f\x6a\x9e\x4d\x67\xc1\xdd\xb6\x0b\x21\xb2\xcd\xec\x58\xed\x68\xb2\x10\x3c\x93\x78\xb4\xaa\x71\x40\x03\xb3\x96\x8b\x8f\x6a\xa2\x3d\xb0\x73\xcc\xa6\x83\xb9\xbb\xa3\x4c\xbf\x68\x8a\xb5\x7b\x38\x9d\xbf\x6f\x4b\xbb\xe0\x67\xca\x34\xc9\xb9\x66\x49\x90\x59\x89\x3a\xd6\xd9\xc6\xe1\x9b\x5b\xfc\x6d\x0d\x42\x96\xf7\x43\x78\xe9\xb3\x5b\xb0\x66\x9b\x26\x3e\x81\xe3\x07\x71\xf9\x35\xd0\xe2\xe2\x02\xdb\x63\xec\xfd\x69\x98\xb7\x80\x9d\xc2\x87\x8c\x49\x50\xbd\x03\x57\x13\x6b\xa6\xdf\xda\xaa\xf5\xb1\x73\xed\x4d\x1f\x3b\xe4\x28\x75\x0e\x8c\x38\x77\x3e\x41\x2d\x4c\x8f\x85\x1b\xbd\x31\x54\x66\xc9\x7e\x75\xa9\x90\xa9\x2f\x53\xe3\xc8\xac\x62\x3d\xbe\x41\xa9\x2a\xd5\x61\x22\xf8\xc6\x9f\x0c\xf8\x73\xbf\xb4\x28\x2d\x19\xdf\xcc\xfe\x6f\x00\xd2\x6d\x97\x9d\x3e\xc7\x00\x00")
 
 func openapiYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -92,7 +92,7 @@ func openapiYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "openapi.yaml", size: 16304, mode: os.FileMode(493), modTime: time.Unix(1765460770, 0)}
+	info := bindataFileInfo{name: "openapi.yaml", size: 51006, mode: os.FileMode(493), modTime: time.Unix(1792278093, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"gorm.io/gorm"
+)
+
+// AuditAction is the mutating operation that an audit event records.
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+)
+
+// The types of the targets of the audit events.
+const (
+	AuditTargetResourceBundle = "ResourceBundle"
+	AuditTargetConsumer       = "Consumer"
+)
+
+// AuditEvent records who created, updated or deleted a resource bundle or a consumer. The audit events are
+// append-only, they are never updated or removed by maestro.
+type AuditEvent struct {
+	Meta
+
+	// Actor is the user of the REST request or the gRPC source client that performed the operation, it is empty
+	// if the operation was performed by maestro itself, e.g. by a placement.
+	Actor string
+	// Source is the source of the target resource bundle, it is empty for the consumers.
+	Source string
+	Action AuditAction
+	// TargetType is the type of the target, one of ResourceBundle or Consumer.
+	TargetType string
+	TargetID   string
+	// VersionBefore and VersionAfter are the versions of the target resource bundle before and after the
+	// operation, the version is 0 if the resource bundle did not exist, and they are 0 for the consumers.
+	VersionBefore int32
+	VersionAfter  int32
+	// RequestID is the operation ID of the REST request or the ID of the CloudEvent of the gRPC request.
+	RequestID string
+}
+
+type AuditEventList []*AuditEvent
+
+func (d *AuditEvent) BeforeCreate(tx *gorm.DB) error {
+	d.ID = NewID()
+	return nil
+}
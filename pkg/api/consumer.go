@@ -1,6 +1,8 @@
 package api
 
 import (
+	"fmt"
+
 	"gorm.io/gorm"
 
 	"github.com/openshift-online/maestro/pkg/db"
@@ -41,3 +43,31 @@ func (d *Consumer) BeforeCreate(tx *gorm.DB) error {
 
 type ConsumerPatchRequest struct {
 }
+
+// ConsumerDeletionPolicy decides how the resources of a consumer are handled when the consumer is deleted.
+type ConsumerDeletionPolicy string
+
+const (
+	// ConsumerDeletionPolicyRestrict refuses to delete the consumer if it still has resources, it is the default policy.
+	ConsumerDeletionPolicyRestrict ConsumerDeletionPolicy = "Restrict"
+	// ConsumerDeletionPolicyCascade marks all of the resources of the consumer as deleting, the consumer is removed
+	// once the agent confirms the deletion of its last resource.
+	ConsumerDeletionPolicyCascade ConsumerDeletionPolicy = "Cascade"
+	// ConsumerDeletionPolicyOrphan removes the resources of the consumer from the storage without telling the agent,
+	// and then removes the consumer. It is used to force the removal of a consumer whose agent is gone.
+	ConsumerDeletionPolicyOrphan ConsumerDeletionPolicy = "Orphan"
+)
+
+// ParseConsumerDeletionPolicy returns the consumer deletion policy of the given value, the restrict policy is
+// returned if the value is empty.
+func ParseConsumerDeletionPolicy(value string) (ConsumerDeletionPolicy, error) {
+	switch policy := ConsumerDeletionPolicy(value); policy {
+	case "":
+		return ConsumerDeletionPolicyRestrict, nil
+	case ConsumerDeletionPolicyRestrict, ConsumerDeletionPolicyCascade, ConsumerDeletionPolicyOrphan:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unsupported deletion policy %q, the supported policies are %s, %s and %s", value,
+			ConsumerDeletionPolicyRestrict, ConsumerDeletionPolicyCascade, ConsumerDeletionPolicyOrphan)
+	}
+}
@@ -0,0 +1,13 @@
+package api
+
+import "time"
+
+// ConsumerConnection records that the agent of a consumer has a gRPC broker stream on a maestro instance. The spec
+// events of the consumer are only handled by the instances that the agent is connected to.
+type ConsumerConnection struct {
+	ConsumerName string `gorm:"primaryKey"`
+	InstanceID   string `gorm:"primaryKey"`
+	CreatedAt    time.Time
+}
+
+type ConsumerConnectionList []*ConsumerConnection
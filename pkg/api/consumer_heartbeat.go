@@ -0,0 +1,67 @@
+package api
+
+import "time"
+
+// The condition types of the consumer status.
+const (
+	// ConsumerConditionConnected indicates whether the agent of the consumer is connected.
+	ConsumerConditionConnected = "Connected"
+	// ConsumerConditionLastStatusReceived indicates when the last resource status was received from the agent.
+	ConsumerConditionLastStatusReceived = "LastStatusReceived"
+	// ConsumerConditionAgentVersion reports the version of the agent.
+	ConsumerConditionAgentVersion = "AgentVersion"
+)
+
+// ConsumerHeartbeat records the liveness signals of the agent of a consumer that are observed by a maestro instance,
+// the signals are the broker connections, the resync requests and the status updates of the agent.
+// Each instance saves its own heartbeat, so the heartbeats of the instances that are gone are not refreshed anymore.
+type ConsumerHeartbeat struct {
+	ConsumerName string `gorm:"primaryKey"`
+	InstanceID   string `gorm:"primaryKey"`
+	// Connected is true if the agent is connected to the instance. For the message brokers that do not report the
+	// agent connections, the agent is treated as connected once a signal is received.
+	Connected bool
+	// LastHeartbeat is the last time the instance received a signal from the agent or saw it connected.
+	LastHeartbeat time.Time
+	// LastStatusReceived is the last time the instance received a resource status from the agent.
+	LastStatusReceived *time.Time
+	// AgentVersion is the version reported by the agent, it is empty if the agent does not report it.
+	AgentVersion string
+}
+
+type ConsumerHeartbeatList []*ConsumerHeartbeat
+
+// ConsumerStatus is the status of the agent of a consumer aggregated from the heartbeats of all the instances.
+type ConsumerStatus struct {
+	Connected          bool
+	LastSeen           *time.Time
+	LastStatusReceived *time.Time
+	AgentVersion       string
+}
+
+// NewConsumerStatus aggregates the heartbeats of a consumer. The agent is connected if any instance saw it connected
+// within the timeout, the heartbeats older than the timeout are treated as disconnected.
+func NewConsumerStatus(heartbeats ConsumerHeartbeatList, now time.Time, timeout time.Duration) *ConsumerStatus {
+	status := &ConsumerStatus{}
+	var versionReportedAt time.Time
+	for _, heartbeat := range heartbeats {
+		if heartbeat.Connected && now.Sub(heartbeat.LastHeartbeat) < timeout {
+			status.Connected = true
+		}
+		if status.LastSeen == nil || heartbeat.LastHeartbeat.After(*status.LastSeen) {
+			lastSeen := heartbeat.LastHeartbeat
+			status.LastSeen = &lastSeen
+		}
+		// the version reported by the latest heartbeat wins
+		if heartbeat.AgentVersion != "" && heartbeat.LastHeartbeat.After(versionReportedAt) {
+			status.AgentVersion = heartbeat.AgentVersion
+			versionReportedAt = heartbeat.LastHeartbeat
+		}
+		if heartbeat.LastStatusReceived != nil &&
+			(status.LastStatusReceived == nil || heartbeat.LastStatusReceived.After(*status.LastStatusReceived)) {
+			lastStatusReceived := *heartbeat.LastStatusReceived
+			status.LastStatusReceived = &lastStatusReceived
+		}
+	}
+	return status
+}
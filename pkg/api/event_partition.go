@@ -0,0 +1,15 @@
+package api
+
+import "time"
+
+// EventPartition is an hourly time partition of the events or the status events table. The events are
+// partitioned by their creation time, so the expired events are removed by dropping their partitions.
+type EventPartition struct {
+	Table string
+	Name  string
+	// From and To are the range of the creation time of the events in the partition, To is exclusive.
+	From time.Time
+	To   time.Time
+}
+
+type EventPartitionList []*EventPartition
@@ -60,4 +60,6 @@ type PagingMeta struct {
 	Page  int
 	Size  int64
 	Total int64
+	// Continue is the token to list the next page, it is empty if there are no more objects.
+	Continue string
 }
@@ -27,6 +27,7 @@ type Consumer struct {
 	Labels    *map[string]string `json:"labels,omitempty"`
 	CreatedAt *time.Time         `json:"created_at,omitempty"`
 	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+	Status    *ConsumerStatus    `json:"status,omitempty"`
 }
 
 // NewConsumer instantiates a new Consumer object
@@ -270,6 +271,38 @@ func (o *Consumer) SetUpdatedAt(v time.Time) {
 	o.UpdatedAt = &v
 }
 
+// GetStatus returns the Status field value if set, zero value otherwise.
+func (o *Consumer) GetStatus() ConsumerStatus {
+	if o == nil || IsNil(o.Status) {
+		var ret ConsumerStatus
+		return ret
+	}
+	return *o.Status
+}
+
+// GetStatusOk returns a tuple with the Status field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Consumer) GetStatusOk() (*ConsumerStatus, bool) {
+	if o == nil || IsNil(o.Status) {
+		return nil, false
+	}
+	return o.Status, true
+}
+
+// HasStatus returns a boolean if a field has been set.
+func (o *Consumer) HasStatus() bool {
+	if o != nil && !IsNil(o.Status) {
+		return true
+	}
+
+	return false
+}
+
+// SetStatus gets a reference to the given ConsumerStatus and assigns it to the Status field.
+func (o *Consumer) SetStatus(v ConsumerStatus) {
+	o.Status = &v
+}
+
 func (o Consumer) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -301,6 +334,9 @@ func (o Consumer) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.UpdatedAt) {
 		toSerialize["updated_at"] = o.UpdatedAt
 	}
+	if !IsNil(o.Status) {
+		toSerialize["status"] = o.Status
+	}
 	return toSerialize, nil
 }
 
@@ -21,10 +21,11 @@ var _ MappedNullable = &List{}
 
 // List struct for List
 type List struct {
-	Kind  string `json:"kind"`
-	Page  int32  `json:"page"`
-	Size  int32  `json:"size"`
-	Total int32  `json:"total"`
+	Kind     string  `json:"kind"`
+	Page     int32   `json:"page"`
+	Size     int32   `json:"size"`
+	Total    int32   `json:"total"`
+	Continue *string `json:"continue,omitempty"`
 }
 
 type _List List
@@ -146,6 +147,38 @@ func (o *List) SetTotal(v int32) {
 	o.Total = v
 }
 
+// GetContinue returns the Continue field value if set, zero value otherwise.
+func (o *List) GetContinue() string {
+	if o == nil || IsNil(o.Continue) {
+		var ret string
+		return ret
+	}
+	return *o.Continue
+}
+
+// GetContinueOk returns a tuple with the Continue field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *List) GetContinueOk() (*string, bool) {
+	if o == nil || IsNil(o.Continue) {
+		return nil, false
+	}
+	return o.Continue, true
+}
+
+// HasContinue returns a boolean if a field has been set.
+func (o *List) HasContinue() bool {
+	if o != nil && !IsNil(o.Continue) {
+		return true
+	}
+
+	return false
+}
+
+// SetContinue gets a reference to the given string and assigns it to the Continue field.
+func (o *List) SetContinue(v string) {
+	o.Continue = &v
+}
+
 func (o List) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -160,6 +193,9 @@ func (o List) ToMap() (map[string]interface{}, error) {
 	toSerialize["page"] = o.Page
 	toSerialize["size"] = o.Size
 	toSerialize["total"] = o.Total
+	if !IsNil(o.Continue) {
+		toSerialize["continue"] = o.Continue
+	}
 	return toSerialize, nil
 }
 
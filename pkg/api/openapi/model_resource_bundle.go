@@ -34,6 +34,7 @@ type ResourceBundle struct {
 	DeleteOption    map[string]interface{}   `json:"delete_option,omitempty"`
 	ManifestConfigs []map[string]interface{} `json:"manifest_configs,omitempty"`
 	Status          map[string]interface{}   `json:"status,omitempty"`
+	Rollout         *ResourceBundleRollout   `json:"rollout,omitempty"`
 }
 
 // NewResourceBundle instantiates a new ResourceBundle object
@@ -501,6 +502,38 @@ func (o *ResourceBundle) SetStatus(v map[string]interface{}) {
 	o.Status = v
 }
 
+// GetRollout returns the Rollout field value if set, zero value otherwise.
+func (o *ResourceBundle) GetRollout() ResourceBundleRollout {
+	if o == nil || IsNil(o.Rollout) {
+		var ret ResourceBundleRollout
+		return ret
+	}
+	return *o.Rollout
+}
+
+// GetRolloutOk returns a tuple with the Rollout field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ResourceBundle) GetRolloutOk() (*ResourceBundleRollout, bool) {
+	if o == nil || IsNil(o.Rollout) {
+		return nil, false
+	}
+	return o.Rollout, true
+}
+
+// HasRollout returns a boolean if a field has been set.
+func (o *ResourceBundle) HasRollout() bool {
+	if o != nil && !IsNil(o.Rollout) {
+		return true
+	}
+
+	return false
+}
+
+// SetRollout gets a reference to the given ResourceBundleRollout and assigns it to the Rollout field.
+func (o *ResourceBundle) SetRollout(v ResourceBundleRollout) {
+	o.Rollout = &v
+}
+
 func (o ResourceBundle) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -553,6 +586,9 @@ func (o ResourceBundle) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Status) {
 		toSerialize["status"] = o.Status
 	}
+	if !IsNil(o.Rollout) {
+		toSerialize["rollout"] = o.Rollout
+	}
 	return toSerialize, nil
 }
 
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// placementResourceNamespace is the namespace of the resource ids generated for the placements.
+var placementResourceNamespace = uuid.MustParse("5e2c1f4a-8d8e-4b55-9a2b-6e0f3b9c7d21")
+
+// PlacementTemplateEngineCEL renders the `{{ expression }}` placeholders in the placement payload with CEL.
+const PlacementTemplateEngineCEL = "CEL"
+
+// Placement is a resource bundle template that targets the consumers selected by a label selector. A resource
+// is created from the template for each of the selected consumers, and the resources are added or removed when
+// the consumers are created, relabeled or deleted.
+type Placement struct {
+	Meta
+
+	// Name must be unique and not null, it is the placement external ID.
+	// When creating a placement, if its name is not specified, the placement id will be used as its name.
+	//
+	// Cannot be updated.
+	Name string
+	// ConsumerSelector is a Kubernetes label selector, e.g. "env=prod,region=eu", the placement selects
+	// the consumers whose labels match it. An empty selector selects all of the consumers.
+	ConsumerSelector string
+	// Payload is the manifest bundle of the resources, it has the same format as the resource payload.
+	Payload datatypes.JSONMap
+	// TemplateEngine is the engine that renders the placeholders in the payload for each of the consumers, only
+	// "CEL" is supported. The payload is delivered as it is if the engine is not set.
+	TemplateEngine string
+	// Values maps the consumer names to the parameters that the payload is rendered with for the consumers.
+	Values datatypes.JSONMap
+}
+
+type PlacementList []*Placement
+type PlacementIndex map[string]*Placement
+
+func (l PlacementList) Index() PlacementIndex {
+	index := PlacementIndex{}
+	for _, o := range l {
+		index[o.ID] = o
+	}
+	return index
+}
+
+func (d *Placement) BeforeCreate(tx *gorm.DB) error {
+	d.ID = NewID()
+
+	if d.Name == "" {
+		d.Name = d.ID
+	}
+
+	return nil
+}
+
+// PlacementResourceID returns the id of the resource that is created by the placement for the consumer, the id
+// is deterministic, so a resource is never created twice for the same placement and consumer.
+func PlacementResourceID(placementID, consumerName string) string {
+	return uuid.NewSHA1(placementResourceNamespace, []byte(placementID+"/"+consumerName)).String()
+}
@@ -0,0 +1,27 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+// PresentAuditEvent converts an audit event from the API to the openapi representation.
+func PresentAuditEvent(auditEvent *api.AuditEvent) openapi.AuditEvent {
+	// the audit events are only listed, the href refers to the list of the audit events
+	return openapi.AuditEvent{
+		Id:            openapi.PtrString(auditEvent.ID),
+		Kind:          ObjectKind(auditEvent),
+		Href:          openapi.PtrString(fmt.Sprintf("%s/%s", BasePath, path(auditEvent))),
+		Actor:         openapi.PtrString(auditEvent.Actor),
+		Source:        openapi.PtrString(auditEvent.Source),
+		Action:        openapi.PtrString(string(auditEvent.Action)),
+		TargetType:    openapi.PtrString(auditEvent.TargetType),
+		TargetId:      openapi.PtrString(auditEvent.TargetID),
+		VersionBefore: openapi.PtrInt32(auditEvent.VersionBefore),
+		VersionAfter:  openapi.PtrInt32(auditEvent.VersionAfter),
+		RequestId:     openapi.PtrString(auditEvent.RequestID),
+		CreatedAt:     openapi.PtrTime(auditEvent.CreatedAt),
+	}
+}
@@ -29,3 +29,56 @@ func PresentConsumer(consumer *api.Consumer) openapi.Consumer {
 		UpdatedAt: openapi.PtrTime(consumer.UpdatedAt),
 	}
 }
+
+// PresentConsumerStatus presents the agent status of a consumer as the Connected, LastStatusReceived and AgentVersion
+// conditions.
+func PresentConsumerStatus(status *api.ConsumerStatus) *openapi.ConsumerStatus {
+	if status == nil {
+		status = &api.ConsumerStatus{}
+	}
+
+	connected := openapi.ConsumerCondition{
+		Type:           openapi.PtrString(api.ConsumerConditionConnected),
+		Status:         openapi.PtrString("False"),
+		Reason:         openapi.PtrString("AgentNeverSeen"),
+		Message:        openapi.PtrString("No heartbeat has been received from the agent"),
+		LastUpdateTime: status.LastSeen,
+	}
+	if status.Connected {
+		connected.Status = openapi.PtrString("True")
+		connected.Reason = openapi.PtrString("AgentConnected")
+		connected.Message = openapi.PtrString("The agent is connected")
+	} else if status.LastSeen != nil {
+		connected.Reason = openapi.PtrString("AgentDisconnected")
+		connected.Message = openapi.PtrString("No heartbeat has been received from the agent recently")
+	}
+
+	lastStatusReceived := openapi.ConsumerCondition{
+		Type:           openapi.PtrString(api.ConsumerConditionLastStatusReceived),
+		Status:         openapi.PtrString("False"),
+		Reason:         openapi.PtrString("NoStatusReceived"),
+		Message:        openapi.PtrString("No resource status has been received from the agent"),
+		LastUpdateTime: status.LastStatusReceived,
+	}
+	if status.LastStatusReceived != nil {
+		lastStatusReceived.Status = openapi.PtrString("True")
+		lastStatusReceived.Reason = openapi.PtrString("StatusReceived")
+		lastStatusReceived.Message = openapi.PtrString("A resource status has been received from the agent")
+	}
+
+	agentVersion := openapi.ConsumerCondition{
+		Type:    openapi.PtrString(api.ConsumerConditionAgentVersion),
+		Status:  openapi.PtrString("Unknown"),
+		Reason:  openapi.PtrString("AgentVersionNotReported"),
+		Message: openapi.PtrString("The agent does not report its version"),
+	}
+	if status.AgentVersion != "" {
+		agentVersion.Status = openapi.PtrString("True")
+		agentVersion.Reason = openapi.PtrString("AgentVersionReported")
+		agentVersion.Message = openapi.PtrString(status.AgentVersion)
+	}
+
+	return &openapi.ConsumerStatus{
+		Conditions: []openapi.ConsumerCondition{connected, lastStatusReceived, agentVersion},
+	}
+}
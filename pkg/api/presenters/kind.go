@@ -13,10 +13,24 @@ func ObjectKind(i interface{}) *string {
 		result = "Consumer"
 	case api.ConsumerList, *api.ConsumerList, []api.Consumer, []*api.Consumer:
 		result = "ConsumerList"
+	case api.Placement, *api.Placement:
+		result = "Placement"
+	case api.PlacementList, *api.PlacementList, []api.Placement, []*api.Placement:
+		result = "PlacementList"
 	case api.Resource, *api.Resource:
 		result = "ResourceBundle"
 	case api.ResourceList, *api.ResourceList, []api.Resource, []*api.Resource:
 		result = "ResourceBundleList"
+	case api.ResourceRevision, *api.ResourceRevision:
+		result = "ResourceBundleRevision"
+	case api.ResourceRevisionList, *api.ResourceRevisionList, []api.ResourceRevision, []*api.ResourceRevision:
+		result = "ResourceBundleRevisionList"
+	case api.ResourceDiff, *api.ResourceDiff:
+		result = "ResourceBundleDiff"
+	case api.AuditEvent, *api.AuditEvent:
+		result = "AuditEvent"
+	case api.AuditEventList, *api.AuditEventList, []api.AuditEvent, []*api.AuditEvent:
+		result = "AuditEventList"
 	case errors.ServiceError, *errors.ServiceError:
 		result = "Error"
 	}
@@ -22,6 +22,10 @@ func path(i interface{}) string {
 		return "resource-bundles"
 	case api.Consumer, *api.Consumer:
 		return "consumers"
+	case api.Placement, *api.Placement:
+		return "placements"
+	case api.AuditEvent, *api.AuditEvent:
+		return "audit-events"
 	case errors.ServiceError, *errors.ServiceError:
 		return "errors"
 	default:
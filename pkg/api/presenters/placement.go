@@ -0,0 +1,70 @@
+package presenters
+
+import (
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/constants"
+	"github.com/openshift-online/maestro/pkg/util"
+)
+
+// ConvertPlacement converts a placement from the openapi representation to the API placement.
+func ConvertPlacement(placement openapi.Placement) (*api.Placement, error) {
+	payload, err := api.EncodeManifestBundle(constants.DefaultSourceID, &api.ManifestBundleWrapper{
+		Meta:            placement.Metadata,
+		Manifests:       placement.Manifests,
+		ManifestConfigs: placement.ManifestConfigs,
+		DeleteOption:    placement.DeleteOption,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Placement{
+		Meta: api.Meta{
+			ID: util.NilToEmptyString(placement.Id),
+		},
+		Name:             util.NilToEmptyString(placement.Name),
+		ConsumerSelector: util.NilToEmptyString(placement.ConsumerSelector),
+		Payload:          payload,
+		TemplateEngine:   util.NilToEmptyString(placement.TemplateEngine),
+		Values:           placement.Values,
+	}, nil
+}
+
+// PresentPlacement converts a placement from the API to the openapi representation.
+func PresentPlacement(placement *api.Placement) (*openapi.Placement, error) {
+	manifestWrapper, err := api.DecodeManifestBundle(placement.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	reference := PresentReference(placement.ID, placement)
+	p := &openapi.Placement{
+		Id:               reference.Id,
+		Kind:             reference.Kind,
+		Href:             reference.Href,
+		Name:             openapi.PtrString(placement.Name),
+		ConsumerSelector: openapi.PtrString(placement.ConsumerSelector),
+		CreatedAt:        openapi.PtrTime(placement.CreatedAt),
+		UpdatedAt:        openapi.PtrTime(placement.UpdatedAt),
+		Values:           placement.Values,
+	}
+
+	if placement.TemplateEngine != "" {
+		p.TemplateEngine = openapi.PtrString(placement.TemplateEngine)
+	}
+
+	if manifestWrapper != nil {
+		p.Metadata = manifestWrapper.Meta
+		p.Manifests = manifestWrapper.Manifests
+		p.ManifestConfigs = manifestWrapper.ManifestConfigs
+		p.DeleteOption = manifestWrapper.DeleteOption
+	}
+
+	// set the deletedAt field if the placement has been marked as deleted
+	if !placement.DeletedAt.Time.IsZero() {
+		p.DeletedAt = openapi.PtrTime(placement.DeletedAt.Time)
+	}
+
+	return p, nil
+}
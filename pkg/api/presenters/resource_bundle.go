@@ -3,8 +3,33 @@ package presenters
 import (
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/constants"
+	"github.com/openshift-online/maestro/pkg/util"
 )
 
+// ConvertResourceBundle converts a resource bundle from the openapi representation to the API resource.
+func ConvertResourceBundle(resourceBundle openapi.ResourceBundle) (*api.Resource, error) {
+	payload, err := api.EncodeManifestBundle(constants.DefaultSourceID, &api.ManifestBundleWrapper{
+		Meta:            resourceBundle.Metadata,
+		Manifests:       resourceBundle.Manifests,
+		ManifestConfigs: resourceBundle.ManifestConfigs,
+		DeleteOption:    resourceBundle.DeleteOption,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Resource{
+		Meta: api.Meta{
+			ID: util.NilToEmptyString(resourceBundle.Id),
+		},
+		Name:         util.NilToEmptyString(resourceBundle.Name),
+		Source:       constants.DefaultSourceID,
+		ConsumerName: util.NilToEmptyString(resourceBundle.ConsumerName),
+		Payload:      payload,
+	}, nil
+}
+
 // PresentResourceBundle converts a resource from the API to the openapi representation.
 func PresentResourceBundle(resource *api.Resource) (*openapi.ResourceBundle, error) {
 	manifestWrapper, err := api.DecodeManifestBundle(resource.Payload)
@@ -15,6 +40,10 @@ func PresentResourceBundle(resource *api.Resource) (*openapi.ResourceBundle, err
 	if err != nil {
 		return nil, err
 	}
+	rollout, err := api.NewResourceBundleRollout(resource)
+	if err != nil {
+		return nil, err
+	}
 
 	reference := PresentReference(resource.ID, resource)
 	rb := &openapi.ResourceBundle{
@@ -27,6 +56,7 @@ func PresentResourceBundle(resource *api.Resource) (*openapi.ResourceBundle, err
 		CreatedAt:    openapi.PtrTime(resource.CreatedAt),
 		UpdatedAt:    openapi.PtrTime(resource.UpdatedAt),
 		Status:       status,
+		Rollout:      PresentResourceBundleRollout(rollout),
 	}
 
 	if manifestWrapper != nil {
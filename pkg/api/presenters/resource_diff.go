@@ -0,0 +1,49 @@
+package presenters
+
+import (
+	"strings"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+// PresentResourceDiff converts a resource diff from the API to the openapi representation.
+func PresentResourceDiff(diff *api.ResourceDiff) *openapi.ResourceBundleDiff {
+	rd := &openapi.ResourceBundleDiff{
+		Kind:             ObjectKind(diff),
+		Action:           openapi.PtrString(strings.ToLower(string(diff.Action))),
+		ConsumerName:     openapi.PtrString(diff.ConsumerName),
+		CurrentVersion:   openapi.PtrInt32(diff.CurrentVersion),
+		Changed:          openapi.PtrBool(diff.Changed),
+		Fields:           presentFieldDiffs(diff.Fields),
+		ValidationErrors: diff.ValidationErrors,
+	}
+	if diff.ResourceID != "" {
+		rd.Id = openapi.PtrString(diff.ResourceID)
+	}
+
+	for _, manifest := range diff.Manifests {
+		rd.Manifests = append(rd.Manifests, openapi.ResourceBundleManifestDiff{
+			Change:     openapi.PtrString(string(manifest.Change)),
+			ApiVersion: openapi.PtrString(manifest.APIVersion),
+			Kind:       openapi.PtrString(manifest.Kind),
+			Namespace:  openapi.PtrString(manifest.Namespace),
+			Name:       openapi.PtrString(manifest.Name),
+			Fields:     presentFieldDiffs(manifest.Fields),
+		})
+	}
+
+	return rd
+}
+
+func presentFieldDiffs(fields []api.FieldDiff) []openapi.ResourceBundleFieldDiff {
+	var result []openapi.ResourceBundleFieldDiff
+	for _, field := range fields {
+		result = append(result, openapi.ResourceBundleFieldDiff{
+			Path:     openapi.PtrString(field.Path),
+			OldValue: field.OldValue,
+			NewValue: field.NewValue,
+		})
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"fmt"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+// PresentResourceRevision converts a resource revision from the API to the openapi representation.
+func PresentResourceRevision(revision *api.ResourceRevision) (*openapi.ResourceBundleRevision, error) {
+	manifestWrapper, err := api.DecodeManifestBundle(revision.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &openapi.ResourceBundleRevision{
+		Id:               openapi.PtrString(revision.ID),
+		Kind:             ObjectKind(revision),
+		Href:             openapi.PtrString(fmt.Sprintf("%s/revisions", *ObjectPath(revision.ResourceID, &api.Resource{}))),
+		ResourceBundleId: openapi.PtrString(revision.ResourceID),
+		Version:          openapi.PtrInt32(revision.Version),
+		Source:           openapi.PtrString(revision.Source),
+		Author:           openapi.PtrString(revision.Author),
+		CreatedAt:        openapi.PtrTime(revision.CreatedAt),
+	}
+
+	if manifestWrapper != nil {
+		rv.Metadata = manifestWrapper.Meta
+		rv.Manifests = manifestWrapper.Manifests
+		rv.ManifestConfigs = manifestWrapper.ManifestConfigs
+		rv.DeleteOption = manifestWrapper.DeleteOption
+	}
+
+	return rv, nil
+}
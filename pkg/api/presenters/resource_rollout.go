@@ -0,0 +1,33 @@
+package presenters
+
+import (
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+)
+
+// PresentResourceBundleRollout converts a resource bundle rollout from the API to the openapi representation.
+func PresentResourceBundleRollout(rollout *api.ResourceBundleRollout) *openapi.ResourceBundleRollout {
+	rr := &openapi.ResourceBundleRollout{
+		Phase:           openapi.PtrString(string(rollout.Phase)),
+		ObservedVersion: openapi.PtrInt32(rollout.ObservedVersion),
+		UpToDate:        openapi.PtrBool(rollout.UpToDate),
+	}
+
+	for _, manifest := range rollout.Manifests {
+		rr.Manifests = append(rr.Manifests, openapi.ResourceBundleManifestRollout{
+			Ordinal:   openapi.PtrInt32(manifest.Ordinal),
+			Group:     openapi.PtrString(manifest.Group),
+			Version:   openapi.PtrString(manifest.Version),
+			Kind:      openapi.PtrString(manifest.Kind),
+			Resource:  openapi.PtrString(manifest.Resource),
+			Namespace: openapi.PtrString(manifest.Namespace),
+			Name:      openapi.PtrString(manifest.Name),
+			Phase:     openapi.PtrString(string(manifest.Phase)),
+			Applied:   openapi.PtrString(string(manifest.Applied)),
+			Available: openapi.PtrString(string(manifest.Available)),
+			Message:   openapi.PtrString(manifest.Message),
+		})
+	}
+
+	return rr
+}
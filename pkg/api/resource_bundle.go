@@ -6,6 +6,7 @@ import (
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	cloudeventstypes "github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/uuid"
 	"gorm.io/datatypes"
 	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
@@ -99,9 +100,83 @@ func DecodeManifestBundle(manifestBundle datatypes.JSONMap) (*ManifestBundleWrap
 	}, nil
 }
 
+// EncodeManifestBundle converts a manifest bundle wrapper (metadata, manifests, manifest configs and
+// delete option) from openapi input into a CloudEvent JSONMap representation of the resource manifest bundle.
+func EncodeManifestBundle(source string, wrapper *ManifestBundleWrapper) (datatypes.JSONMap, error) {
+	if wrapper == nil {
+		return nil, nil
+	}
+
+	data := map[string]interface{}{
+		"manifests": wrapper.Manifests,
+	}
+	if len(wrapper.ManifestConfigs) > 0 {
+		data["manifestConfigs"] = wrapper.ManifestConfigs
+	}
+	if len(wrapper.DeleteOption) > 0 {
+		data["deleteOption"] = wrapper.DeleteOption
+	}
+
+	evt := cloudevents.NewEvent()
+	evt.SetID(uuid.New().String())
+	evt.SetSource(source)
+	evt.SetType(types.CloudEventsType{
+		CloudEventsDataType: workpayload.ManifestBundleEventDataType,
+		SubResource:         types.SubResourceSpec,
+		Action:              types.UpdateRequestAction,
+	}.String())
+
+	if len(wrapper.Meta) > 0 {
+		metaJson, err := json.Marshal(wrapper.Meta)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal work meta: %v", err)
+		}
+		evt.SetExtension(types.ExtensionWorkMeta, string(metaJson))
+	}
+
+	if err := evt.SetData(cloudevents.ApplicationJSON, data); err != nil {
+		return nil, fmt.Errorf("failed to set cloudevent data: %v", err)
+	}
+
+	// ensure the manifests can be decoded as a manifest bundle
+	if err := evt.DataAs(&workpayload.ManifestBundle{}); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest bundle: %v", err)
+	}
+
+	manifestBundle, err := CloudEventToJSONMap(&evt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert cloudevent to resource manifest bundle: %v", err)
+	}
+
+	return manifestBundle, nil
+}
+
 // DecodeBundleStatus converts a CloudEvent JSONMap representation of a resource bundle status
 // into resource bundle status (map[string]interface{}) in openapi output.
 func DecodeBundleStatus(status datatypes.JSONMap) (map[string]interface{}, error) {
+	resourceBundleStatus, err := decodeResourceBundleStatus(status)
+	if err != nil {
+		return nil, err
+	}
+	if resourceBundleStatus == nil {
+		return nil, nil
+	}
+
+	resourceBundleStatusJSON, err := json.Marshal(resourceBundleStatus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource status: %v", err)
+	}
+	resourceBundleStatusMap := make(map[string]interface{})
+	if err := json.Unmarshal(resourceBundleStatusJSON, &resourceBundleStatusMap); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resource status: %v", err)
+	}
+
+	return resourceBundleStatusMap, nil
+}
+
+// decodeResourceBundleStatus converts a CloudEvent JSONMap representation of a resource bundle status
+// into resource bundle status, nil is returned if the status is empty.
+func decodeResourceBundleStatus(status datatypes.JSONMap) (*ResourceBundleStatus, error) {
 	if len(status) == 0 {
 		return nil, nil
 	}
@@ -130,16 +205,8 @@ func DecodeBundleStatus(status datatypes.JSONMap) (map[string]interface{}, error
 	if err := evt.DataAs(resourceBundleStatus.ManifestBundleStatus); err != nil {
 		return nil, fmt.Errorf("failed to decode cloudevent payload: %v", err)
 	}
-	resourceBundleStatusJSON, err := json.Marshal(resourceBundleStatus)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal resource status: %v", err)
-	}
-	resourceBundleStatusMap := make(map[string]interface{})
-	if err := json.Unmarshal(resourceBundleStatusJSON, &resourceBundleStatusMap); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal resource status: %v", err)
-	}
 
-	return resourceBundleStatusMap, nil
+	return resourceBundleStatus, nil
 }
 
 // JSONMAPToCloudEvent converts a JSONMap (resource manifest or status) to a CloudEvent
@@ -71,6 +71,78 @@ func TestDecodeManifestBundle(t *testing.T) {
 	}
 }
 
+func TestEncodeManifestBundle(t *testing.T) {
+	cases := []struct {
+		name          string
+		input         *ManifestBundleWrapper
+		expectedNil   bool
+		expectedError bool
+	}{
+		{
+			name:        "nil",
+			input:       nil,
+			expectedNil: true,
+		},
+		{
+			name: "valid",
+			input: &ManifestBundleWrapper{
+				Meta: map[string]any{
+					"labels": map[string]any{"app": "nginx"},
+				},
+				Manifests: newJSONMAPList(t, []string{
+					"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}}",
+				}...),
+				ManifestConfigs: newJSONMAPList(t, []string{
+					"{\"updateStrategy\":{\"type\":\"ServerSideApply\"},\"resourceIdentifier\":{\"name\":\"nginx\",\"group\":\"\",\"resource\":\"configmaps\",\"namespace\":\"default\"}}",
+				}...),
+				DeleteOption: map[string]any{
+					"propagationPolicy": "Orphan",
+				},
+			},
+		},
+		{
+			name: "invalid manifests",
+			input: &ManifestBundleWrapper{
+				Manifests: []map[string]any{},
+				DeleteOption: map[string]any{
+					"propagationPolicy": 1,
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			gotManifestBundle, err := EncodeManifestBundle("maestro", c.input)
+			if c.expectedError {
+				if err == nil {
+					t.Errorf("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if c.expectedNil {
+				if gotManifestBundle != nil {
+					t.Errorf("expected nil manifest bundle but got: %#v", gotManifestBundle)
+				}
+				return
+			}
+
+			// the encoded manifest bundle should be decoded to the original input
+			gotManifestBundleWrapper, err := DecodeManifestBundle(gotManifestBundle)
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if !equality.Semantic.DeepEqual(c.input, gotManifestBundleWrapper) {
+				t.Errorf("expected %#v but got: %#v", c.input, gotManifestBundleWrapper)
+			}
+		})
+	}
+}
+
 func TestDecodeBundleStatus(t *testing.T) {
 	cases := []struct {
 		name             string
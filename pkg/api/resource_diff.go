@@ -0,0 +1,52 @@
+package api
+
+// ManifestChange is the change of a manifest in a resource diff.
+type ManifestChange string
+
+const (
+	ManifestAdded     ManifestChange = "added"
+	ManifestRemoved   ManifestChange = "removed"
+	ManifestChanged   ManifestChange = "changed"
+	ManifestUnchanged ManifestChange = "unchanged"
+)
+
+// ResourceDiff is the result of a dry run of a resource create or update, it describes the changes that would be
+// applied to the stored manifest bundle of the resource and the validation results of the new manifest bundle.
+type ResourceDiff struct {
+	// ResourceID is the id of the resource, it is empty for a create without an id.
+	ResourceID   string
+	ConsumerName string
+	// Action is the action of the dry run, either CreateEventType or UpdateEventType.
+	Action EventType
+	// CurrentVersion is the version of the stored resource, it is 0 for a create.
+	CurrentVersion int32
+	// Changed is true if the manifest bundle would be changed, an update without changes is not applied.
+	Changed   bool
+	Manifests []ManifestDiff
+	// Fields are the changes of the manifest bundle fields other than the manifests, e.g. the manifest
+	// configs and the delete option.
+	Fields []FieldDiff
+	// ValidationErrors are the errors that would reject the create or update, it is empty if the new
+	// manifest bundle is valid.
+	ValidationErrors []string
+}
+
+// ManifestDiff is the change of a manifest identified by its apiVersion, kind, namespace and name.
+type ManifestDiff struct {
+	Change     ManifestChange
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+	// Fields are the changed fields of a changed manifest, they are empty for the other changes.
+	Fields []FieldDiff
+}
+
+// FieldDiff is the change of a field, the path of the field is in the form of "spec.containers[0].image".
+// The old and new values are JSON encoded, the old value is nil for an added field and the new value is
+// nil for a removed field.
+type FieldDiff struct {
+	Path     string
+	OldValue *string
+	NewValue *string
+}
@@ -0,0 +1,31 @@
+package api
+
+import (
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ResourceRevision is a version of the resource spec, a revision is saved each time the resource is created or its
+// manifest bundle is updated, so the previous specs of the resource can be inspected and rolled back to.
+type ResourceRevision struct {
+	Meta
+
+	// ResourceID is the id of the resource, the revisions are removed with the resource.
+	ResourceID string
+	// Version is the version of the resource that the revision was applied as.
+	Version int32
+	// Source is the source of the resource, e.g. "maestro" for the resources applied with the RESTful API.
+	Source string
+	// Author is the user of the REST request or the gRPC source client that applied the revision, it is empty
+	// if the revision was not applied by an authenticated caller, e.g. by a placement.
+	Author string
+	// Payload is the manifest bundle of the revision, it has the same format as the resource payload.
+	Payload datatypes.JSONMap
+}
+
+type ResourceRevisionList []*ResourceRevision
+
+func (d *ResourceRevision) BeforeCreate(tx *gorm.DB) error {
+	d.ID = NewID()
+	return nil
+}
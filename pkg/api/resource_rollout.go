@@ -0,0 +1,167 @@
+package api
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	workv1 "open-cluster-management.io/api/work/v1"
+)
+
+// RolloutPhase is the phase of a resource bundle rollout, it is computed from the status reported by the agent.
+type RolloutPhase string
+
+const (
+	// RolloutPending means the agent has not reported the status of the current version yet.
+	RolloutPending RolloutPhase = "Pending"
+	// RolloutApplied means the manifests of the current version are applied on the consumer.
+	RolloutApplied RolloutPhase = "Applied"
+	// RolloutAvailable means the manifests of the current version are applied and available on the consumer.
+	RolloutAvailable RolloutPhase = "Available"
+	// RolloutDegraded means the manifests of the current version cannot be applied or are not available.
+	RolloutDegraded RolloutPhase = "Degraded"
+	// RolloutDeleting means the resource bundle is being deleted.
+	RolloutDeleting RolloutPhase = "Deleting"
+)
+
+// WaitableRolloutPhases are the rollout phases that can be waited for.
+var WaitableRolloutPhases = []RolloutPhase{RolloutApplied, RolloutAvailable}
+
+// Reached returns true if the phase is the target phase or a later one, e.g. an Available resource bundle
+// has reached the Applied phase too.
+func (p RolloutPhase) Reached(target RolloutPhase) bool {
+	switch target {
+	case RolloutApplied:
+		return p == RolloutApplied || p == RolloutAvailable
+	default:
+		return p == target
+	}
+}
+
+// ResourceBundleRollout is the summary of the rollout of a resource bundle, so the clients do not need to
+// interpret the raw manifest bundle status.
+type ResourceBundleRollout struct {
+	Phase RolloutPhase
+	// ObservedVersion is the version of the resource bundle that the status is reported for.
+	ObservedVersion int32
+	// UpToDate is true if the status is reported for the current version of the resource bundle.
+	UpToDate  bool
+	Manifests []ManifestRollout
+}
+
+// ManifestRollout is the rollout summary of a manifest in the resource bundle.
+type ManifestRollout struct {
+	Ordinal   int32
+	Group     string
+	Version   string
+	Kind      string
+	Resource  string
+	Namespace string
+	Name      string
+	Phase     RolloutPhase
+	// Applied and Available are the statuses of the Applied and Available conditions of the manifest,
+	// they are Unknown if the condition is not reported.
+	Applied   metav1.ConditionStatus
+	Available metav1.ConditionStatus
+	// Message is the message of the condition that the phase is computed from.
+	Message string
+}
+
+// NewResourceBundleRollout computes the rollout summary of the resource bundle from its status. The resource
+// bundle is Pending until the agent reports the status of its current version, then it is Degraded if any of
+// the manifests cannot be applied or is not available, otherwise it is Applied or Available according to the
+// conditions of the manifest bundle.
+func NewResourceBundleRollout(resource *Resource) (*ResourceBundleRollout, error) {
+	status, err := decodeResourceBundleStatus(resource.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	rollout := &ResourceBundleRollout{Phase: RolloutPending}
+	if status != nil {
+		rollout.ObservedVersion = status.ObservedVersion
+		rollout.UpToDate = status.ObservedVersion == resource.Version
+		for _, manifestStatus := range status.ResourceStatus {
+			rollout.Manifests = append(rollout.Manifests, newManifestRollout(manifestStatus))
+		}
+		if rollout.UpToDate {
+			rollout.Phase = bundleRolloutPhase(status.Conditions, rollout.Manifests)
+		}
+	}
+
+	// the status of a deleting resource bundle is kept until the agent removes the manifests
+	if !resource.DeletedAt.Time.IsZero() {
+		rollout.Phase = RolloutDeleting
+	}
+
+	return rollout, nil
+}
+
+func bundleRolloutPhase(conditions []metav1.Condition, manifests []ManifestRollout) RolloutPhase {
+	for _, manifest := range manifests {
+		if manifest.Phase == RolloutDegraded {
+			return RolloutDegraded
+		}
+	}
+	phase, _ := rolloutPhase(conditions)
+	return phase
+}
+
+func newManifestRollout(manifestStatus workv1.ManifestCondition) ManifestRollout {
+	phase, message := rolloutPhase(manifestStatus.Conditions)
+	return ManifestRollout{
+		Ordinal:   manifestStatus.ResourceMeta.Ordinal,
+		Group:     manifestStatus.ResourceMeta.Group,
+		Version:   manifestStatus.ResourceMeta.Version,
+		Kind:      manifestStatus.ResourceMeta.Kind,
+		Resource:  manifestStatus.ResourceMeta.Resource,
+		Namespace: manifestStatus.ResourceMeta.Namespace,
+		Name:      manifestStatus.ResourceMeta.Name,
+		Phase:     phase,
+		Applied:   conditionStatus(manifestStatus.Conditions, workv1.ManifestApplied),
+		Available: conditionStatus(manifestStatus.Conditions, workv1.ManifestAvailable),
+		Message:   message,
+	}
+}
+
+// rolloutPhase computes the rollout phase from the Applied, Available and Degraded conditions, the message
+// of the condition that the phase is computed from is returned too.
+func rolloutPhase(conds []metav1.Condition) (RolloutPhase, string) {
+	if degraded := meta.FindStatusCondition(conds, workv1.WorkDegraded); degraded != nil && degraded.Status == metav1.ConditionTrue {
+		return RolloutDegraded, degraded.Message
+	}
+
+	applied := meta.FindStatusCondition(conds, workv1.WorkApplied)
+	available := meta.FindStatusCondition(conds, workv1.WorkAvailable)
+	for _, cond := range []*metav1.Condition{applied, available} {
+		if cond != nil && cond.Status == metav1.ConditionFalse {
+			return RolloutDegraded, cond.Message
+		}
+	}
+
+	switch {
+	case available != nil && available.Status == metav1.ConditionTrue:
+		return RolloutAvailable, available.Message
+	case applied != nil && applied.Status == metav1.ConditionTrue:
+		return RolloutApplied, applied.Message
+	default:
+		return RolloutPending, ""
+	}
+}
+
+func conditionStatus(conds []metav1.Condition, conditionType string) metav1.ConditionStatus {
+	if cond := meta.FindStatusCondition(conds, conditionType); cond != nil {
+		return cond.Status
+	}
+	return metav1.ConditionUnknown
+}
+
+// ParseRolloutPhase parses a rollout phase that can be waited for.
+func ParseRolloutPhase(phase string) (RolloutPhase, error) {
+	for _, waitable := range WaitableRolloutPhases {
+		if string(waitable) == phase {
+			return waitable, nil
+		}
+	}
+	return "", fmt.Errorf("the rollout phase %q cannot be waited for, it must be one of %v", phase, WaitableRolloutPhases)
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func TestNewResourceBundleRollout(t *testing.T) {
+	applied := `{"type":"Applied","status":"True","reason":"Applied","message":"applied"}`
+	available := `{"type":"Available","status":"True","reason":"Available","message":"available"}`
+	notAvailable := `{"type":"Available","status":"False","reason":"NotAvailable","message":"not available"}`
+
+	cases := []struct {
+		name              string
+		version           int32
+		status            datatypes.JSONMap
+		deleted           bool
+		expectedPhase     RolloutPhase
+		expectedUpToDate  bool
+		expectedManifests []ManifestRollout
+	}{
+		{
+			name:          "no status",
+			version:       1,
+			expectedPhase: RolloutPending,
+		},
+		{
+			name:          "status of a previous version",
+			version:       2,
+			status:        newStatusJSONMap(t, 1, []string{applied, available}, []string{applied, available}),
+			expectedPhase: RolloutPending,
+			expectedManifests: []ManifestRollout{
+				{Kind: "ConfigMap", Namespace: "default", Name: "web", Phase: RolloutAvailable, Applied: "True", Available: "True", Message: "available"},
+			},
+		},
+		{
+			name:             "applied",
+			version:          1,
+			status:           newStatusJSONMap(t, 1, []string{applied}, []string{applied}),
+			expectedPhase:    RolloutApplied,
+			expectedUpToDate: true,
+			expectedManifests: []ManifestRollout{
+				{Kind: "ConfigMap", Namespace: "default", Name: "web", Phase: RolloutApplied, Applied: "True", Available: "Unknown", Message: "applied"},
+			},
+		},
+		{
+			name:             "available",
+			version:          1,
+			status:           newStatusJSONMap(t, 1, []string{applied, available}, []string{applied, available}),
+			expectedPhase:    RolloutAvailable,
+			expectedUpToDate: true,
+			expectedManifests: []ManifestRollout{
+				{Kind: "ConfigMap", Namespace: "default", Name: "web", Phase: RolloutAvailable, Applied: "True", Available: "True", Message: "available"},
+			},
+		},
+		{
+			name:             "degraded manifest",
+			version:          1,
+			status:           newStatusJSONMap(t, 1, []string{applied}, []string{applied, notAvailable}),
+			expectedPhase:    RolloutDegraded,
+			expectedUpToDate: true,
+			expectedManifests: []ManifestRollout{
+				{Kind: "ConfigMap", Namespace: "default", Name: "web", Phase: RolloutDegraded, Applied: "True", Available: "False", Message: "not available"},
+			},
+		},
+		{
+			name:             "deleting",
+			version:          1,
+			status:           newStatusJSONMap(t, 1, []string{applied, available}, []string{applied, available}),
+			deleted:          true,
+			expectedPhase:    RolloutDeleting,
+			expectedUpToDate: true,
+			expectedManifests: []ManifestRollout{
+				{Kind: "ConfigMap", Namespace: "default", Name: "web", Phase: RolloutAvailable, Applied: "True", Available: "True", Message: "available"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resource := &Resource{Version: c.version, Status: c.status}
+			if c.deleted {
+				resource.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+			}
+
+			rollout, err := NewResourceBundleRollout(resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if rollout.Phase != c.expectedPhase {
+				t.Errorf("expected phase %s but got %s", c.expectedPhase, rollout.Phase)
+			}
+			if rollout.UpToDate != c.expectedUpToDate {
+				t.Errorf("expected up to date %v but got %v", c.expectedUpToDate, rollout.UpToDate)
+			}
+			if len(rollout.Manifests) != len(c.expectedManifests) {
+				t.Fatalf("expected %d manifests but got %d", len(c.expectedManifests), len(rollout.Manifests))
+			}
+			for i, manifest := range rollout.Manifests {
+				expected := c.expectedManifests[i]
+				expected.Version = "v1"
+				expected.Resource = "configmaps"
+				if manifest != expected {
+					t.Errorf("expected manifest %#v but got %#v", expected, manifest)
+				}
+			}
+		})
+	}
+}
+
+func TestRolloutPhaseReached(t *testing.T) {
+	cases := []struct {
+		phase    RolloutPhase
+		target   RolloutPhase
+		expected bool
+	}{
+		{phase: RolloutAvailable, target: RolloutApplied, expected: true},
+		{phase: RolloutApplied, target: RolloutApplied, expected: true},
+		{phase: RolloutApplied, target: RolloutAvailable, expected: false},
+		{phase: RolloutDegraded, target: RolloutApplied, expected: false},
+		{phase: RolloutPending, target: RolloutAvailable, expected: false},
+	}
+
+	for _, c := range cases {
+		if reached := c.phase.Reached(c.target); reached != c.expected {
+			t.Errorf("expected %s reached %s to be %v", c.phase, c.target, c.expected)
+		}
+	}
+}
+
+func newStatusJSONMap(t *testing.T, observedVersion int32, bundleConditions, manifestConditions []string) datatypes.JSONMap {
+	return newJSONMap(t, fmt.Sprintf(`{"specversion":"1.0","id":"dfaa4da7-915a-4060-962e-4c741c979989",`+
+		`"type":"io.open-cluster-management.works.v1alpha1.manifestbundles.status.update_request","source":"cluster1-work-agent",`+
+		`"datacontenttype":"application/json","resourceversion":"%d","sequenceid":"1792842398301163520",`+
+		`"data":{"conditions":[%s],"resourceStatus":[{"conditions":[%s],`+
+		`"resourceMeta":{"ordinal":0,"group":"","version":"v1","kind":"ConfigMap","resource":"configmaps","namespace":"default","name":"web"}}]}}`,
+		observedVersion, strings.Join(bundleConditions, ","), strings.Join(manifestConditions, ",")))
+}
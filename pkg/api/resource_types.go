@@ -11,6 +11,16 @@ import (
 
 type ResourceType string
 
+// PatchType is the media type of a patch of the manifest bundle of a resource.
+type PatchType string
+
+const (
+	// JSONPatchType is a JSON patch (RFC 6902).
+	JSONPatchType PatchType = "application/json-patch+json"
+	// MergePatchType is a JSON merge patch (RFC 7386).
+	MergePatchType PatchType = "application/merge-patch+json"
+)
+
 type Resource struct {
 	Meta
 	Version      int32
@@ -24,6 +34,22 @@ type Resource struct {
 	// When creating a resource, if its name is not specified, the resource id will be used as its name.
 	// Cannot be updated.
 	Name string
+	// PlacementID is the id of the placement that the resource is created from, it is empty if the resource
+	// is not created from a placement.
+	PlacementID string
+	// ManagedFields maps the field managers that applied the resource to the paths of the fields that they own,
+	// it is maintained by the server-side apply and is empty if the resource is never applied.
+	ManagedFields datatypes.JSONMap
+	// StatusRevision is the revision of the status change that is being broadcast to the status subscribers.
+	// It is not persisted.
+	StatusRevision int64 `gorm:"-"`
+	// AgentVersion is the version of the agent that reports the status, it is decoded from the status event and
+	// is not persisted.
+	AgentVersion string `gorm:"-"`
+	// Patch is applied to the stored manifest bundle by the update instead of replacing it with the payload, the
+	// PatchType is its media type. They are not persisted.
+	Patch     []byte    `gorm:"-"`
+	PatchType PatchType `gorm:"-"`
 }
 
 type ResourceList []*Resource
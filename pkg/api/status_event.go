@@ -23,6 +23,8 @@ type StatusEvent struct {
 	Status          datatypes.JSONMap
 	StatusEventType StatusEventType // Update|Delete
 	ReconciledDate  *time.Time      `json:"gorm:null"`
+	// Revision is the revision of the status change recorded in the status revision journal.
+	Revision int64
 }
 
 type StatusEventList []*StatusEvent
@@ -36,6 +38,23 @@ func (l StatusEventList) Index() StatusEventIndex {
 	return index
 }
 
+// StatusRevision is a journal entry of a resource status change. The revision is a monotonically
+// increasing number assigned by the database, the status subscribers use it to resume their
+// subscriptions without relisting all of the resources.
+// Unlike the status events, the status revisions are retained for a period after they were handled,
+// the delete entries keep the last resource payload and status since the resource is gone.
+type StatusRevision struct {
+	Revision        int64 `gorm:"primaryKey;autoIncrement"`
+	ResourceID      string
+	ResourceSource  string
+	Payload         datatypes.JSONMap
+	Status          datatypes.JSONMap
+	StatusEventType StatusEventType // Update|Delete
+	CreatedAt       time.Time
+}
+
+type StatusRevisionList []*StatusRevision
+
 func (e *StatusEvent) BeforeCreate(tx *gorm.DB) error {
 	e.ID = NewID()
 	return nil
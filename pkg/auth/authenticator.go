@@ -0,0 +1,105 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/openshift-online/maestro/pkg/client/grpcauthorizer"
+)
+
+// The supported authentication types of the REST API.
+const (
+	AuthNTypeMock  = "mock"
+	AuthNTypeToken = "token"
+	AuthNTypeMTLS  = "mtls"
+	AuthNTypeJWT   = "jwt"
+)
+
+// Authenticator authenticates the callers of the REST requests.
+type Authenticator interface {
+	// Authenticate returns the identity of the caller of the request, or an error if the caller
+	// cannot be authenticated.
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+// bearerToken returns the bearer token from the authorization header of the request.
+func bearerToken(r *http.Request) (string, error) {
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		return "", fmt.Errorf("missing authorization header")
+	}
+
+	token, found := strings.CutPrefix(authorization, "Bearer ")
+	if !found || strings.TrimSpace(token) == "" {
+		return "", fmt.Errorf("invalid authorization header, a bearer token is required")
+	}
+
+	return strings.TrimSpace(token), nil
+}
+
+// MockAuthenticator authenticates every caller as a mock user.
+type MockAuthenticator struct{}
+
+var _ Authenticator = &MockAuthenticator{}
+
+func NewMockAuthenticator() Authenticator {
+	return &MockAuthenticator{}
+}
+
+// Authenticate returns the mock identity for every request.
+func (m *MockAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	return &Identity{User: "mock", Groups: []string{"mock-group"}}, nil
+}
+
+// TokenAuthenticator authenticates the bearer token of the caller by a token review.
+type TokenAuthenticator struct {
+	tokenReviewer grpcauthorizer.GRPCAuthorizer
+}
+
+var _ Authenticator = &TokenAuthenticator{}
+
+func NewTokenAuthenticator(tokenReviewer grpcauthorizer.GRPCAuthorizer) Authenticator {
+	return &TokenAuthenticator{
+		tokenReviewer: tokenReviewer,
+	}
+}
+
+// Authenticate reviews the bearer token of the request and returns the user and groups associated with it.
+func (t *TokenAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+
+	user, groups, err := t.tokenReviewer.TokenReview(r.Context(), token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to review token: %v", err)
+	}
+
+	return &Identity{User: user, Groups: groups}, nil
+}
+
+// CertificateAuthenticator authenticates the caller by its verified client certificate, the common name
+// of the certificate is the user and the organizations of the certificate are the groups.
+type CertificateAuthenticator struct{}
+
+var _ Authenticator = &CertificateAuthenticator{}
+
+func NewCertificateAuthenticator() Authenticator {
+	return &CertificateAuthenticator{}
+}
+
+// Authenticate returns the user and groups from the client certificate of the request.
+func (c *CertificateAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, fmt.Errorf("could not verify client certificate")
+	}
+
+	cert := r.TLS.VerifiedChains[0][0]
+	if cert.Subject.CommonName == "" {
+		return nil, fmt.Errorf("could not find user in client certificate")
+	}
+
+	return &Identity{User: cert.Subject.CommonName, Groups: cert.Subject.Organization}, nil
+}
@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/ghodss/yaml"
+)
+
+// wildcard grants the access to all of the sources or consumers.
+const wildcard = "*"
+
+// Scope is the sources and consumers that a caller is allowed to access, a nil list means there is
+// no restriction on it and an empty list means nothing is allowed.
+type Scope struct {
+	Sources   []string
+	Consumers []string
+}
+
+// AllowsSource returns true if the source is in the scope.
+func (s *Scope) AllowsSource(source string) bool {
+	if s == nil || s.Sources == nil {
+		return true
+	}
+	return slices.Contains(s.Sources, source)
+}
+
+// AllowsConsumer returns true if the consumer is in the scope.
+func (s *Scope) AllowsConsumer(consumerName string) bool {
+	if s == nil || s.Consumers == nil {
+		return true
+	}
+	return slices.Contains(s.Consumers, consumerName)
+}
+
+// Authorizer decides the scope of the REST callers.
+type Authorizer interface {
+	// Scope returns the sources and consumers that the given identity is allowed to access, a nil
+	// scope means the identity is not restricted.
+	Scope(ctx context.Context, identity *Identity) (*Scope, error)
+}
+
+// AuthorizationRule grants the users and groups the access to the sources and consumers,
+// "*" grants the access to all of the sources or consumers.
+type AuthorizationRule struct {
+	Users     []string `json:"users,omitempty"`
+	Groups    []string `json:"groups,omitempty"`
+	Sources   []string `json:"sources,omitempty"`
+	Consumers []string `json:"consumers,omitempty"`
+}
+
+// AuthorizationConfig is the configuration of the rule based authorizer.
+type AuthorizationConfig struct {
+	Rules []AuthorizationRule `json:"rules"`
+}
+
+// LoadAuthorizationConfig loads the authorization config from the given YAML file.
+func LoadAuthorizationConfig(path string) (*AuthorizationConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read authorization config file %s: %v", path, err)
+	}
+
+	config := &AuthorizationConfig{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse authorization config file %s: %v", path, err)
+	}
+
+	return config, nil
+}
+
+// RuleAuthorizer is an authorizer that decides the scope of the callers from a list of rules.
+// The scope of a caller is the union of the rules that match the user or any of the groups of
+// the caller, a caller is not allowed to access anything if no rule matches.
+type RuleAuthorizer struct {
+	rules []AuthorizationRule
+}
+
+var _ Authorizer = &RuleAuthorizer{}
+
+func NewRuleAuthorizer(config *AuthorizationConfig) Authorizer {
+	return &RuleAuthorizer{
+		rules: config.Rules,
+	}
+}
+
+// Scope returns the union of the sources and consumers of the rules matching the identity.
+func (a *RuleAuthorizer) Scope(ctx context.Context, identity *Identity) (*Scope, error) {
+	if identity == nil {
+		return nil, fmt.Errorf("the identity is required")
+	}
+
+	scope := &Scope{
+		Sources:   []string{},
+		Consumers: []string{},
+	}
+	for _, rule := range a.rules {
+		if !rule.matches(identity) {
+			continue
+		}
+		scope.Sources = union(scope.Sources, rule.Sources)
+		scope.Consumers = union(scope.Consumers, rule.Consumers)
+	}
+
+	return scope, nil
+}
+
+func (r AuthorizationRule) matches(identity *Identity) bool {
+	if slices.Contains(r.Users, identity.User) {
+		return true
+	}
+
+	for _, group := range identity.Groups {
+		if slices.Contains(r.Groups, group) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// union merges the granted names into the allowed names, nil is returned if any of them is
+// unrestricted.
+func union(allowed, granted []string) []string {
+	if allowed == nil || slices.Contains(granted, wildcard) {
+		return nil
+	}
+
+	for _, name := range granted {
+		if !slices.Contains(allowed, name) {
+			allowed = append(allowed, name)
+		}
+	}
+	return allowed
+}
+
+// MockAuthorizer does not restrict any callers.
+type MockAuthorizer struct{}
+
+var _ Authorizer = &MockAuthorizer{}
+
+func NewMockAuthorizer() Authorizer {
+	return &MockAuthorizer{}
+}
+
+// Scope returns a nil scope for every caller.
+func (m *MockAuthorizer) Scope(ctx context.Context, identity *Identity) (*Scope, error) {
+	return nil, nil
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestRuleAuthorizerScope(t *testing.T) {
+	authorizer := NewRuleAuthorizer(&AuthorizationConfig{
+		Rules: []AuthorizationRule{
+			{
+				Groups:    []string{"team-a"},
+				Sources:   []string{"source-a"},
+				Consumers: []string{"cluster1", "cluster2"},
+			},
+			{
+				Users:     []string{"alice"},
+				Sources:   []string{"source-b"},
+				Consumers: []string{"cluster2", "cluster3"},
+			},
+			{
+				Groups:    []string{"admins"},
+				Sources:   []string{"*"},
+				Consumers: []string{"*"},
+			},
+		},
+	})
+
+	cases := []struct {
+		name          string
+		identity      *Identity
+		expectedScope *Scope
+	}{
+		{
+			name:     "no matched rules",
+			identity: &Identity{User: "bob", Groups: []string{"team-b"}},
+			expectedScope: &Scope{
+				Sources:   []string{},
+				Consumers: []string{},
+			},
+		},
+		{
+			name:     "matched by group",
+			identity: &Identity{User: "bob", Groups: []string{"team-a"}},
+			expectedScope: &Scope{
+				Sources:   []string{"source-a"},
+				Consumers: []string{"cluster1", "cluster2"},
+			},
+		},
+		{
+			name:     "matched by user and group",
+			identity: &Identity{User: "alice", Groups: []string{"team-a"}},
+			expectedScope: &Scope{
+				Sources:   []string{"source-a", "source-b"},
+				Consumers: []string{"cluster1", "cluster2", "cluster3"},
+			},
+		},
+		{
+			name:          "matched wildcard",
+			identity:      &Identity{User: "alice", Groups: []string{"admins"}},
+			expectedScope: &Scope{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			scope, err := authorizer.Scope(context.Background(), c.identity)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(scope, c.expectedScope) {
+				t.Errorf("expected %#v but got: %#v", c.expectedScope, scope)
+			}
+		})
+	}
+}
+
+func TestScopeAllows(t *testing.T) {
+	var unrestricted *Scope
+	if !unrestricted.AllowsSource("source-a") || !unrestricted.AllowsConsumer("cluster1") {
+		t.Errorf("expected the nil scope allows everything")
+	}
+
+	scope := &Scope{Sources: []string{"source-a"}, Consumers: []string{}}
+	if !scope.AllowsSource("source-a") {
+		t.Errorf("expected source-a is allowed")
+	}
+	if scope.AllowsSource("source-b") {
+		t.Errorf("expected source-b is not allowed")
+	}
+	if scope.AllowsConsumer("cluster1") {
+		t.Errorf("expected cluster1 is not allowed")
+	}
+}
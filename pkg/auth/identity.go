@@ -0,0 +1,52 @@
+package auth
+
+import "context"
+
+// Context key type defined to avoid collisions in other pkgs using context
+// See https://golang.org/pkg/context/#WithValue
+type contextKey string
+
+const (
+	contextIdentityKey contextKey = "identity"
+	contextScopeKey    contextKey = "scope"
+)
+
+// Identity is the authenticated caller of a REST request or a gRPC source client.
+type Identity struct {
+	User   string
+	Groups []string
+}
+
+// NewContextWithIdentity returns a copy of the context that carries the given identity.
+func NewContextWithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, contextIdentityKey, identity)
+}
+
+// IdentityFromContext returns the identity carried by the context, nil is returned if the
+// request is not authenticated.
+func IdentityFromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(contextIdentityKey).(*Identity)
+	return identity
+}
+
+// UsernameFromContext returns the user name of the identity carried by the context, an empty
+// string is returned if the request is not authenticated.
+func UsernameFromContext(ctx context.Context) string {
+	identity := IdentityFromContext(ctx)
+	if identity == nil {
+		return ""
+	}
+	return identity.User
+}
+
+// NewContextWithScope returns a copy of the context that carries the given scope.
+func NewContextWithScope(ctx context.Context, scope *Scope) context.Context {
+	return context.WithValue(ctx, contextScopeKey, scope)
+}
+
+// ScopeFromContext returns the scope carried by the context, nil is returned if the caller is
+// not restricted.
+func ScopeFromContext(ctx context.Context) *Scope {
+	scope, _ := ctx.Value(contextScopeKey).(*Scope)
+	return scope
+}
@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mendsley/gojwk"
+	"k8s.io/klog/v2"
+)
+
+// jwksMinRefreshInterval is the minimum interval between two refreshes of the JSON web key set, it prevents
+// the callers from flooding the JWKS endpoint with tokens signed by unknown keys.
+const jwksMinRefreshInterval = 30 * time.Second
+
+// JWTAuthenticator authenticates the bearer token of the caller as a JSON web token signed by one of
+// the keys published at a JWKS URL.
+type JWTAuthenticator struct {
+	jwksURL       string
+	usernameClaim string
+	groupsClaim   string
+	httpClient    *http.Client
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	lastRefresh time.Time
+}
+
+var _ Authenticator = &JWTAuthenticator{}
+
+func NewJWTAuthenticator(jwksURL, usernameClaim, groupsClaim string) Authenticator {
+	return &JWTAuthenticator{
+		jwksURL:       jwksURL,
+		usernameClaim: usernameClaim,
+		groupsClaim:   groupsClaim,
+		httpClient:    &http.Client{Timeout: 10 * time.Second},
+		keys:          map[string]crypto.PublicKey{},
+	}
+}
+
+// Authenticate verifies the bearer token of the request and returns the user and groups from its claims.
+func (j *JWTAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	token, err := bearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return j.key(r.Context(), kid)
+	}, jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify token: %v", err)
+	}
+
+	user, _ := claims[j.usernameClaim].(string)
+	if user == "" {
+		return nil, fmt.Errorf("could not find claim %s in token", j.usernameClaim)
+	}
+
+	groups := []string{}
+	switch claimedGroups := claims[j.groupsClaim].(type) {
+	case []interface{}:
+		for _, group := range claimedGroups {
+			if g, ok := group.(string); ok {
+				groups = append(groups, g)
+			}
+		}
+	case string:
+		groups = append(groups, claimedGroups)
+	}
+
+	return &Identity{User: user, Groups: groups}, nil
+}
+
+// key returns the public key with the given key id, the key set is refreshed if the key is unknown.
+func (j *JWTAuthenticator) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	j.mu.RLock()
+	key, ok := j.keys[kid]
+	j.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	if err := j.refresh(ctx); err != nil {
+		return nil, err
+	}
+
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	if key, ok := j.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// refresh reloads the key set from the JWKS URL.
+func (j *JWTAuthenticator) refresh(ctx context.Context) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if time.Since(j.lastRefresh) < jwksMinRefreshInterval {
+		return nil
+	}
+	j.lastRefresh = time.Now()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.jwksURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := j.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to get JSON web key set: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get JSON web key set: unexpected status %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read JSON web key set: %v", err)
+	}
+
+	keySet, err := gojwk.Unmarshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse JSON web key set: %v", err)
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range keySet.Keys {
+		key, err := jwk.DecodePublicKey()
+		if err != nil {
+			klog.FromContext(ctx).Error(err, "skipping invalid JSON web key", "kid", jwk.Kid)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	j.keys = keys
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/openshift-online/maestro/test/mocks/jwk"
+)
+
+func TestJWTAuthenticator(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwksURL, teardown := jwk.NewJWKCertServerMock(t, key.Public(), "test-kid", "RS256")
+	defer teardown()
+
+	authenticator := NewJWTAuthenticator(jwksURL, "username", "groups")
+
+	sign := func(signingKey *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(signingKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	cases := []struct {
+		name             string
+		token            string
+		expectedIdentity *Identity
+		expectedError    bool
+	}{
+		{
+			name: "valid token",
+			token: sign(key, "test-kid", jwt.MapClaims{
+				"username": "alice",
+				"groups":   []string{"team-a"},
+				"exp":      time.Now().Add(time.Hour).Unix(),
+			}),
+			expectedIdentity: &Identity{User: "alice", Groups: []string{"team-a"}},
+		},
+		{
+			name: "expired token",
+			token: sign(key, "test-kid", jwt.MapClaims{
+				"username": "alice",
+				"exp":      time.Now().Add(-time.Hour).Unix(),
+			}),
+			expectedError: true,
+		},
+		{
+			name: "unknown signing key",
+			token: sign(otherKey, "other-kid", jwt.MapClaims{
+				"username": "alice",
+			}),
+			expectedError: true,
+		},
+		{
+			name: "wrong signature",
+			token: sign(otherKey, "test-kid", jwt.MapClaims{
+				"username": "alice",
+			}),
+			expectedError: true,
+		},
+		{
+			name: "no username",
+			token: sign(key, "test-kid", jwt.MapClaims{
+				"groups": []string{"team-a"},
+			}),
+			expectedError: true,
+		},
+		{
+			name:          "no token",
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, "/api/maestro/v1/resource-bundles", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.token != "" {
+				req.Header.Set("Authorization", "Bearer "+c.token)
+			}
+
+			identity, err := authenticator.Authenticate(req)
+			if c.expectedError {
+				if err == nil {
+					t.Errorf("expected error but got identity %#v", identity)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(identity, c.expectedIdentity) {
+				t.Errorf("expected %#v but got: %#v", c.expectedIdentity, identity)
+			}
+		})
+	}
+}
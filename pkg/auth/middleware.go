@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"net/http"
+
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+// NewAuthMiddleware creates a middleware that authenticates the caller of the request and decides the scope
+// of the caller. The identity and the scope are added to the request context for the handlers.
+func NewAuthMiddleware(authenticator Authenticator, authorizer Authorizer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			logger := klog.FromContext(r.Context())
+
+			identity, err := authenticator.Authenticate(r)
+			if err != nil {
+				logger.Info("unable to authenticate the request", "error", err)
+				api.SendUnauthorized(w, r, "Unable to authenticate the request")
+				return
+			}
+
+			scope, err := authorizer.Scope(r.Context(), identity)
+			if err != nil {
+				logger.Error(err, "unable to authorize the request", "user", identity.User)
+				api.SendUnauthorized(w, r, "Unable to authorize the request")
+				return
+			}
+
+			ctx := NewContextWithIdentity(r.Context(), identity)
+			ctx = NewContextWithScope(ctx, scope)
+			ctx = klog.NewContext(ctx, logger.WithValues("user", identity.User))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
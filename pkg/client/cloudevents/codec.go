@@ -14,6 +14,9 @@ import (
 	"github.com/openshift-online/maestro/pkg/api"
 )
 
+// AgentVersionExtension is the optional CloudEvents extension of the status events that reports the agent version.
+const AgentVersionExtension = "agentversion"
+
 type Codec struct {
 	sourceID string
 }
@@ -113,6 +116,13 @@ func (codec *Codec) Decode(evt *cloudevents.Event) (*api.Resource, error) {
 		Status:       status,
 	}
 
+	// the agent version is optional, the agents that do not report it are tracked without a version
+	if agentVersion, ok := evtExtensions[AgentVersionExtension]; ok {
+		if resource.AgentVersion, err = cloudeventstypes.ToString(agentVersion); err != nil {
+			return nil, fmt.Errorf("failed to get %s extension: %v", AgentVersionExtension, err)
+		}
+	}
+
 	return resource, nil
 }
 
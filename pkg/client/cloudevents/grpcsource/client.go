@@ -3,8 +3,10 @@ package grpcsource
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/openshift-online/ocm-sdk-go/logging"
+	"google.golang.org/grpc/metadata"
 	"k8s.io/client-go/rest"
 	workv1client "open-cluster-management.io/api/client/work/clientset/versioned/typed/work/v1"
 	workpayload "open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
@@ -16,6 +18,19 @@ import (
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
+// SinceRevisionMetadataKey is the gRPC metadata key that resumes a status subscription from a status revision.
+// The SubscriptionRequest of the CloudEvents gRPC protocol is defined by sdk-go and has no field for the revision,
+// so the maestro server reads it from the metadata of the Subscribe call instead. The value is the decimal revision
+// of the last status bookmark the subscriber received.
+const SinceRevisionMetadataKey = "maestro-since-revision"
+
+// WithSinceRevision returns a context that carries the SinceRevisionMetadataKey metadata, the status subscription
+// made with the context, e.g. by NewMaestroGRPCSourceWorkClient, replays the status changes after the revision
+// before the live status changes.
+func WithSinceRevision(ctx context.Context, revision int64) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, SinceRevisionMetadataKey, strconv.FormatInt(revision, 10))
+}
+
 func NewMaestroGRPCSourceWorkClient(
 	ctx context.Context,
 	logger logging.Logger,
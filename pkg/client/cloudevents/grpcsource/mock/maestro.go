@@ -33,11 +33,19 @@ func NewMaestroMockServer(store *ResourceBundlesStore) *MaestroMockServer {
 		switch r.Method {
 		case http.MethodGet:
 			list := &openapi.ResourceBundleList{}
-			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
 			size, _ := strconv.Atoi(r.URL.Query().Get("size"))
 
+			// the continue token of the mock server is the index of the next item
+			index := 0
+			if token := r.URL.Query().Get("continue"); token != "" {
+				var err error
+				if index, err = strconv.Atoi(token); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+			}
+
 			items := store.Get()
-			index := ((page - 1) * size)
 			for i := 0; i < size; i++ {
 				if index >= len(items) {
 					break
@@ -46,9 +54,12 @@ func NewMaestroMockServer(store *ResourceBundlesStore) *MaestroMockServer {
 				index = index + 1
 			}
 
-			list.Page = int32(page)
+			list.Page = 1
 			list.Total = int32(len(items))
 			list.Size = int32(len(list.Items))
+			if size > 0 && len(list.Items) == size {
+				list.Continue = openapi.PtrString(strconv.Itoa(index))
+			}
 			data, _ := json.Marshal(list)
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
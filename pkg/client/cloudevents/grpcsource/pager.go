@@ -3,7 +3,6 @@ package grpcsource
 import (
 	"context"
 	"fmt"
-	"strconv"
 
 	"github.com/openshift-online/ocm-sdk-go/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,14 +18,13 @@ import (
 var MaxListPageSize int32 = 400
 
 // PageList assists client code in breaking large list queries into multiple smaller chunks of PageSize or smaller.
+// The chunks are listed with the continue tokens of the maestro server, so that no item is missed or listed twice
+// when the items are created or deleted during the list. If the list reaches the limit of the list options before
+// the last item, the continue token to list the rest of the items is returned. The label and field selectors of the
+// list options are evaluated by the maestro server.
 func PageList(ctx context.Context, logger logging.Logger, client *openapi.APIClient, search string, opts metav1.ListOptions) (*openapi.ResourceBundleList, string, error) {
 	items := []openapi.ResourceBundle{}
 
-	page, err := page(opts)
-	if err != nil {
-		return nil, "", err
-	}
-
 	operationID := maestrologger.GetOperationID(ctx)
 
 	limit := opts.Limit
@@ -34,16 +32,31 @@ func PageList(ctx context.Context, logger logging.Logger, client *openapi.APICli
 		return nil, "", fmt.Errorf("limit cannot be less than 0")
 	}
 
-	var total int32 = 0
-	nextPage := ""
-	pageSize := pageSize(int32(limit))
-	offset := (page - 1) * pageSize
+	continueToken := opts.Continue
 	for {
-		logger.Debug(ctx, "list works with search=%s, page=%d, size=%d", search, page, pageSize)
+		size := pageSize(int32(limit))
+		if limit > 0 {
+			// only list the items left to reach the limit
+			size = pageSize(int32(limit) - int32(len(items)))
+		}
+
+		logger.Debug(ctx, "list works with search=%s, continue=%s, size=%d", search, continueToken, size)
 		req := client.DefaultAPI.ApiMaestroV1ResourceBundlesGet(ctx).
 			Search(search).
-			Page(page).
-			Size(pageSize)
+			Size(size).
+			Total(false)
+
+		if len(continueToken) > 0 {
+			req = req.Continue_(continueToken)
+		}
+
+		if len(opts.LabelSelector) > 0 {
+			req = req.LabelSelector(opts.LabelSelector)
+		}
+
+		if len(opts.FieldSelector) > 0 {
+			req = req.FieldSelector(opts.FieldSelector)
+		}
 
 		if len(operationID) > 0 {
 			req = req.XOperationID(operationID)
@@ -53,51 +66,23 @@ func PageList(ctx context.Context, logger logging.Logger, client *openapi.APICli
 		if err != nil {
 			return nil, "", err
 		}
-		logger.Debug(ctx, "listed works total=%d, page=%d, size=%d", rbs.Total, rbs.Page, rbs.Size)
+		logger.Debug(ctx, "listed works size=%d", rbs.Size)
 
 		items = append(items, rbs.Items...)
-		total = rbs.Size + total
-		page = page + 1
+		continueToken = rbs.GetContinue()
 
-		if rbs.Size < pageSize {
+		if len(continueToken) == 0 {
 			// reaches the last page, stop list
 			break
 		}
 
-		if limit == 0 {
-			// no limit, continue to list the rest of items
-			continue
-		}
-
-		if total == int32(limit) {
-			// reaches the limit, stop list
-			if (total + offset) < rbs.Total {
-				// the listed items reach the limit size, but there are still items left
-				nextPage = fmt.Sprintf("%d", page)
-			}
-
-			break
+		if limit > 0 && int64(len(items)) >= limit {
+			// the listed items reach the limit size, the rest of items can be listed with the continue token
+			return &openapi.ResourceBundleList{Items: items}, continueToken, nil
 		}
 	}
 
-	return &openapi.ResourceBundleList{Items: items}, nextPage, nil
-}
-
-func page(opts metav1.ListOptions) (int32, error) {
-	if len(opts.Continue) == 0 {
-		return 1, nil
-	}
-
-	page, err := strconv.Atoi(opts.Continue)
-	if err != nil {
-		return 0, fmt.Errorf("a page number is required, %v", err)
-	}
-
-	if page < 0 {
-		return 0, fmt.Errorf("an invalid page number %d", page)
-	}
-
-	return int32(page), nil
+	return &openapi.ResourceBundleList{Items: items}, "", nil
 }
 
 func pageSize(limit int32) int32 {
@@ -24,6 +24,7 @@ func TestPageList(t *testing.T) {
 		listOpts         metav1.ListOptions
 		expectedItemsLen int
 		expectedNext     string
+		expectedErr      bool
 	}{
 		{
 			name:             "no items",
@@ -69,7 +70,7 @@ func TestPageList(t *testing.T) {
 				Limit: 400,
 			},
 			expectedItemsLen: 400,
-			expectedNext:     "2",
+			expectedNext:     "400",
 		},
 		{
 			name:            "list items (limit < total items)",
@@ -78,34 +79,43 @@ func TestPageList(t *testing.T) {
 				Limit: 40,
 			},
 			expectedItemsLen: 40,
-			expectedNext:     "2",
+			expectedNext:     "40",
 		},
 		{
-			name:            "list items with continue (from last page - 1)",
+			name:            "list items (limit > MaxListPageSize)",
+			resourceBundles: resourceBundles(1229),
+			listOpts: metav1.ListOptions{
+				Limit: 500,
+			},
+			expectedItemsLen: 500,
+			expectedNext:     "500",
+		},
+		{
+			name:            "list items with continue",
 			resourceBundles: resourceBundles(429),
 			listOpts: metav1.ListOptions{
 				Limit:    100,
-				Continue: "4",
+				Continue: "300",
 			},
 			expectedItemsLen: 100,
-			expectedNext:     "5",
+			expectedNext:     "400",
 		},
 		{
-			name:            "list items with continue (from page last page)",
+			name:            "list items with continue (to the last item)",
 			resourceBundles: resourceBundles(429),
 			listOpts: metav1.ListOptions{
 				Limit:    100,
-				Continue: "5",
+				Continue: "400",
 			},
 			expectedItemsLen: 29,
 			expectedNext:     "",
 		},
 		{
-			name:            "list items with continue (from page last page + 1)",
+			name:            "list items with continue (after the last item)",
 			resourceBundles: resourceBundles(429),
 			listOpts: metav1.ListOptions{
 				Limit:    100,
-				Continue: "6",
+				Continue: "429",
 			},
 			expectedItemsLen: 0,
 			expectedNext:     "",
@@ -115,30 +125,27 @@ func TestPageList(t *testing.T) {
 			resourceBundles: resourceBundles(1229),
 			listOpts: metav1.ListOptions{
 				Limit:    400,
-				Continue: "3",
+				Continue: "800",
 			},
 			expectedItemsLen: 400,
-			expectedNext:     "4",
+			expectedNext:     "1200",
 		},
 		{
-			name:            "list items with continue and max limit",
+			name:            "list all items with continue",
 			resourceBundles: resourceBundles(1229),
 			listOpts: metav1.ListOptions{
-				Limit:    400,
-				Continue: "4",
+				Continue: "400",
 			},
-			expectedItemsLen: 29,
+			expectedItemsLen: 829,
 			expectedNext:     "",
 		},
 		{
-			name:            "list items with continue and max limit",
-			resourceBundles: resourceBundles(1229),
+			name:            "list items with invalid continue",
+			resourceBundles: resourceBundles(429),
 			listOpts: metav1.ListOptions{
-				Limit:    400,
-				Continue: "5",
+				Continue: "invalid",
 			},
-			expectedItemsLen: 0,
-			expectedNext:     "",
+			expectedErr: true,
 		},
 	}
 
@@ -152,8 +159,14 @@ func TestPageList(t *testing.T) {
 			}
 
 			list, next, err := PageList(context.Background(), logger, client, "", c.listOpts)
+			if c.expectedErr {
+				if err == nil {
+					t.Errorf("expected error, but got nil")
+				}
+				return
+			}
 			if err != nil {
-				t.Errorf("unexpected error %v", err)
+				t.Fatalf("unexpected error %v", err)
 			}
 
 			if len(list.Items) != c.expectedItemsLen {
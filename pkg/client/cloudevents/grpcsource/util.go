@@ -8,16 +8,18 @@ import (
 	jsonpatch "github.com/evanphx/json-patch"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/selection"
 	workv1 "open-cluster-management.io/api/work/v1"
 	"open-cluster-management.io/sdk-go/pkg/cloudevents/clients/work/payload"
 
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 )
 
-const jsonbPrefix = `payload->'metadata'->'labels'`
+// conditionFieldPrefix is the prefix of the field selectors of the work conditions, e.g.
+// status.conditions.Available=True selects the works whose Available condition is True.
+const conditionFieldPrefix = "status.conditions."
 
 // ToManifestWork converts an openapi.ResourceBundle object to workv1.ManifestWork object
 func ToManifestWork(rb *openapi.ResourceBundle) (*workv1.ManifestWork, error) {
@@ -99,82 +101,63 @@ func ToManifestWork(rb *openapi.ResourceBundle) (*workv1.ManifestWork, error) {
 	return work, nil
 }
 
-func ToLabelSearch(opts metav1.ListOptions) (labels.Selector, string, bool, error) {
-	if len(opts.LabelSelector) == 0 {
-		return labels.Everything(), "", false, nil
+// ToSelectors parses the label and field selectors of the list options. The selectors are passed to the maestro
+// server to list the works, and the works sent to the watchers are filtered by them.
+func ToSelectors(opts metav1.ListOptions) (labels.Selector, fields.Selector, error) {
+	labelSelector := labels.Everything()
+	if len(opts.LabelSelector) != 0 {
+		selector, err := labels.Parse(opts.LabelSelector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid labels selector %q: %v", opts.LabelSelector, err)
+		}
+		labelSelector = selector
 	}
 
-	labelSelector, err := labels.Parse(opts.LabelSelector)
-	if err != nil {
-		return nil, "", false, fmt.Errorf("invalid labels selector %q: %v", opts.LabelSelector, err)
-	}
-
-	requirements, selectable := labelSelector.Requirements()
-	if !selectable {
-		return labels.Everything(), "", false, nil
-	}
-
-	equalsLabels := []string{}
-	notEqualsLabels := []string{}
-
-	existsKeys := []string{}
-	doesNotExistKeys := []string{}
-
-	inLabels := map[string][]string{}
-
-	// refer to below links to find how to use the label selector in kubernetes
-	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#equality-based-requirement
-	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#set-based-requirement
-	for _, requirement := range requirements {
-		switch requirement.Operator() {
-		case selection.Equals, selection.DoubleEquals:
-			values := requirement.Values()
-			if len(values) != 1 {
-				return nil, "", false, fmt.Errorf("too many values in equals operation")
-			}
-
-			equalsLabels = append(equalsLabels, fmt.Sprintf(`"%s":"%s"`, requirement.Key(), values.List()[0]))
-		case selection.NotEquals:
-			values := requirement.Values()
-			if len(values) != 1 {
-				return nil, "", false, fmt.Errorf("too many values in not equals operation")
-			}
-
-			notEqualsLabels = append(notEqualsLabels, fmt.Sprintf(`%s->>'%s'<>'%s'`, jsonbPrefix, requirement.Key(), values.List()[0]))
-		case selection.Exists:
-			existsKeys = append(existsKeys, fmt.Sprintf(`%s->>'%s'<>null`, jsonbPrefix, requirement.Key()))
-		case selection.In:
-			vals := []string{}
-			for val := range requirement.Values() {
-				vals = append(vals, fmt.Sprintf("'%s'", val))
-			}
-
-			inLabels[requirement.Key()] = vals
-		case selection.NotIn:
-			for val := range requirement.Values() {
-				notEqualsLabels = append(notEqualsLabels, fmt.Sprintf(`%s->>'%s'<>'%s'`, jsonbPrefix, requirement.Key(), val))
-			}
-		default:
-			// only DoesNotExist cannot be supported
-			return nil, "", false, fmt.Errorf("unsupported operator %s", requirement.Operator())
+	fieldSelector := fields.Everything()
+	if len(opts.FieldSelector) != 0 {
+		selector, err := fields.ParseSelector(opts.FieldSelector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid fields selector %q: %v", opts.FieldSelector, err)
 		}
+		fieldSelector = selector
 	}
 
-	labelSearch := []string{}
-	if len(equalsLabels) != 0 {
-		labelSearch = append(labelSearch, fmt.Sprintf(`%s@>'{%s}'`, jsonbPrefix, strings.Join(equalsLabels, ",")))
+	return labelSelector, fieldSelector, nil
+}
+
+// ToNamespacedListOptions returns the list options whose field selector also selects the works in the namespace, so
+// the namespace is evaluated by the maestro server with the other fields instead of being built into the search.
+// The list options are returned as they are for metav1.NamespaceAll.
+func ToNamespacedListOptions(namespace string, opts metav1.ListOptions) (metav1.ListOptions, error) {
+	if namespace == metav1.NamespaceAll {
+		return opts, nil
 	}
 
-	if len(inLabels) != 0 {
-		for key, vals := range inLabels {
-			labelSearch = append(labelSearch, fmt.Sprintf(`%s->>'%s'in(%s)`, jsonbPrefix, key, strings.Join(vals, ",")))
-		}
+	_, fieldSelector, err := ToSelectors(opts)
+	if err != nil {
+		return opts, err
+	}
+
+	namespaceSelector := fields.OneTermEqualSelector("metadata.namespace", namespace)
+	if fieldSelector.Empty() {
+		opts.FieldSelector = namespaceSelector.String()
+		return opts, nil
 	}
+	opts.FieldSelector = fields.AndSelectors(fieldSelector, namespaceSelector).String()
+	return opts, nil
+}
 
-	labelSearch = append(labelSearch, notEqualsLabels...)
-	labelSearch = append(labelSearch, existsKeys...)
-	labelSearch = append(labelSearch, doesNotExistKeys...)
-	return labelSelector, strings.Join(labelSearch, " and "), true, nil
+// ToWorkFields returns the fields of a work that can be selected by a field selector, they are the same as the
+// fields supported by the maestro server: metadata.name, metadata.namespace and status.conditions.<type>.
+func ToWorkFields(work *workv1.ManifestWork) fields.Set {
+	workFields := fields.Set{
+		"metadata.name":      work.Name,
+		"metadata.namespace": work.Namespace,
+	}
+	for _, condition := range work.Status.Conditions {
+		workFields[conditionFieldPrefix+condition.Type] = string(condition.Status)
+	}
+	return workFields
 }
 
 // ToWorkPatch returns a merge patch between an existing work and a new work.
@@ -142,76 +142,146 @@ func TestToManifestWork(t *testing.T) {
 	}
 }
 
-func TestToLabelSearch(t *testing.T) {
+func TestToSelectors(t *testing.T) {
+	work := &workv1.ManifestWork{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "web",
+			Namespace: "cluster1",
+			Labels:    map[string]string{"env": "prod", "replicas": "3"},
+		},
+		Status: workv1.ManifestWorkStatus{
+			Conditions: []v1.Condition{{Type: "Available", Status: v1.ConditionTrue}},
+		},
+	}
+
 	cases := []struct {
-		name                string
-		opts                v1.ListOptions
-		expectedSelectable  bool
-		expectedLabelSearch string
+		name          string
+		opts          v1.ListOptions
+		expectedMatch bool
+		expectedErr   bool
 	}{
 		{
-			name:                "no label selector",
-			opts:                v1.ListOptions{},
-			expectedSelectable:  false,
-			expectedLabelSearch: "",
+			name:          "no selectors",
+			opts:          v1.ListOptions{},
+			expectedMatch: true,
 		},
 		{
-			name:                "selector everything",
-			opts:                v1.ListOptions{LabelSelector: labels.Everything().String()},
-			expectedSelectable:  false,
-			expectedLabelSearch: "",
+			name:          "selector everything",
+			opts:          v1.ListOptions{LabelSelector: labels.Everything().String()},
+			expectedMatch: true,
 		},
 		{
-			name:                "one equals selector",
-			opts:                v1.ListOptions{LabelSelector: "a=b"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'@>'{"a":"b"}'`,
+			name:          "set based label selector",
+			opts:          v1.ListOptions{LabelSelector: "env in (prod,stage),!canary,replicas>2"},
+			expectedMatch: true,
 		},
 		{
-			name:                "multiple equals selector",
-			opts:                v1.ListOptions{LabelSelector: "a=b,c==d"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'@>'{"a":"b","c":"d"}'`,
+			name:          "label selector does not exist",
+			opts:          v1.ListOptions{LabelSelector: "!env"},
+			expectedMatch: false,
 		},
 		{
-			name:                "multiple not equals selector",
-			opts:                v1.ListOptions{LabelSelector: "a!=b,c!=d"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'->>'a'<>'b' and payload->'metadata'->'labels'->>'c'<>'d'`,
+			name:          "field selector",
+			opts:          v1.ListOptions{FieldSelector: "metadata.name=web,metadata.namespace=cluster1,status.conditions.Available=True"},
+			expectedMatch: true,
 		},
 		{
-			name:                "exist selector",
-			opts:                v1.ListOptions{LabelSelector: "a"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'->>'a'<>null`,
+			name:          "field selector of a missing condition",
+			opts:          v1.ListOptions{FieldSelector: "status.conditions.Degraded!=True"},
+			expectedMatch: true,
 		},
 		{
-			name:                "in selector",
-			opts:                v1.ListOptions{LabelSelector: "env in (a)"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'->>'env'in('a')`,
+			name:          "unmatched field selector",
+			opts:          v1.ListOptions{FieldSelector: "metadata.name!=web"},
+			expectedMatch: false,
 		},
 		{
-			name:                "not in selector",
-			opts:                v1.ListOptions{LabelSelector: "env notin (a)"},
-			expectedSelectable:  true,
-			expectedLabelSearch: `payload->'metadata'->'labels'->>'env'<>'a'`,
+			name:        "invalid label selector",
+			opts:        v1.ListOptions{LabelSelector: "env in (prod"},
+			expectedErr: true,
+		},
+		{
+			name:        "invalid field selector",
+			opts:        v1.ListOptions{FieldSelector: "metadata.name"},
+			expectedErr: true,
 		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			_, labelSearch, selectable, err := ToLabelSearch(c.opts)
-			if c.expectedSelectable != selectable {
-				t.Errorf("expected %v, but got %v", c.expectedSelectable, selectable)
+			labelSelector, fieldSelector, err := ToSelectors(c.opts)
+			if c.expectedErr {
+				if err == nil {
+					t.Errorf("expected error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
 			}
 
-			if c.expectedLabelSearch != labelSearch {
-				t.Errorf("expected %s, but got %s", c.expectedLabelSearch, labelSearch)
+			matched := labelSelector.Matches(labels.Set(work.Labels)) && fieldSelector.Matches(ToWorkFields(work))
+			if c.expectedMatch != matched {
+				t.Errorf("expected %v, but got %v", c.expectedMatch, matched)
 			}
+		})
+	}
+}
 
+func TestToNamespacedListOptions(t *testing.T) {
+	cases := []struct {
+		name                  string
+		namespace             string
+		opts                  v1.ListOptions
+		expectedFieldSelector string
+		expectedErr           bool
+	}{
+		{
+			name:                  "all namespaces",
+			namespace:             v1.NamespaceAll,
+			opts:                  v1.ListOptions{FieldSelector: "metadata.name=web"},
+			expectedFieldSelector: "metadata.name=web",
+		},
+		{
+			name:                  "no field selector",
+			namespace:             "cluster1",
+			opts:                  v1.ListOptions{},
+			expectedFieldSelector: "metadata.namespace=cluster1",
+		},
+		{
+			name:                  "with field selector",
+			namespace:             "cluster1",
+			opts:                  v1.ListOptions{FieldSelector: "metadata.name=web"},
+			expectedFieldSelector: "metadata.name=web,metadata.namespace=cluster1",
+		},
+		{
+			name:                  "namespace is escaped",
+			namespace:             "cluster1' or '1'='1",
+			opts:                  v1.ListOptions{},
+			expectedFieldSelector: `metadata.namespace=cluster1' or '1'\='1`,
+		},
+		{
+			name:        "invalid field selector",
+			namespace:   "cluster1",
+			opts:        v1.ListOptions{FieldSelector: "metadata.name"},
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			opts, err := ToNamespacedListOptions(c.namespace, c.opts)
+			if c.expectedErr {
+				if err == nil {
+					t.Errorf("expected error, but got nil")
+				}
+				return
+			}
 			if err != nil {
-				t.Errorf("expected error %v", err)
+				t.Fatalf("unexpected error %v", err)
+			}
+			if opts.FieldSelector != c.expectedFieldSelector {
+				t.Errorf("expected field selector %q, but got %q", c.expectedFieldSelector, opts.FieldSelector)
 			}
 		})
 	}
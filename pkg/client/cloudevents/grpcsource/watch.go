@@ -6,6 +6,7 @@ import (
 
 	"github.com/openshift-online/ocm-sdk-go/logging"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/watch"
 	workv1 "open-cluster-management.io/api/work/v1"
@@ -25,18 +26,21 @@ type workWatcher struct {
 	source        string
 	namespace     string
 	labelSelector labels.Selector
+	fieldSelector fields.Selector
 }
 
 var _ watch.Interface = &workWatcher{}
 
 func newWorkWatcher(ctx context.Context,
-	logger logging.Logger, source, namespace string, labelSelector labels.Selector) *workWatcher {
+	logger logging.Logger, source, namespace string,
+	labelSelector labels.Selector, fieldSelector fields.Selector) *workWatcher {
 	return &workWatcher{
 		result:        make(chan watch.Event),
 		done:          make(chan struct{}),
 		source:        source,
 		namespace:     namespace,
 		labelSelector: labelSelector,
+		fieldSelector: fieldSelector,
 		ctx:           ctx,
 		logger:        logger,
 	}
@@ -87,6 +91,11 @@ func (w *workWatcher) Receive(evt watch.Event) {
 		return
 	}
 
+	if !w.fieldSelector.Matches(ToWorkFields(work)) {
+		w.logger.Info(w.ctx, "ignore the field unmatched work %s/%s from the watcher %s/%s", work.Namespace, work.Name, w.source, w.namespace)
+		return
+	}
+
 	w.logger.Debug(w.ctx, "send the work %s/%s status update (type=%s) from the watcher %s/%s",
 		work.Namespace, work.Name, evt.Type, w.source, w.namespace)
 	w.result <- evt
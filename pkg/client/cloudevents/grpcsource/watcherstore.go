@@ -4,13 +4,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 	"sync"
 	"time"
 
 	"github.com/openshift-online/ocm-sdk-go/logging"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -75,27 +75,26 @@ func newRESTFulAPIWatcherStore(ctx context.Context,
 // Using `metav1.NamespaceAll` to specify all namespaces.
 func (m *RESTFulAPIWatcherStore) GetWatcher(ctx context.Context, namespace string, opts metav1.ListOptions) (watch.Interface, error) {
 	// Only list works from maestro server with the given namespace when a watcher is required
-	labelSelector, labelSearch, selectable, err := ToLabelSearch(opts)
+	labelSelector, fieldSelector, err := ToSelectors(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	searches := []string{fmt.Sprintf("source='%s'", m.sourceID)}
-	if namespace != metav1.NamespaceAll {
-		searches = append(searches, fmt.Sprintf("consumer_name='%s'", namespace))
-	}
-
-	if selectable {
-		searches = append(searches, labelSearch)
+	listOpts, err := ToNamespacedListOptions(namespace, metav1.ListOptions{
+		LabelSelector: opts.LabelSelector,
+		FieldSelector: opts.FieldSelector,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// for watch, we need list all works with the search condition from maestro server
-	rbs, _, err := PageList(ctx, m.logger, m.apiClient, strings.Join(searches, " and "), metav1.ListOptions{})
+	// for watch, we need list all works with the search condition and selectors from maestro server
+	rbs, _, err := PageList(ctx, m.logger, m.apiClient, fmt.Sprintf("source='%s'", m.sourceID), listOpts)
 	if err != nil {
 		return nil, err
 	}
 
-	watcher := m.registerWatcher(ctx, namespace, labelSelector)
+	watcher := m.registerWatcher(ctx, namespace, labelSelector, fieldSelector)
 
 	// save the works to a queue
 	for _, rb := range rbs.Items {
@@ -156,21 +155,12 @@ func (m *RESTFulAPIWatcherStore) Get(ctx context.Context, namespace, name string
 func (m *RESTFulAPIWatcherStore) List(ctx context.Context, namespace string, opts metav1.ListOptions) (*store.ResourceList[*workv1.ManifestWork], error) {
 	works := []*workv1.ManifestWork{}
 
-	_, labelSearch, selectable, err := ToLabelSearch(opts)
+	listOpts, err := ToNamespacedListOptions(namespace, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	searches := []string{fmt.Sprintf("source='%s'", m.sourceID)}
-	if namespace != metav1.NamespaceAll {
-		searches = append(searches, fmt.Sprintf("consumer_name='%s'", namespace))
-	}
-
-	if selectable {
-		searches = append(searches, labelSearch)
-	}
-
-	rbs, nextPage, err := PageList(ctx, m.logger, m.apiClient, strings.Join(searches, " and "), opts)
+	rbs, nextPage, err := PageList(ctx, m.logger, m.apiClient, fmt.Sprintf("source='%s'", m.sourceID), listOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -286,7 +276,8 @@ func (m *RESTFulAPIWatcherStore) process() {
 	}
 }
 
-func (m *RESTFulAPIWatcherStore) registerWatcher(ctx context.Context, namespace string, labelSelector labels.Selector) watch.Interface {
+func (m *RESTFulAPIWatcherStore) registerWatcher(ctx context.Context, namespace string,
+	labelSelector labels.Selector, fieldSelector fields.Selector) watch.Interface {
 	m.Lock()
 	defer m.Unlock()
 
@@ -295,7 +286,7 @@ func (m *RESTFulAPIWatcherStore) registerWatcher(ctx context.Context, namespace
 		return watcher
 	}
 
-	watcher = newWorkWatcher(ctx, m.logger, m.sourceID, namespace, labelSelector)
+	watcher = newWorkWatcher(ctx, m.logger, m.sourceID, namespace, labelSelector, fieldSelector)
 	m.logger.Info(ctx, "register watcher %s/%s", m.sourceID, namespace)
 	m.watchers[namespace] = watcher
 	sourceClientRegisteredWatchersGaugeMetric.WithLabelValues(m.sourceID, namespace).Inc()
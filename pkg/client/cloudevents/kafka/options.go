@@ -0,0 +1,129 @@
+package kafka
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultTopicPrefix = "maestro"
+	defaultGroupID     = "maestro"
+)
+
+// KafkaConfig is the config file of the kafka message broker, the file is passed with the --message-broker-config-file
+// flag when the message broker type is kafka.
+type KafkaConfig struct {
+	// BootstrapServers is a comma separated list of the kafka brokers, e.g. "kafka-0:9092,kafka-1:9092".
+	BootstrapServers string `json:"bootstrapServers"`
+	// TopicPrefix is the prefix of the topics, default is "maestro".
+	TopicPrefix string `json:"topicPrefix,omitempty"`
+	// GroupID is the consumer group of the maestro instances, default is "maestro".
+	GroupID string `json:"groupID,omitempty"`
+
+	CAFile         string `json:"caFile,omitempty"`
+	ClientCertFile string `json:"clientCertFile,omitempty"`
+	ClientKeyFile  string `json:"clientKeyFile,omitempty"`
+
+	// Username and Password enable the SASL/PLAIN authentication.
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// KafkaOptions holds the options to connect to the kafka brokers.
+type KafkaOptions struct {
+	BootstrapServers []string
+	TopicPrefix      string
+	GroupID          string
+	TLSConfig        *tls.Config
+	Username         string
+	Password         string
+}
+
+// BuildKafkaOptionsFromFlags builds the kafka options from the config file.
+func BuildKafkaOptionsFromFlags(configPath string) (*KafkaOptions, error) {
+	configData, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &KafkaConfig{}
+	if err := yaml.Unmarshal(configData, config); err != nil {
+		return nil, err
+	}
+
+	options := &KafkaOptions{
+		TopicPrefix: defaultTopicPrefix,
+		GroupID:     defaultGroupID,
+		Username:    config.Username,
+		Password:    config.Password,
+	}
+	for _, server := range strings.Split(config.BootstrapServers, ",") {
+		if server = strings.TrimSpace(server); server != "" {
+			options.BootstrapServers = append(options.BootstrapServers, server)
+		}
+	}
+	if len(options.BootstrapServers) == 0 {
+		return nil, fmt.Errorf("bootstrapServers is required")
+	}
+	if config.TopicPrefix != "" {
+		options.TopicPrefix = config.TopicPrefix
+	}
+	if config.GroupID != "" {
+		options.GroupID = config.GroupID
+	}
+
+	if (config.ClientCertFile == "") != (config.ClientKeyFile == "") {
+		return nil, fmt.Errorf("either both or none of clientCertFile and clientKeyFile must be set")
+	}
+	if config.CAFile != "" || config.ClientCertFile != "" {
+		options.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		if config.CAFile != "" {
+			caData, err := os.ReadFile(config.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			certPool := x509.NewCertPool()
+			if !certPool.AppendCertsFromPEM(caData) {
+				return nil, fmt.Errorf("no valid certificate is found in %s", config.CAFile)
+			}
+			options.TLSConfig.RootCAs = certPool
+		}
+		if config.ClientCertFile != "" {
+			cert, err := tls.LoadX509KeyPair(config.ClientCertFile, config.ClientKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			options.TLSConfig.Certificates = []tls.Certificate{cert}
+		}
+	}
+
+	return options, nil
+}
+
+// saramaConfig returns the sarama config of a maestro instance.
+func (o *KafkaOptions) saramaConfig(clientID string) *sarama.Config {
+	config := sarama.NewConfig()
+	config.ClientID = clientID
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Return.Successes = true
+	config.Consumer.Return.Errors = true
+	// the status changes before the subscription are recovered by the resync
+	config.Consumer.Offsets.Initial = sarama.OffsetNewest
+	if o.TLSConfig != nil {
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = o.TLSConfig
+	}
+	if o.Username != "" {
+		config.Net.SASL.Enable = true
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+		config.Net.SASL.User = o.Username
+		config.Net.SASL.Password = o.Password
+	}
+	return config
+}
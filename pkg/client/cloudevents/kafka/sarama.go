@@ -0,0 +1,160 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/IBM/sarama"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+// saramaClient is the brokerClient of the kafka brokers.
+type saramaClient struct {
+	brokers   []string
+	config    *sarama.Config
+	producer  sarama.SyncProducer
+	errorChan chan<- error
+}
+
+func newSaramaClient(opts *KafkaOptions, clientID string, errorChan chan<- error) (brokerClient, error) {
+	config := opts.saramaConfig(clientID)
+	producer, err := sarama.NewSyncProducer(opts.BootstrapServers, config)
+	if err != nil {
+		return nil, err
+	}
+	return &saramaClient{
+		brokers:   opts.BootstrapServers,
+		config:    config,
+		producer:  producer,
+		errorChan: errorChan,
+	}, nil
+}
+
+func (c *saramaClient) Publish(ctx context.Context, topic, key string, value []byte) error {
+	_, _, err := c.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder(key),
+		Value: sarama.ByteEncoder(value),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("content-type"), Value: []byte(cloudevents.ApplicationCloudEventsJSON)},
+		},
+	})
+	return err
+}
+
+func (c *saramaClient) Consume(ctx context.Context, groupID string, topics []string, handler func(ctx context.Context, value []byte)) error {
+	if groupID == "" {
+		return c.consumePartitions(ctx, topics, handler)
+	}
+
+	group, err := sarama.NewConsumerGroup(c.brokers, groupID, c.config)
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	go func() {
+		// the errors channel is closed when the group is closed
+		for err := range group.Errors() {
+			select {
+			case c.errorChan <- err:
+			default:
+			}
+		}
+	}()
+
+	for {
+		// Consume returns when the group is rebalanced, it is called again to rejoin the group.
+		if err := group.Consume(ctx, topics, &groupHandler{handler: handler}); err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return nil
+			}
+			return err
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+// consumePartitions consumes all the partitions of the topics without a consumer group, the partitions are assigned
+// manually and no offset is committed, so nothing is left on the brokers when the client is gone. The partitions
+// that are added to the topics later are consumed once the transport reconnects.
+func (c *saramaClient) consumePartitions(ctx context.Context, topics []string, handler func(ctx context.Context, value []byte)) error {
+	consumer, err := sarama.NewConsumer(c.brokers, c.config)
+	if err != nil {
+		return err
+	}
+	defer consumer.Close()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for _, topic := range topics {
+		partitions, err := consumer.Partitions(topic)
+		if err != nil {
+			return err
+		}
+		for _, partition := range partitions {
+			pc, err := consumer.ConsumePartition(topic, partition, c.config.Consumer.Offsets.Initial)
+			if err != nil {
+				return err
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer pc.AsyncClose()
+				for {
+					select {
+					case msg, ok := <-pc.Messages():
+						if !ok {
+							return
+						}
+						handler(ctx, msg.Value)
+					case err := <-pc.Errors():
+						select {
+						case c.errorChan <- err:
+						default:
+						}
+					case <-ctx.Done():
+						return
+					}
+				}
+			}()
+		}
+	}
+
+	<-ctx.Done()
+	return nil
+}
+
+func (c *saramaClient) Close() error {
+	return c.producer.Close()
+}
+
+type groupHandler struct {
+	handler func(ctx context.Context, value []byte)
+}
+
+func (h *groupHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *groupHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *groupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			h.handler(session.Context(), msg.Value)
+			session.MarkMessage(msg, "")
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetypes "github.com/cloudevents/sdk-go/v2/types"
+	"k8s.io/klog/v2"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/options"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/config"
+)
+
+// Topic layout, all the topics are prefixed with the topic prefix of the options:
+//   - <prefix>.sourceevents.<consumer>: the spec events and the status resync requests sent to a consumer, the agent
+//     of the consumer subscribes to it.
+//   - <prefix>.sourcebroadcast: the status resync requests sent to all the consumers.
+//   - <prefix>.agentevents.<source>: the status events and the spec resync requests sent to a source, the maestro
+//     instances subscribe to it.
+//   - <prefix>.agentbroadcast: the spec resync requests sent to all the sources.
+//
+// The messages are keyed by the resource id, so the events of a resource are kept in order in a partition.
+
+// SourceEventsTopic returns the topic of the events sent from the sources to the consumer.
+func SourceEventsTopic(topicPrefix, consumerName string) string {
+	return fmt.Sprintf("%s.sourceevents.%s", topicPrefix, consumerName)
+}
+
+// SourceBroadcastTopic returns the topic of the events sent from the sources to all the consumers.
+func SourceBroadcastTopic(topicPrefix string) string {
+	return fmt.Sprintf("%s.sourcebroadcast", topicPrefix)
+}
+
+// AgentEventsTopic returns the topic of the events sent from the agents to the source.
+func AgentEventsTopic(topicPrefix, sourceID string) string {
+	return fmt.Sprintf("%s.agentevents.%s", topicPrefix, sourceID)
+}
+
+// AgentBroadcastTopic returns the topic of the events sent from the agents to all the sources.
+func AgentBroadcastTopic(topicPrefix string) string {
+	return fmt.Sprintf("%s.agentbroadcast", topicPrefix)
+}
+
+// brokerClient publishes the messages to and consumes the messages from the kafka topics, it is implemented with
+// sarama and replaced with an in-memory broker in the tests.
+type brokerClient interface {
+	Publish(ctx context.Context, topic, key string, value []byte) error
+	// Consume passes the messages of the topics to the handler until the context is done. With a consumer group, a
+	// message is delivered to only one member of the group. Without a consumer group, all the partitions of the
+	// topics are consumed from the newest offsets and no offset is committed.
+	Consume(ctx context.Context, groupID string, topics []string, handler func(ctx context.Context, value []byte)) error
+	Close() error
+}
+
+type newBrokerClientFn func(opts *KafkaOptions, clientID string, errorChan chan<- error) (brokerClient, error)
+
+// eventTopicFn returns the topic and the key of an event that is sent.
+type eventTopicFn func(evt cloudevents.Event) (topic string, key string, err error)
+
+// kafkaTransport is the CloudEvents transport of the source and agent clients on kafka, the events are encoded in
+// the structured content mode.
+type kafkaTransport struct {
+	sync.Mutex
+
+	opts     *KafkaOptions
+	clientID string
+	// eventTopic returns the topic that an event is sent to
+	eventTopic eventTopicFn
+	// topics are the topics that the events are received from
+	topics []string
+	// groupID is the consumer group that the events are received with, the events are received without a consumer
+	// group if it is empty.
+	groupID   string
+	newClient newBrokerClientFn
+	client    brokerClient
+	errorChan chan error
+}
+
+var _ options.CloudEventTransport = &kafkaTransport{}
+
+// NewSourceOptions returns the CloudEvents source options of the kafka message broker.
+//
+// With the shared subscription type, the maestro instances join the same consumer group and a status event is
+// received by only one instance. With the broadcast subscription type, each instance consumes all the partitions
+// without a consumer group, so all the instances receive the status events and the status dispatcher decides which
+// instance handles them, and no consumer group is left behind by the instances that are gone.
+func NewSourceOptions(opts *KafkaOptions, clientID, sourceID string, subscriptionType config.SubscriptionType) *options.CloudEventsSourceOptions {
+	return &options.CloudEventsSourceOptions{
+		CloudEventsTransport: newSourceTransport(opts, clientID, sourceID, subscriptionType, newSaramaClient),
+		SourceID:             sourceID,
+	}
+}
+
+// NewAgentOptions returns the CloudEvents agent options of the kafka message broker for the agents that are built
+// with the sdk-go. The agents of a consumer share the <GroupID>-<consumer> consumer group, so a spec event is
+// received by one agent of the consumer.
+func NewAgentOptions(opts *KafkaOptions, clusterName, agentID string) *options.CloudEventsAgentOptions {
+	return &options.CloudEventsAgentOptions{
+		CloudEventsTransport: newAgentTransport(opts, clusterName, agentID, newSaramaClient),
+		AgentID:              agentID,
+		ClusterName:          clusterName,
+	}
+}
+
+func newSourceTransport(opts *KafkaOptions, clientID, sourceID string, subscriptionType config.SubscriptionType,
+	newClient newBrokerClientFn) *kafkaTransport {
+	groupID := opts.GroupID
+	if subscriptionType == config.BroadcastSubscriptionType {
+		groupID = ""
+	}
+	return &kafkaTransport{
+		opts:     opts,
+		clientID: clientID,
+		eventTopic: func(evt cloudevents.Event) (string, string, error) {
+			// the events without a cluster name are sent to all the consumers
+			clusterName, err := eventExtension(evt, types.ExtensionClusterName)
+			if err != nil {
+				return "", "", err
+			}
+			if clusterName == "" {
+				return SourceBroadcastTopic(opts.TopicPrefix), eventKey(evt, clusterName), nil
+			}
+			return SourceEventsTopic(opts.TopicPrefix, clusterName), eventKey(evt, clusterName), nil
+		},
+		topics:    []string{AgentEventsTopic(opts.TopicPrefix, sourceID), AgentBroadcastTopic(opts.TopicPrefix)},
+		groupID:   groupID,
+		newClient: newClient,
+		errorChan: make(chan error, 1),
+	}
+}
+
+func newAgentTransport(opts *KafkaOptions, clusterName, agentID string, newClient newBrokerClientFn) *kafkaTransport {
+	return &kafkaTransport{
+		opts:     opts,
+		clientID: agentID,
+		eventTopic: func(evt cloudevents.Event) (string, string, error) {
+			// the events without an original source are sent to all the sources
+			source, err := eventExtension(evt, types.ExtensionOriginalSource)
+			if err != nil {
+				return "", "", err
+			}
+			if source == "" {
+				return AgentBroadcastTopic(opts.TopicPrefix), eventKey(evt, clusterName), nil
+			}
+			return AgentEventsTopic(opts.TopicPrefix, source), eventKey(evt, clusterName), nil
+		},
+		topics:    []string{SourceEventsTopic(opts.TopicPrefix, clusterName), SourceBroadcastTopic(opts.TopicPrefix)},
+		groupID:   fmt.Sprintf("%s-%s", opts.GroupID, clusterName),
+		newClient: newClient,
+		errorChan: make(chan error, 1),
+	}
+}
+
+func (t *kafkaTransport) Connect(ctx context.Context) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.client != nil {
+		// reconnect after an error
+		if err := t.client.Close(); err != nil {
+			klog.FromContext(ctx).Error(err, "failed to close the kafka client")
+		}
+	}
+	client, err := t.newClient(t.opts, t.clientID, t.errorChan)
+	if err != nil {
+		return fmt.Errorf("failed to connect to kafka brokers %v: %v", t.opts.BootstrapServers, err)
+	}
+	t.client = client
+	return nil
+}
+
+func (t *kafkaTransport) Send(ctx context.Context, evt cloudevents.Event) error {
+	client, err := t.getClient()
+	if err != nil {
+		return err
+	}
+
+	topic, key, err := t.eventTopic(evt)
+	if err != nil {
+		return fmt.Errorf("failed to get the topic of the event %s: %v", evt.ID(), err)
+	}
+
+	data, err := evt.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to encode the event %s: %v", evt.ID(), err)
+	}
+	if err := client.Publish(ctx, topic, key, data); err != nil {
+		return fmt.Errorf("failed to publish the event %s to topic %s: %v", evt.ID(), topic, err)
+	}
+	return nil
+}
+
+// Subscribe does nothing, the topics are consumed when the events are received.
+func (t *kafkaTransport) Subscribe(ctx context.Context) error {
+	_, err := t.getClient()
+	return err
+}
+
+func (t *kafkaTransport) Receive(ctx context.Context, fn options.ReceiveHandlerFn) error {
+	client, err := t.getClient()
+	if err != nil {
+		return err
+	}
+
+	logger := klog.FromContext(ctx)
+	return client.Consume(ctx, t.groupID, t.topics, func(ctx context.Context, value []byte) {
+		evt := cloudevents.NewEvent()
+		if err := evt.UnmarshalJSON(value); err != nil {
+			logger.Error(err, "failed to decode the kafka message to a cloudevent")
+			return
+		}
+		fn(ctx, evt)
+	})
+}
+
+func (t *kafkaTransport) Close(ctx context.Context) error {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.client == nil {
+		return nil
+	}
+	err := t.client.Close()
+	t.client = nil
+	return err
+}
+
+func (t *kafkaTransport) ErrorChan() <-chan error {
+	return t.errorChan
+}
+
+func (t *kafkaTransport) getClient() (brokerClient, error) {
+	t.Lock()
+	defer t.Unlock()
+
+	if t.client == nil {
+		return nil, fmt.Errorf("the kafka transport is not connected")
+	}
+	return t.client, nil
+}
+
+// eventExtension returns the string value of the event extension, empty is returned if the extension is not set.
+func eventExtension(evt cloudevents.Event, name string) (string, error) {
+	val, ok := evt.Extensions()[name]
+	if !ok {
+		return "", nil
+	}
+	return cetypes.ToString(val)
+}
+
+// eventKey returns the message key of the event, the resync requests have no resource id, they are keyed by the
+// cluster name.
+func eventKey(evt cloudevents.Event, clusterName string) string {
+	if resourceID, err := cetypes.ToString(evt.Extensions()[types.ExtensionResourceID]); err == nil && resourceID != "" {
+		return resourceID
+	}
+	return clusterName
+}
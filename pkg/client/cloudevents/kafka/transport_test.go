@@ -0,0 +1,440 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
+
+	"github.com/openshift-online/maestro/pkg/config"
+)
+
+// memBroker is an in-memory stand-in of the kafka brokers, a message is delivered to one member of each consumer
+// group that subscribes to the topic, the member is chosen by the message key like a partition. The members without
+// a consumer group receive all the messages of their topics.
+type memBroker struct {
+	sync.Mutex
+	messages map[string][]memMessage
+	groups   map[string][]*memMember
+	// standalone are the members without a consumer group
+	standalone []*memMember
+}
+
+type memMessage struct {
+	key   string
+	value []byte
+}
+
+type memMember struct {
+	topics   map[string]bool
+	messages chan []byte
+}
+
+func newMemBroker() *memBroker {
+	return &memBroker{
+		messages: map[string][]memMessage{},
+		groups:   map[string][]*memMember{},
+	}
+}
+
+func (b *memBroker) newClient(opts *KafkaOptions, clientID string, errorChan chan<- error) (brokerClient, error) {
+	return &memClient{broker: b}, nil
+}
+
+func (b *memBroker) members() int {
+	b.Lock()
+	defer b.Unlock()
+	count := len(b.standalone)
+	for _, members := range b.groups {
+		count += len(members)
+	}
+	return count
+}
+
+type memClient struct {
+	broker *memBroker
+}
+
+func (c *memClient) Publish(ctx context.Context, topic, key string, value []byte) error {
+	c.broker.Lock()
+	defer c.broker.Unlock()
+
+	c.broker.messages[topic] = append(c.broker.messages[topic], memMessage{key: key, value: value})
+	for _, members := range c.broker.groups {
+		subscribed := []*memMember{}
+		for _, member := range members {
+			if member.topics[topic] {
+				subscribed = append(subscribed, member)
+			}
+		}
+		if len(subscribed) == 0 {
+			continue
+		}
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(key))
+		subscribed[int(h.Sum32())%len(subscribed)].messages <- value
+	}
+	for _, member := range c.broker.standalone {
+		if member.topics[topic] {
+			member.messages <- value
+		}
+	}
+	return nil
+}
+
+func (c *memClient) Consume(ctx context.Context, groupID string, topics []string, handler func(ctx context.Context, value []byte)) error {
+	member := &memMember{topics: map[string]bool{}, messages: make(chan []byte, 100)}
+	for _, topic := range topics {
+		member.topics[topic] = true
+	}
+
+	c.broker.Lock()
+	if groupID == "" {
+		c.broker.standalone = append(c.broker.standalone, member)
+	} else {
+		c.broker.groups[groupID] = append(c.broker.groups[groupID], member)
+	}
+	c.broker.Unlock()
+
+	defer func() {
+		c.broker.Lock()
+		defer c.broker.Unlock()
+		if groupID == "" {
+			c.broker.standalone = removeMember(c.broker.standalone, member)
+			return
+		}
+		c.broker.groups[groupID] = removeMember(c.broker.groups[groupID], member)
+	}()
+
+	for {
+		select {
+		case value := <-member.messages:
+			handler(ctx, value)
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (c *memClient) Close() error {
+	return nil
+}
+
+func removeMember(members []*memMember, member *memMember) []*memMember {
+	kept := []*memMember{}
+	for _, m := range members {
+		if m != member {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+func newTestEvent(clusterName, resourceID string) cloudevents.Event {
+	evt := cloudevents.NewEvent()
+	evt.SetID(resourceID)
+	evt.SetSource("maestro")
+	evt.SetType("io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request")
+	if clusterName != "" {
+		evt.SetExtension(types.ExtensionClusterName, clusterName)
+	}
+	if resourceID != "" {
+		evt.SetExtension(types.ExtensionResourceID, resourceID)
+	}
+	return evt
+}
+
+func TestSendTopics(t *testing.T) {
+	broker := newMemBroker()
+	opts := &KafkaOptions{TopicPrefix: "maestro", GroupID: "maestro"}
+	transport := newSourceTransport(opts, "instance-1", "maestro", config.SharedSubscriptionType, broker.newClient)
+
+	ctx := context.Background()
+	if err := transport.Send(ctx, newTestEvent("cluster1", "r1")); err == nil {
+		t.Fatal("expected an error before the transport is connected")
+	}
+	if err := transport.Connect(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := transport.Send(ctx, newTestEvent("cluster1", "r1")); err != nil {
+		t.Fatal(err)
+	}
+	// a status resync request of a consumer has no resource id
+	if err := transport.Send(ctx, newTestEvent("cluster2", "")); err != nil {
+		t.Fatal(err)
+	}
+	if err := transport.Send(ctx, newTestEvent("", "")); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		topic string
+		key   string
+	}{
+		{topic: "maestro.sourceevents.cluster1", key: "r1"},
+		{topic: "maestro.sourceevents.cluster2", key: "cluster2"},
+		{topic: "maestro.sourcebroadcast", key: ""},
+	}
+	for _, c := range cases {
+		messages := broker.messages[c.topic]
+		if len(messages) != 1 {
+			t.Fatalf("expected 1 message in topic %s, but got %d", c.topic, len(messages))
+		}
+		if messages[0].key != c.key {
+			t.Errorf("expected key %q in topic %s, but got %q", c.key, c.topic, messages[0].key)
+		}
+		evt := cloudevents.NewEvent()
+		if err := evt.UnmarshalJSON(messages[0].value); err != nil {
+			t.Errorf("expected a structured cloudevent in topic %s, but got %v", c.topic, err)
+		}
+	}
+}
+
+func TestReceive(t *testing.T) {
+	cases := []struct {
+		name             string
+		subscriptionType config.SubscriptionType
+		// groupIDs are the consumer groups of the instances, an instance of the broadcast subscription type has no
+		// consumer group
+		groupIDs []string
+		// expectedEvents is the number of the events received by all the instances
+		expectedEvents int
+	}{
+		{
+			name:             "shared subscription",
+			subscriptionType: config.SharedSubscriptionType,
+			groupIDs:         []string{"maestro", "maestro"},
+			expectedEvents:   10,
+		},
+		{
+			name:             "broadcast subscription",
+			subscriptionType: config.BroadcastSubscriptionType,
+			groupIDs:         []string{"", ""},
+			expectedEvents:   20,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			broker := newMemBroker()
+			opts := &KafkaOptions{TopicPrefix: "maestro", GroupID: "maestro"}
+
+			var lock sync.Mutex
+			received := map[string]int{}
+			for i := range c.groupIDs {
+				transport := newSourceTransport(opts, fmt.Sprintf("instance-%d", i), "maestro", c.subscriptionType, broker.newClient)
+				if transport.groupID != c.groupIDs[i] {
+					t.Fatalf("expected group %q, but got %q", c.groupIDs[i], transport.groupID)
+				}
+				if err := transport.Connect(ctx); err != nil {
+					t.Fatal(err)
+				}
+				if err := transport.Subscribe(ctx); err != nil {
+					t.Fatal(err)
+				}
+				go func() {
+					_ = transport.Receive(ctx, func(ctx context.Context, evt cloudevents.Event) {
+						lock.Lock()
+						defer lock.Unlock()
+						received[evt.ID()]++
+					})
+				}()
+			}
+
+			if err := wait(func() bool { return broker.members() == len(c.groupIDs) }); err != nil {
+				t.Fatal(err)
+			}
+
+			// publish the status events of an agent
+			agent := &memClient{broker: broker}
+			for i := 0; i < 10; i++ {
+				evt := newTestEvent("cluster1", fmt.Sprintf("r%d", i))
+				data, err := evt.MarshalJSON()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := agent.Publish(ctx, AgentEventsTopic("maestro", "maestro"), evt.ID(), data); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// the events of other sources are not received
+			if err := agent.Publish(ctx, AgentEventsTopic("maestro", "other"), "r0", []byte("{}")); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := wait(func() bool {
+				lock.Lock()
+				defer lock.Unlock()
+				total := 0
+				for _, count := range received {
+					total += count
+				}
+				return total == c.expectedEvents
+			}); err != nil {
+				t.Fatal(err)
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			for id, count := range received {
+				if count != c.expectedEvents/10 {
+					t.Errorf("expected event %s to be received %d times, but got %d", id, c.expectedEvents/10, count)
+				}
+			}
+		})
+	}
+}
+
+func TestAgentTransport(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broker := newMemBroker()
+	opts := &KafkaOptions{TopicPrefix: "maestro", GroupID: "maestro"}
+	transport := newAgentTransport(opts, "cluster1", "agent-1", broker.newClient)
+	if transport.groupID != "maestro-cluster1" {
+		t.Fatalf("expected group maestro-cluster1, but got %s", transport.groupID)
+	}
+	if err := transport.Connect(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// a status event is sent to its original source, and a spec resync request is sent to all the sources
+	status := newTestEvent("cluster1", "r1")
+	status.SetExtension(types.ExtensionOriginalSource, "maestro")
+	if err := transport.Send(ctx, status); err != nil {
+		t.Fatal(err)
+	}
+	if err := transport.Send(ctx, newTestEvent("cluster1", "")); err != nil {
+		t.Fatal(err)
+	}
+	for topic, key := range map[string]string{"maestro.agentevents.maestro": "r1", "maestro.agentbroadcast": "cluster1"} {
+		messages := broker.messages[topic]
+		if len(messages) != 1 {
+			t.Fatalf("expected 1 message in topic %s, but got %d", topic, len(messages))
+		}
+		if messages[0].key != key {
+			t.Errorf("expected key %q in topic %s, but got %q", key, topic, messages[0].key)
+		}
+	}
+
+	var lock sync.Mutex
+	received := []string{}
+	go func() {
+		_ = transport.Receive(ctx, func(ctx context.Context, evt cloudevents.Event) {
+			lock.Lock()
+			defer lock.Unlock()
+			received = append(received, evt.ID())
+		})
+	}()
+	if err := wait(func() bool { return broker.members() == 1 }); err != nil {
+		t.Fatal(err)
+	}
+
+	// the agent receives the events of its consumer and the broadcast events
+	source := newSourceTransport(opts, "instance-1", "maestro", config.SharedSubscriptionType, broker.newClient)
+	if err := source.Connect(ctx); err != nil {
+		t.Fatal(err)
+	}
+	resync := newTestEvent("", "")
+	resync.SetID("resync")
+	for _, evt := range []cloudevents.Event{newTestEvent("cluster1", "r1"), newTestEvent("cluster2", "r2"), resync} {
+		if err := source.Send(ctx, evt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := wait(func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return len(received) == 2
+	}); err != nil {
+		t.Fatal(err)
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if received[0] != "r1" || received[1] != "resync" {
+		t.Errorf("expected the events r1 and resync, but got %v", received)
+	}
+}
+
+func TestBuildKafkaOptionsFromFlags(t *testing.T) {
+	cases := []struct {
+		name            string
+		config          string
+		expectedOptions *KafkaOptions
+		expectedErr     bool
+	}{
+		{
+			name:   "default options",
+			config: "bootstrapServers: kafka-0:9092, kafka-1:9092",
+			expectedOptions: &KafkaOptions{
+				BootstrapServers: []string{"kafka-0:9092", "kafka-1:9092"},
+				TopicPrefix:      "maestro",
+				GroupID:          "maestro",
+			},
+		},
+		{
+			name:   "customized options",
+			config: "{\"bootstrapServers\":\"kafka:9092\",\"topicPrefix\":\"test\",\"groupID\":\"test-group\",\"username\":\"user\",\"password\":\"pass\"}",
+			expectedOptions: &KafkaOptions{
+				BootstrapServers: []string{"kafka:9092"},
+				TopicPrefix:      "test",
+				GroupID:          "test-group",
+				Username:         "user",
+				Password:         "pass",
+			},
+		},
+		{
+			name:        "no bootstrap servers",
+			config:      "topicPrefix: test",
+			expectedErr: true,
+		},
+		{
+			name:        "client cert without key",
+			config:      "bootstrapServers: kafka:9092\nclientCertFile: /tmp/tls.crt",
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			configFile := filepath.Join(t.TempDir(), "kafka.config")
+			if err := os.WriteFile(configFile, []byte(c.config), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			options, err := BuildKafkaOptionsFromFlags(configFile)
+			if c.expectedErr {
+				if err == nil {
+					t.Fatal("expected an error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprintf("%v", options) != fmt.Sprintf("%v", c.expectedOptions) {
+				t.Errorf("expected options %v, but got %v", c.expectedOptions, options)
+			}
+		})
+	}
+}
+
+func wait(condition func() bool) error {
+	for i := 0; i < 50; i++ {
+		if condition() {
+			return nil
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	return fmt.Errorf("timeout waiting for the condition")
+}
@@ -38,11 +38,16 @@ type SourceClientImpl struct {
 	ResourceService        services.ResourceService
 }
 
-func NewSourceClient(sourceOptions *ceoptions.CloudEventsSourceOptions, resourceService services.ResourceService) (SourceClient, error) {
+func NewSourceClient(sourceOptions *ceoptions.CloudEventsSourceOptions, resourceService services.ResourceService,
+	consumerHeartbeatService services.ConsumerHeartbeatService) (SourceClient, error) {
 	ctx := context.Background()
 	codec := NewCodec(sourceOptions.SourceID)
+	lister := &heartbeatLister{
+		resourceService:          resourceService,
+		consumerHeartbeatService: consumerHeartbeatService,
+	}
 	ceSourceClient, err := ceclients.NewCloudEventSourceClient[*api.Resource](ctx, sourceOptions,
-		resourceService, ResourceStatusHashGetter, codec)
+		lister, ResourceStatusHashGetter, codec)
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +175,18 @@ func (s *SourceClientImpl) SubscribedChan() <-chan struct{} {
 	return s.CloudEventSourceClient.SubscribedChan()
 }
 
+// heartbeatLister lists the resources of a consumer for the spec resync requests of its agent, the resync requests
+// are recorded as the agent heartbeats.
+type heartbeatLister struct {
+	resourceService          services.ResourceService
+	consumerHeartbeatService services.ConsumerHeartbeatService
+}
+
+func (l *heartbeatLister) List(ctx context.Context, listOpts types.ListOptions) ([]*api.Resource, error) {
+	l.consumerHeartbeatService.RecordHeartbeat(listOpts.ClusterName, "")
+	return l.resourceService.List(ctx, listOpts)
+}
+
 // ResourceStatusHashGetter returns a hash of the resource status.
 // It calculates the hash based on the manifestwork status to ensure consistency
 // with the agent's status calculation. The resource status is converted to
@@ -19,8 +19,9 @@ type GRPCAuthorizer interface {
 	//
 	// Parameters:
 	// - ctx: The context for managing request lifecycle.
-	// - action: The action being requested, e.g., "pub" (publish) or "sub" (subscribe).
-	// - resourceType: The type of resource, e.g., "source" or "cluster".
+	// - action: The action being requested, e.g., "pub" (publish), "sub" (subscribe) or "observe" (observe the status of
+	//   the resources on a consumer from all of the sources).
+	// - resourceType: The type of resource, e.g., "source" or "consumer".
 	// - resource: The specific resource name within the given resource type.
 	// - user: The user requesting the action (may be empty if groups are used).
 	// - groups: The groups requesting the action (may be empty if user is used).
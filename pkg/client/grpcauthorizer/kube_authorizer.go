@@ -57,7 +57,7 @@ func (k *KubeGRPCAuthorizer) AccessReview(ctx context.Context, action, resourceT
 		return false, fmt.Errorf("groups must be set when user is specified")
 	}
 
-	if action != "pub" && action != "sub" {
+	if action != "pub" && action != "sub" && action != "observe" {
 		return false, fmt.Errorf("unsupported action: %s", action)
 	}
 
@@ -69,6 +69,8 @@ func (k *KubeGRPCAuthorizer) AccessReview(ctx context.Context, action, resourceT
 	switch resourceType {
 	case "source":
 		nonResourceUrl = fmt.Sprintf("/sources/%s", resource)
+	case "consumer":
+		nonResourceUrl = fmt.Sprintf("/consumers/%s", resource)
 	default:
 		return false, fmt.Errorf("unsupported resource type: %s", resourceType)
 	}
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+// AdmissionFailurePolicy defines how the failure to call an admission webhook is handled.
+type AdmissionFailurePolicy string
+
+const (
+	// AdmissionFailurePolicyFail rejects the write if the webhook cannot be called.
+	AdmissionFailurePolicyFail AdmissionFailurePolicy = "Fail"
+	// AdmissionFailurePolicyIgnore admits the write if the webhook cannot be called.
+	AdmissionFailurePolicyIgnore AdmissionFailurePolicy = "Ignore"
+)
+
+// AdmissionWebhookType is the type of an admission webhook.
+type AdmissionWebhookType string
+
+const (
+	// MutatingAdmissionWebhook may change the manifest bundle of the write.
+	MutatingAdmissionWebhook AdmissionWebhookType = "Mutating"
+	// ValidatingAdmissionWebhook can only admit or reject the write.
+	ValidatingAdmissionWebhook AdmissionWebhookType = "Validating"
+)
+
+// AdmissionConfig contains the configuration of the admission chain that runs on the resource bundle writes.
+type AdmissionConfig struct {
+	ConfigFile string `json:"admission_config_file"`
+
+	// Plugins are the names of the in-process admission plugins to enable, in the order to run.
+	Plugins []string `json:"plugins,omitempty"`
+	// Webhooks are the admission webhooks to call, in the order to run.
+	Webhooks []AdmissionWebhookConfig `json:"webhooks,omitempty"`
+}
+
+// AdmissionWebhookConfig is the configuration of an admission webhook.
+type AdmissionWebhookConfig struct {
+	Name string               `json:"name"`
+	Type AdmissionWebhookType `json:"type"`
+	// URL is the HTTPS endpoint that the admission reviews are posted to.
+	URL string `json:"url"`
+	// CAFile is the CA bundle to verify the webhook server, the system CAs are used if it is empty.
+	CAFile string `json:"caFile,omitempty"`
+	// TimeoutSeconds is the timeout to call the webhook, it defaults to 10 seconds.
+	TimeoutSeconds int                    `json:"timeoutSeconds,omitempty"`
+	FailurePolicy  AdmissionFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+func NewAdmissionConfig() *AdmissionConfig {
+	return &AdmissionConfig{
+		ConfigFile: "",
+	}
+}
+
+func (c *AdmissionConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.ConfigFile, "admission-config-file", c.ConfigFile, "The config file path of the admission plugins and webhooks for the resource bundle writes")
+}
+
+// ReadFiles loads the admission plugins and webhooks from the config file, the admission is disabled if
+// the config file is not specified.
+func (c *AdmissionConfig) ReadFiles() error {
+	if c.ConfigFile == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(c.ConfigFile)
+	if err != nil {
+		return err
+	}
+
+	config := &AdmissionConfig{}
+	if err := yaml.Unmarshal(content, config); err != nil {
+		return fmt.Errorf("failed to parse admission config file %s: %v", c.ConfigFile, err)
+	}
+
+	names := map[string]bool{}
+	for i, webhook := range config.Webhooks {
+		if webhook.Name == "" {
+			return fmt.Errorf("the name of admission webhook %d is required", i)
+		}
+		if names[webhook.Name] {
+			return fmt.Errorf("the admission webhook %s is duplicated", webhook.Name)
+		}
+		names[webhook.Name] = true
+
+		if webhook.Type != MutatingAdmissionWebhook && webhook.Type != ValidatingAdmissionWebhook {
+			return fmt.Errorf("the type of admission webhook %s must be %s or %s",
+				webhook.Name, MutatingAdmissionWebhook, ValidatingAdmissionWebhook)
+		}
+		if webhook.URL == "" {
+			return fmt.Errorf("the url of admission webhook %s is required", webhook.Name)
+		}
+		switch webhook.FailurePolicy {
+		case "":
+			config.Webhooks[i].FailurePolicy = AdmissionFailurePolicyFail
+		case AdmissionFailurePolicyFail, AdmissionFailurePolicyIgnore:
+		default:
+			return fmt.Errorf("the failure policy of admission webhook %s must be %s or %s",
+				webhook.Name, AdmissionFailurePolicyFail, AdmissionFailurePolicyIgnore)
+		}
+		if webhook.TimeoutSeconds <= 0 {
+			config.Webhooks[i].TimeoutSeconds = 10
+		}
+	}
+
+	c.Plugins = config.Plugins
+	c.Webhooks = config.Webhooks
+	return nil
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAdmissionConfigReadFiles(t *testing.T) {
+	RegisterTestingT(t)
+
+	// the admission is disabled without a config file
+	c := NewAdmissionConfig()
+	Expect(c.ReadFiles()).To(Succeed())
+	Expect(c.Plugins).To(BeEmpty())
+	Expect(c.Webhooks).To(BeEmpty())
+
+	configFile, err := createConfigFile("admission", `
+plugins:
+- label
+webhooks:
+- name: policy
+  type: Validating
+  url: https://policy.example.com/validate
+  caFile: /etc/maestro/policy-ca.crt
+- name: defaults
+  type: Mutating
+  url: https://defaults.example.com/mutate
+  timeoutSeconds: 5
+  failurePolicy: Ignore
+`)
+	defer os.Remove(configFile.Name())
+	Expect(err).NotTo(HaveOccurred())
+
+	c.ConfigFile = configFile.Name()
+	Expect(c.ReadFiles()).To(Succeed())
+	Expect(c.Plugins).To(Equal([]string{"label"}))
+	Expect(c.Webhooks).To(Equal([]AdmissionWebhookConfig{
+		{
+			Name:           "policy",
+			Type:           ValidatingAdmissionWebhook,
+			URL:            "https://policy.example.com/validate",
+			CAFile:         "/etc/maestro/policy-ca.crt",
+			TimeoutSeconds: 10,
+			FailurePolicy:  AdmissionFailurePolicyFail,
+		},
+		{
+			Name:           "defaults",
+			Type:           MutatingAdmissionWebhook,
+			URL:            "https://defaults.example.com/mutate",
+			TimeoutSeconds: 5,
+			FailurePolicy:  AdmissionFailurePolicyIgnore,
+		},
+	}))
+
+	invalidFile, err := createConfigFile("admission", `
+webhooks:
+- name: policy
+  type: Auditing
+  url: https://policy.example.com/validate
+`)
+	defer os.Remove(invalidFile.Name())
+	Expect(err).NotTo(HaveOccurred())
+
+	c = NewAdmissionConfig()
+	c.ConfigFile = invalidFile.Name()
+	Expect(c.ReadFiles()).To(MatchError("the type of admission webhook policy must be Mutating or Validating"))
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// AuditConfig contains the configuration of the sinks that the audit events are delivered to, the audit events
+// are always saved in the database and the sinks are optional.
+type AuditConfig struct {
+	// SinkFile is the file that the audit events are appended to as JSON lines.
+	SinkFile string `json:"audit_sink_file"`
+	// SinkWebhookURL is the endpoint that the audit events are posted to as JSON, one event per request.
+	SinkWebhookURL string `json:"audit_sink_webhook_url"`
+	// SinkWebhookCAFile is the CA bundle to verify the webhook server, the system CAs are used if it is empty.
+	SinkWebhookCAFile  string        `json:"audit_sink_webhook_ca_file"`
+	SinkWebhookTimeout time.Duration `json:"audit_sink_webhook_timeout"`
+}
+
+func NewAuditConfig() *AuditConfig {
+	return &AuditConfig{
+		SinkFile:           "",
+		SinkWebhookURL:     "",
+		SinkWebhookCAFile:  "",
+		SinkWebhookTimeout: 10 * time.Second,
+	}
+}
+
+func (c *AuditConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.SinkFile, "audit-sink-file", c.SinkFile, "The file that the audit events are appended to as JSON lines, the file sink is disabled if it is empty")
+	fs.StringVar(&c.SinkWebhookURL, "audit-sink-webhook-url", c.SinkWebhookURL, "The URL that the audit events are posted to as JSON, the webhook sink is disabled if it is empty")
+	fs.StringVar(&c.SinkWebhookCAFile, "audit-sink-webhook-ca-file", c.SinkWebhookCAFile, "The CA file to verify the audit webhook server")
+	fs.DurationVar(&c.SinkWebhookTimeout, "audit-sink-webhook-timeout", c.SinkWebhookTimeout, "The timeout to post an audit event to the audit webhook")
+}
+
+func (c *AuditConfig) ReadFiles() error {
+	if c.SinkWebhookURL == "" {
+		return nil
+	}
+
+	webhookURL, err := url.Parse(c.SinkWebhookURL)
+	if err != nil {
+		return fmt.Errorf("the audit sink webhook url is invalid, %v", err)
+	}
+	if webhookURL.Scheme != "http" && webhookURL.Scheme != "https" {
+		return fmt.Errorf("the audit sink webhook url must be http or https, but it is %q", c.SinkWebhookURL)
+	}
+	if c.SinkWebhookTimeout <= 0 {
+		return fmt.Errorf("the audit sink webhook timeout must be positive")
+	}
+	return nil
+}
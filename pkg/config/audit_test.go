@@ -0,0 +1,25 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAuditConfigReadFiles(t *testing.T) {
+	RegisterTestingT(t)
+
+	// the sinks are disabled by default
+	c := NewAuditConfig()
+	Expect(c.ReadFiles()).To(Succeed())
+
+	c.SinkWebhookURL = "https://siem.example.com/audit"
+	Expect(c.ReadFiles()).To(Succeed())
+
+	c.SinkWebhookURL = "ftp://siem.example.com/audit"
+	Expect(c.ReadFiles()).To(MatchError(ContainSubstring("must be http or https")))
+
+	c.SinkWebhookURL = "https://siem.example.com/audit"
+	c.SinkWebhookTimeout = 0
+	Expect(c.ReadFiles()).To(MatchError(ContainSubstring("timeout must be positive")))
+}
@@ -13,24 +13,32 @@ import (
 )
 
 type ApplicationConfig struct {
-	HTTPServer    *HTTPServerConfig    `json:"http_server"`
-	GRPCServer    *GRPCServerConfig    `json:"grpc_server"`
-	Metrics       *MetricsConfig       `json:"metrics"`
-	HealthCheck   *HealthCheckConfig   `json:"health_check"`
-	EventServer   *EventServerConfig   `json:"event_server"`
-	Database      *DatabaseConfig      `json:"database"`
-	MessageBroker *MessageBrokerConfig `json:"message_broker"`
+	HTTPServer      *HTTPServerConfig      `json:"http_server"`
+	GRPCServer      *GRPCServerConfig      `json:"grpc_server"`
+	Metrics         *MetricsConfig         `json:"metrics"`
+	HealthCheck     *HealthCheckConfig     `json:"health_check"`
+	EventServer     *EventServerConfig     `json:"event_server"`
+	EventController *EventControllerConfig `json:"event_controller"`
+	Database        *DatabaseConfig        `json:"database"`
+	MessageBroker   *MessageBrokerConfig   `json:"message_broker"`
+	Admission       *AdmissionConfig       `json:"admission"`
+	Policy          *PolicyConfig          `json:"policy"`
+	Audit           *AuditConfig           `json:"audit"`
 }
 
 func NewApplicationConfig() *ApplicationConfig {
 	return &ApplicationConfig{
-		HTTPServer:    NewHTTPServerConfig(),
-		GRPCServer:    NewGRPCServerConfig(),
-		Metrics:       NewMetricsConfig(),
-		HealthCheck:   NewHealthCheckConfig(),
-		EventServer:   NewEventServerConfig(),
-		Database:      NewDatabaseConfig(),
-		MessageBroker: NewMessageBrokerConfig(),
+		HTTPServer:      NewHTTPServerConfig(),
+		GRPCServer:      NewGRPCServerConfig(),
+		Metrics:         NewMetricsConfig(),
+		HealthCheck:     NewHealthCheckConfig(),
+		EventServer:     NewEventServerConfig(),
+		EventController: NewEventControllerConfig(),
+		Database:        NewDatabaseConfig(),
+		MessageBroker:   NewMessageBrokerConfig(),
+		Admission:       NewAdmissionConfig(),
+		Policy:          NewPolicyConfig(),
+		Audit:           NewAuditConfig(),
 	}
 }
 
@@ -41,8 +49,12 @@ func (c *ApplicationConfig) AddFlags(flagset *pflag.FlagSet) {
 	c.Metrics.AddFlags(flagset)
 	c.HealthCheck.AddFlags(flagset)
 	c.EventServer.AddFlags(flagset)
+	c.EventController.AddFlags(flagset)
 	c.Database.AddFlags(flagset)
 	c.MessageBroker.AddFlags(flagset)
+	c.Admission.AddFlags(flagset)
+	c.Policy.AddFlags(flagset)
+	c.Audit.AddFlags(flagset)
 }
 
 func (c *ApplicationConfig) ReadFiles() []string {
@@ -51,10 +63,15 @@ func (c *ApplicationConfig) ReadFiles() []string {
 		name string
 	}{
 		{c.HTTPServer.ReadFiles, "Server"},
+		{c.GRPCServer.ReadFiles, "GRPCServer"},
 		{c.Database.ReadFiles, "Database"},
 		{c.Metrics.ReadFiles, "Metrics"},
 		{c.HealthCheck.ReadFiles, "HealthCheck"},
 		{c.EventServer.ReadFiles, "EventServer"},
+		{c.EventController.ReadFiles, "EventController"},
+		{c.Admission.ReadFiles, "Admission"},
+		{c.Policy.ReadFiles, "Policy"},
+		{c.Audit.ReadFiles, "Audit"},
 	}
 	messages := []string{}
 	for _, rf := range readFiles {
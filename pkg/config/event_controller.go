@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// EventControllerConfig contains the configuration for the queue of the resource spec event controller and the
+// retention of the spec and status events.
+type EventControllerConfig struct {
+	Workers         int    `json:"event_workers"`
+	FairQueuing     string `json:"event_fair_queuing"`
+	PriorityClasses bool   `json:"event_priority_classes"`
+
+	// EventRetention and StatusEventRetention are how long the spec and status events are kept after they are
+	// created, the hourly partitions of the events are dropped once they are out of the retention windows.
+	EventRetention       time.Duration `json:"event_retention"`
+	StatusEventRetention time.Duration `json:"status_event_retention"`
+}
+
+// NewEventControllerConfig creates a new EventControllerConfig with default settings, the events are handled in
+// order by one worker.
+func NewEventControllerConfig() *EventControllerConfig {
+	return &EventControllerConfig{
+		Workers:         1,
+		FairQueuing:     "none",
+		PriorityClasses: false,
+
+		EventRetention:       time.Hour,
+		StatusEventRetention: time.Hour,
+	}
+}
+
+// AddFlags configures the EventControllerConfig with command line flags.
+//   - "event-workers" specifies the number of the events that are handled concurrently, the events of the same
+//     resource are always handled in order.
+//   - "event-fair-queuing" specifies how the event queue takes turns among the events, "source" takes turns among
+//     the sources of the resources and "consumer" takes turns among the consumers of the resources.
+//   - "event-priority-classes" handles the delete events before the update events, and the update events before
+//     the create events.
+//   - "event-retention" and "status-event-retention" specify how long the spec and status events are kept, the spec
+//     events are kept until they are reconciled even if they are out of the retention window.
+func (c *EventControllerConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.IntVar(&c.Workers, "event-workers", c.Workers, "The number of workers that handle the resource spec events concurrently")
+	fs.StringVar(&c.FairQueuing, "event-fair-queuing", c.FairQueuing, "Sets how the resource spec events are fairly queued, Options: \"none\" (in order), \"source\" (take turns among the sources) or \"consumer\" (take turns among the consumers)")
+	fs.BoolVar(&c.PriorityClasses, "event-priority-classes", c.PriorityClasses, "Handle the resource spec delete events before the update events, and the update events before the create events")
+	fs.DurationVar(&c.EventRetention, "event-retention", c.EventRetention, "How long the reconciled resource spec events are kept")
+	fs.DurationVar(&c.StatusEventRetention, "status-event-retention", c.StatusEventRetention, "How long the resource status events are kept")
+}
+
+func (c *EventControllerConfig) ReadFiles() error {
+	if c.Workers < 1 {
+		return fmt.Errorf("the event workers must be at least 1, but it is %d", c.Workers)
+	}
+	switch c.FairQueuing {
+	case "none", "source", "consumer":
+	default:
+		return fmt.Errorf("the event fair queuing must be none, source or consumer, but it is %q", c.FairQueuing)
+	}
+	if c.EventRetention <= 0 || c.StatusEventRetention <= 0 {
+		return fmt.Errorf("the event retention and status event retention must be positive")
+	}
+	return nil
+}
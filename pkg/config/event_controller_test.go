@@ -0,0 +1,87 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+func TestEventControllerConfig(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   []string
+		want    *EventControllerConfig
+		wantErr bool
+	}{
+		{
+			name:  "default event controller config",
+			input: []string{},
+			want: &EventControllerConfig{
+				Workers:              1,
+				FairQueuing:          "none",
+				PriorityClasses:      false,
+				EventRetention:       time.Hour,
+				StatusEventRetention: time.Hour,
+			},
+		},
+		{
+			name: "custom event controller config",
+			input: []string{
+				"--event-workers=8",
+				"--event-fair-queuing=consumer",
+				"--event-priority-classes",
+				"--event-retention=24h",
+				"--status-event-retention=30m",
+			},
+			want: &EventControllerConfig{
+				Workers:              8,
+				FairQueuing:          "consumer",
+				PriorityClasses:      true,
+				EventRetention:       24 * time.Hour,
+				StatusEventRetention: 30 * time.Minute,
+			},
+		},
+		{
+			name:    "invalid fair queuing",
+			input:   []string{"--event-fair-queuing=random"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid workers",
+			input:   []string{"--event-workers=0"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid retention",
+			input:   []string{"--status-event-retention=0s"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := NewEventControllerConfig()
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			config.AddFlags(fs)
+			if err := fs.Parse(tc.input); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+
+			err := config.ReadFiles()
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("ReadFiles() expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadFiles() returned an error: %v", err)
+			}
+			if !reflect.DeepEqual(config, tc.want) {
+				t.Errorf("NewEventControllerConfig() = %v; want %v", config, tc.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"math"
 	"time"
 
@@ -33,6 +34,13 @@ type GRPCServerConfig struct {
 	PermitPingWithoutStream bool          `json:"permit_ping_without_stream"`
 	HeartbeatCheckInterval  time.Duration `json:"heartbeatCheckInterval"`
 	HeartbeatDisable        bool          `json:"heartbeat_disable"`
+	StatusBookmarkInterval  time.Duration `json:"status_bookmark_interval"`
+
+	// SubscriberQueueSize is the number of the status events that are queued for a subscriber, and
+	// SubscriberOverflowPolicy is how the broadcaster handles a subscriber whose queue is full.
+	SubscriberQueueSize      int    `json:"subscriber_queue_size"`
+	SubscriberOverflowPolicy string `json:"subscriber_overflow_policy"`
+	SubscriberCoalesce       bool   `json:"subscriber_coalesce"`
 }
 
 func NewGRPCServerConfig() *GRPCServerConfig {
@@ -66,4 +74,20 @@ func (s *GRPCServerConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.BrokerClientCAFile, "grpc-broker-client-ca-file", "", "The path to the broker client ca file")
 	fs.DurationVar(&s.HeartbeatCheckInterval, "heartbeat-check-interval", 10*time.Second, "Duration the server send heartbeat messages")
 	fs.BoolVar(&s.HeartbeatDisable, "heartbeat-disable", false, "Disable heartbeat messages from server to clients")
+	fs.DurationVar(&s.StatusBookmarkInterval, "status-bookmark-interval", 30*time.Second, "Duration the server send status bookmark messages to the resumed subscribers, set 0 to only send it after the replay")
+	fs.IntVar(&s.SubscriberQueueSize, "grpc-subscriber-queue-size", 100, "The number of the status events that are queued for a gRPC status subscriber")
+	fs.StringVar(&s.SubscriberOverflowPolicy, "grpc-subscriber-overflow-policy", "block", "Sets how a gRPC status subscriber whose queue is full is handled, Options: \"block\" (wait for the subscriber), \"disconnect\" (close the subscription) or \"drop-and-resync\" (drop the queued status events and replay the missed status changes)")
+	fs.BoolVar(&s.SubscriberCoalesce, "grpc-subscriber-coalesce", false, "Coalesce the queued status events of the same resource into the latest one for a gRPC status subscriber")
+}
+
+func (s *GRPCServerConfig) ReadFiles() error {
+	if s.SubscriberQueueSize < 1 {
+		return fmt.Errorf("the subscriber queue size must be at least 1, but it is %d", s.SubscriberQueueSize)
+	}
+	switch s.SubscriberOverflowPolicy {
+	case "block", "disconnect", "drop-and-resync":
+	default:
+		return fmt.Errorf("the subscriber overflow policy must be block, disconnect or drop-and-resync, but it is %q", s.SubscriberOverflowPolicy)
+	}
+	return nil
 }
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestGRPCServerConfigSubscriber(t *testing.T) {
+	cases := []struct {
+		name         string
+		input        []string
+		wantSize     int
+		wantPolicy   string
+		wantCoalesce bool
+		wantErr      bool
+	}{
+		{
+			name:       "default subscriber config",
+			input:      []string{},
+			wantSize:   100,
+			wantPolicy: "block",
+		},
+		{
+			name: "custom subscriber config",
+			input: []string{
+				"--grpc-subscriber-queue-size=500",
+				"--grpc-subscriber-overflow-policy=drop-and-resync",
+				"--grpc-subscriber-coalesce",
+			},
+			wantSize:     500,
+			wantPolicy:   "drop-and-resync",
+			wantCoalesce: true,
+		},
+		{
+			name:    "invalid overflow policy",
+			input:   []string{"--grpc-subscriber-overflow-policy=drop"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid queue size",
+			input:   []string{"--grpc-subscriber-queue-size=0"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := NewGRPCServerConfig()
+			fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			config.AddFlags(fs)
+			if err := fs.Parse(tc.input); err != nil {
+				t.Fatalf("failed to parse flags: %v", err)
+			}
+
+			err := config.ReadFiles()
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("ReadFiles() expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadFiles() returned an error: %v", err)
+			}
+			if config.SubscriberQueueSize != tc.wantSize ||
+				config.SubscriberOverflowPolicy != tc.wantPolicy ||
+				config.SubscriberCoalesce != tc.wantCoalesce {
+				t.Errorf("subscriber config = (%d, %q, %v); want (%d, %q, %v)",
+					config.SubscriberQueueSize, config.SubscriberOverflowPolicy, config.SubscriberCoalesce,
+					tc.wantSize, tc.wantPolicy, tc.wantCoalesce)
+			}
+		})
+	}
+}
@@ -8,6 +8,11 @@ type HealthCheckConfig struct {
 	BindPort           string `json:"bind_port"`
 	EnableHTTPS        bool   `json:"enable_https"`
 	HeartbeartInterval int    `json:"heartbeat_interval"`
+	// ConsumerHeartbeatInterval is the interval in seconds to save the agent heartbeats observed by the instance.
+	ConsumerHeartbeatInterval int `json:"consumer_heartbeat_interval"`
+	// ConsumerHeartbeatTimeout is the time in seconds after the last heartbeat when an agent is treated as
+	// disconnected.
+	ConsumerHeartbeatTimeout int `json:"consumer_heartbeat_timeout"`
 }
 
 func NewHealthCheckConfig() *HealthCheckConfig {
@@ -15,6 +20,10 @@ func NewHealthCheckConfig() *HealthCheckConfig {
 		BindPort:           "8083",
 		EnableHTTPS:        false,
 		HeartbeartInterval: 15,
+		// the agents are not reporting heartbeats over the message brokers other than gRPC, they are seen
+		// only when they send resync requests or resource statuses.
+		ConsumerHeartbeatInterval: 10,
+		ConsumerHeartbeatTimeout:  300,
 	}
 }
 
@@ -22,6 +31,8 @@ func (c *HealthCheckConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&c.BindPort, "health-check-server-bindport", c.BindPort, "Health check server bind port")
 	fs.BoolVar(&c.EnableHTTPS, "enable-health-check-https", c.EnableHTTPS, "Enable HTTPS for health check server")
 	fs.IntVar(&c.HeartbeartInterval, "heartbeat-interval", c.HeartbeartInterval, "Heartbeat interval for health check server")
+	fs.IntVar(&c.ConsumerHeartbeatInterval, "consumer-heartbeat-interval", c.ConsumerHeartbeatInterval, "Interval in seconds to save the consumer agent heartbeats")
+	fs.IntVar(&c.ConsumerHeartbeatTimeout, "consumer-heartbeat-timeout", c.ConsumerHeartbeatTimeout, "Time in seconds after the last heartbeat when a consumer agent is treated as disconnected")
 }
 
 func (c *HealthCheckConfig) ReadFiles() error {
@@ -14,6 +14,14 @@ type HTTPServerConfig struct {
 	HTTPSCertFile string        `json:"https_cert_file"`
 	HTTPSKeyFile  string        `json:"https_key_file"`
 	EnableHTTPS   bool          `json:"enable_https"`
+
+	AuthNType        string `json:"rest_authn_type"`
+	AuthNKubeConfig  string `json:"rest_authn_kubeconfig"`
+	ClientCAFile     string `json:"rest_client_ca_file"`
+	JWKSURL          string `json:"rest_jwks_url"`
+	JWTUsernameClaim string `json:"rest_jwt_username_claim"`
+	JWTGroupsClaim   string `json:"rest_jwt_groups_claim"`
+	AuthorizerConfig string `json:"rest_authorizer_config"`
 }
 
 func NewHTTPServerConfig() *HTTPServerConfig {
@@ -25,6 +33,10 @@ func NewHTTPServerConfig() *HTTPServerConfig {
 		EnableHTTPS:   false,
 		HTTPSCertFile: "",
 		HTTPSKeyFile:  "",
+
+		AuthNType:        "mock",
+		JWTUsernameClaim: "username",
+		JWTGroupsClaim:   "groups",
 	}
 }
 
@@ -36,6 +48,13 @@ func (s *HTTPServerConfig) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&s.HTTPSCertFile, "https-cert-file", s.HTTPSCertFile, "The path to the tls.crt file.")
 	fs.StringVar(&s.HTTPSKeyFile, "https-key-file", s.HTTPSKeyFile, "The path to the tls.key file.")
 	fs.BoolVar(&s.EnableHTTPS, "enable-https", s.EnableHTTPS, "Enable HTTPS rather than HTTP")
+	fs.StringVar(&s.AuthNType, "rest-authn-type", s.AuthNType, "Specify the REST API authentication type (e.g., mock, token, mtls or jwt)")
+	fs.StringVar(&s.AuthNKubeConfig, "rest-authn-kubeconfig", s.AuthNKubeConfig, "Path to the kubeconfig file used to review the tokens, the in-cluster config is used if it is not specified")
+	fs.StringVar(&s.ClientCAFile, "rest-client-ca-file", s.ClientCAFile, "The path to the client ca file, must specify if using mtls authentication type")
+	fs.StringVar(&s.JWKSURL, "rest-jwks-url", s.JWKSURL, "The URL of the JSON web key set used to verify the tokens, must specify if using jwt authentication type")
+	fs.StringVar(&s.JWTUsernameClaim, "rest-jwt-username-claim", s.JWTUsernameClaim, "The claim of the token used as the user name")
+	fs.StringVar(&s.JWTGroupsClaim, "rest-jwt-groups-claim", s.JWTGroupsClaim, "The claim of the token used as the user groups")
+	fs.StringVar(&s.AuthorizerConfig, "rest-authorizer-config", s.AuthorizerConfig, "Path to the REST API authorization rules file, the callers are not restricted if it is not specified")
 }
 
 func (s *HTTPServerConfig) ReadFiles() error {
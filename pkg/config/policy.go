@@ -0,0 +1,104 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+// PolicyConfig contains the CEL policies that the manifests of the resource bundle writes are validated against.
+type PolicyConfig struct {
+	ConfigFile string `json:"policy_config_file"`
+
+	Policies []ManifestPolicy `json:"policies,omitempty"`
+}
+
+// ManifestPolicy is a set of CEL validations on the manifests, it follows the semantics of the Kubernetes
+// ValidatingAdmissionPolicy. The policy applies to the manifests of the resource bundles that match all of
+// its consumer selector, sources and resource rules.
+type ManifestPolicy struct {
+	Name string `json:"name"`
+	// ConsumerSelector is a label selector of the consumers, the policy applies to all consumers if it is empty.
+	ConsumerSelector string `json:"consumerSelector,omitempty"`
+	// Sources are the source IDs of the resource bundles, the policy applies to all sources if it is empty.
+	Sources []string `json:"sources,omitempty"`
+	// MatchResources are the kinds of the manifests, the policy applies to all manifests if it is empty.
+	MatchResources []PolicyResourceRule `json:"matchResources,omitempty"`
+	Validations    []PolicyValidation   `json:"validations"`
+	// FailurePolicy defines how an expression that cannot be evaluated is handled, Fail rejects the
+	// manifest and Ignore skips the validation. It defaults to Fail.
+	FailurePolicy AdmissionFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// PolicyResourceRule matches the manifests by the API group and kind, "*" matches all.
+type PolicyResourceRule struct {
+	APIGroups []string `json:"apiGroups"`
+	Kinds     []string `json:"kinds"`
+}
+
+// PolicyValidation is a CEL expression that must evaluate to true for the manifest to be admitted. The
+// expression can access the manifest as object, the manifest of the same resource in the current bundle
+// as oldObject and the write as request.
+type PolicyValidation struct {
+	Expression string `json:"expression"`
+	// Message is returned when the validation fails, MessageExpression is a CEL expression that returns
+	// the message instead.
+	Message           string `json:"message,omitempty"`
+	MessageExpression string `json:"messageExpression,omitempty"`
+}
+
+func NewPolicyConfig() *PolicyConfig {
+	return &PolicyConfig{
+		ConfigFile: "",
+	}
+}
+
+func (c *PolicyConfig) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&c.ConfigFile, "policy-config-file", c.ConfigFile, "The config file path of the CEL policies for the manifests of the resource bundle writes")
+}
+
+// ReadFiles loads the policies from the config file, the expressions are compiled when the policies are loaded
+// by the resource service.
+func (c *PolicyConfig) ReadFiles() error {
+	if c.ConfigFile == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(c.ConfigFile)
+	if err != nil {
+		return err
+	}
+
+	config := &PolicyConfig{}
+	if err := yaml.Unmarshal(content, config); err != nil {
+		return fmt.Errorf("failed to parse policy config file %s: %v", c.ConfigFile, err)
+	}
+
+	names := map[string]bool{}
+	for i, policy := range config.Policies {
+		if policy.Name == "" {
+			return fmt.Errorf("the name of policy %d is required", i)
+		}
+		if names[policy.Name] {
+			return fmt.Errorf("the policy %s is duplicated", policy.Name)
+		}
+		names[policy.Name] = true
+
+		if len(policy.Validations) == 0 {
+			return fmt.Errorf("the policy %s has no validations", policy.Name)
+		}
+		switch policy.FailurePolicy {
+		case "":
+			config.Policies[i].FailurePolicy = AdmissionFailurePolicyFail
+		case AdmissionFailurePolicyFail, AdmissionFailurePolicyIgnore:
+		default:
+			return fmt.Errorf("the failure policy of policy %s must be %s or %s",
+				policy.Name, AdmissionFailurePolicyFail, AdmissionFailurePolicyIgnore)
+		}
+	}
+
+	c.Policies = config.Policies
+	return nil
+}
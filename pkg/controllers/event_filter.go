@@ -2,13 +2,19 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 
 	"k8s.io/klog/v2"
 
 	"github.com/openshift-online/maestro/pkg/db"
 )
 
+// ErrEventSkipped is returned by the Filter if the event should not be processed by this instance and it should
+// not be put to the queue again either, e.g. the event is handled by another instance.
+var ErrEventSkipped = errors.New("event is skipped by this instance")
+
 // EventFilter defines an interface for filtering and deferring actions on events.
 // Implementations of EventFilter should provide logic for determining whether an event
 // should be processed and for handling any actions that need to be deferred.
@@ -18,7 +24,8 @@ import (
 //     was processed successfully or not, such as cleanup tasks or releasing resources.
 type EventFilter interface {
 	// Filter determines whether the event should be processed.
-	// Returns true if the event should be handled, false and an error otherwise.
+	// Returns true if the event should be handled, false and an error otherwise. ErrEventSkipped is returned if
+	// the event should be neither handled nor retried by this instance.
 	Filter(ctx context.Context, id string) (bool, error)
 
 	// DeferredAction schedules actions to be executed regardless of event processing success.
@@ -31,7 +38,8 @@ type EventFilter interface {
 // - DeferredAction releases the lock for the event ID.
 type LockBasedEventFilter struct {
 	lockFactory db.LockFactory
-	// locks map is accessed by a single-threaded handler goroutine, no need for lock on it.
+	// locks map is accessed by the concurrent event workers, it is guarded by mu.
+	mu    sync.Mutex
 	locks map[string]string
 }
 
@@ -51,7 +59,9 @@ func (h *LockBasedEventFilter) Filter(ctx context.Context, id string) (bool, err
 	// subsequent events will be locked by their own distinct IDs.
 	lockOwnerID, acquired, err := h.lockFactory.NewNonBlockingLock(ctx, id, db.Events)
 	// store the lock owner ID for deferred action
+	h.mu.Lock()
 	h.locks[id] = lockOwnerID
+	h.mu.Unlock()
 	if err != nil {
 		return false, fmt.Errorf("error obtaining the event lock: %v", err)
 	}
@@ -66,9 +76,13 @@ func (h *LockBasedEventFilter) Filter(ctx context.Context, id string) (bool, err
 
 // DeferredAction releases the lock for the given event ID if it was acquired.
 func (h *LockBasedEventFilter) DeferredAction(ctx context.Context, id string) {
-	if ownerID, exists := h.locks[id]; exists {
+	h.mu.Lock()
+	ownerID, exists := h.locks[id]
+	delete(h.locks, id)
+	h.mu.Unlock()
+
+	if exists {
 		h.lockFactory.Unlock(ctx, ownerID)
-		delete(h.locks, id)
 	}
 }
 
@@ -0,0 +1,297 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// FairQueuing is how the events are grouped into flows, the event queue takes turns among the flows so that
+// the events of a noisy flow cannot delay the events of the others.
+type FairQueuing string
+
+const (
+	// NoFairQueuing puts all the events into one flow, they are handled in order.
+	NoFairQueuing FairQueuing = "none"
+	// SourceFairQueuing groups the events by the source ID of their resources.
+	SourceFairQueuing FairQueuing = "source"
+	// ConsumerFairQueuing groups the events by the consumer of their resources.
+	ConsumerFairQueuing FairQueuing = "consumer"
+)
+
+// EventFlowFunc returns the flow of an event for the fair queuing.
+type EventFlowFunc func(ctx context.Context, event *api.Event) (string, error)
+
+// EventQueueConfig is the configuration of the kind controller event queue.
+type EventQueueConfig struct {
+	// Workers is the number of the events that are handled concurrently, it defaults to 1.
+	Workers int
+	// Flow returns the flow of an event, all the events are in one flow if it is nil.
+	Flow EventFlowFunc
+	// PriorityClasses handles the delete events before the update events, and the update events before the
+	// create events.
+	PriorityClasses bool
+}
+
+// NewResourceEventFlow returns the flow function that groups the resource events by the consumer or the source of
+// their resources, it returns nil for NoFairQueuing.
+func NewResourceEventFlow(fairQueuing FairQueuing, resources services.ResourceService) (EventFlowFunc, error) {
+	switch fairQueuing {
+	case "", NoFairQueuing:
+		return nil, nil
+	case SourceFairQueuing, ConsumerFairQueuing:
+	default:
+		return nil, fmt.Errorf("unsupported fair queuing %q, it must be one of %s, %s or %s",
+			fairQueuing, NoFairQueuing, SourceFairQueuing, ConsumerFairQueuing)
+	}
+
+	return func(ctx context.Context, event *api.Event) (string, error) {
+		// the resource of a delete event is soft deleted, it can still be found
+		resource, svcErr := resources.Get(ctx, event.SourceID)
+		if svcErr != nil {
+			return "", svcErr
+		}
+		if fairQueuing == SourceFairQueuing {
+			return resource.Source, nil
+		}
+		return resource.ConsumerName, nil
+	}, nil
+}
+
+// Priority classes of the events, the lower is handled first. The events are in the default class if the priority
+// classes are disabled or the events cannot be classified.
+const (
+	deletePriorityClass = iota
+	updatePriorityClass
+	createPriorityClass
+	defaultPriorityClass
+	numPriorityClasses
+)
+
+// priorityClassNames are the values of the priority class label of the queue metrics.
+var priorityClassNames = [numPriorityClasses]string{"delete", "update", "create", "default"}
+
+var eventPriorityClasses = map[api.EventType]int{
+	api.DeleteEventType: deletePriorityClass,
+	api.UpdateEventType: updatePriorityClass,
+	api.CreateEventType: createPriorityClass,
+}
+
+// eventClass is the class of an event in the event queue.
+type eventClass struct {
+	priority int
+	flow     string
+	// key is the resource of the event, the events of the same key are handled in order.
+	key string
+}
+
+// fairEventQueue is the storage of the kind controller workqueue. It keeps a queue for each priority class and
+// takes turns among the flows of a priority class.
+//
+// The events of the same resource are handled in the order they are pushed even if they are in different priority
+// classes: an event is only popped after the earlier queued events of its resource, and a worker waits until the
+// earlier popped events of its resource are handled, see acquire and release.
+//
+// The workqueue calls Touch, Push, Len and Pop with its lock held, the other methods are called by the kind
+// controller manager without it.
+type fairEventQueue struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+	// classes are set before the events are added to the workqueue, they are kept until the events leave the
+	// workqueue for good so that the requeued events stay in their classes.
+	classes map[string]eventClass
+	// items are the classes that the queued and the handled events are pushed with, so an event is popped and
+	// released with its pushed class even if its class is set after it is pushed.
+	items map[string]eventClass
+	// handling are the popped events of each key in the pop order.
+	handling map[string][]string
+
+	priorities [numPriorityClasses]*priorityEventQueue
+	// queued are the queued events of each key in the push order.
+	queued map[string][]string
+	// pushed are the times when the queued events are pushed.
+	pushed map[string]time.Time
+	len    int
+}
+
+type priorityEventQueue struct {
+	flows map[string][]string
+	// order is the round robin order of the flows, next is the flow to take first.
+	order []string
+	next  int
+}
+
+var _ workqueue.Queue[string] = &fairEventQueue{}
+
+func newFairEventQueue() *fairEventQueue {
+	q := &fairEventQueue{
+		classes:  map[string]eventClass{},
+		items:    map[string]eventClass{},
+		handling: map[string][]string{},
+		queued:   map[string][]string{},
+		pushed:   map[string]time.Time{},
+	}
+	q.cond = sync.NewCond(&q.mu)
+	for i := range q.priorities {
+		q.priorities[i] = &priorityEventQueue{flows: map[string][]string{}}
+	}
+	return q
+}
+
+// classify sets the class of the event, it is called before the event is added to the workqueue. The class of an
+// event is not changed until it is forgotten.
+func (q *fairEventQueue) classify(id string, class eventClass) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.classes[id]; !ok {
+		q.classes[id] = class
+	}
+}
+
+// classified returns true if the event has a class.
+func (q *fairEventQueue) classified(id string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	_, ok := q.classes[id]
+	return ok
+}
+
+// forget removes the class of the event, it is called once the event leaves the workqueue for good, i.e. it is
+// handled or it is dropped by the workqueue that is shut down.
+func (q *fairEventQueue) forget(id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.classes, id)
+}
+
+func (q *fairEventQueue) class(id string) eventClass {
+	if class, ok := q.classes[id]; ok {
+		return class
+	}
+	return eventClass{priority: defaultPriorityClass, key: id}
+}
+
+// acquire waits until the earlier popped events of the same resource are released.
+func (q *fairEventQueue) acquire(id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	key := q.items[id].key
+	for {
+		ids := q.handling[key]
+		if len(ids) == 0 || ids[0] == id || !containsEvent(ids, id) {
+			return
+		}
+		q.cond.Wait()
+	}
+}
+
+// release lets the next popped event of the same resource be handled.
+func (q *fairEventQueue) release(id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	key := q.items[id].key
+	q.handling[key] = removeEvent(q.handling[key], id)
+	if len(q.handling[key]) == 0 {
+		delete(q.handling, key)
+	}
+	delete(q.items, id)
+	q.cond.Broadcast()
+}
+
+func (q *fairEventQueue) Touch(id string) {}
+
+func (q *fairEventQueue) Push(id string) {
+	q.mu.Lock()
+	class := q.class(id)
+	q.items[id] = class
+	q.mu.Unlock()
+
+	pq := q.priorities[class.priority]
+	if _, ok := pq.flows[class.flow]; !ok {
+		pq.order = append(pq.order, class.flow)
+	}
+	pq.flows[class.flow] = append(pq.flows[class.flow], id)
+	q.queued[class.key] = append(q.queued[class.key], id)
+	q.pushed[id] = time.Now()
+	q.len++
+
+	specEventQueueDepth.WithLabelValues(priorityClassNames[class.priority]).Inc()
+}
+
+func (q *fairEventQueue) Len() int {
+	return q.len
+}
+
+// Pop returns the first event of the highest priority class that is not behind an earlier queued event of the same
+// resource, it takes turns among the flows of the priority class. The earliest queued event is such an event, so
+// Pop always returns an event if the queue is not empty.
+func (q *fairEventQueue) Pop() string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for priority, pq := range q.priorities {
+		for i := 0; i < len(pq.order); i++ {
+			flowIndex := (pq.next + i) % len(pq.order)
+			flow := pq.order[flowIndex]
+			for j, id := range pq.flows[flow] {
+				class := q.items[id]
+				if q.queued[class.key][0] != id {
+					continue
+				}
+
+				pq.flows[flow] = append(pq.flows[flow][:j:j], pq.flows[flow][j+1:]...)
+				if len(pq.flows[flow]) == 0 {
+					delete(pq.flows, flow)
+					pq.order = append(pq.order[:flowIndex:flowIndex], pq.order[flowIndex+1:]...)
+					pq.next = flowIndex
+				} else {
+					pq.next = flowIndex + 1
+				}
+				if len(pq.order) == 0 {
+					pq.next = 0
+				} else {
+					pq.next %= len(pq.order)
+				}
+
+				q.queued[class.key] = q.queued[class.key][1:]
+				if len(q.queued[class.key]) == 0 {
+					delete(q.queued, class.key)
+				}
+				q.handling[class.key] = append(q.handling[class.key], id)
+
+				specEventQueueDepth.WithLabelValues(priorityClassNames[priority]).Dec()
+				specEventQueueWaitDuration.WithLabelValues(priorityClassNames[priority]).Observe(time.Since(q.pushed[id]).Seconds())
+				delete(q.pushed, id)
+				q.len--
+				return id
+			}
+		}
+	}
+
+	// unreachable if the queue is not empty
+	return ""
+}
+
+func containsEvent(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func removeEvent(ids []string, id string) []string {
+	for i, v := range ids {
+		if v == id {
+			return append(ids[:i:i], ids[i+1:]...)
+		}
+	}
+	return ids
+}
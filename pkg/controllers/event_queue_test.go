@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestFairEventQueue(t *testing.T) {
+	cases := []struct {
+		name    string
+		classes map[string]eventClass
+		pushed  []string
+		popped  []string
+	}{
+		{
+			name:   "unclassified events in order",
+			pushed: []string{"1", "2", "3"},
+			popped: []string{"1", "2", "3"},
+		},
+		{
+			name: "priority classes",
+			classes: map[string]eventClass{
+				"create": {priority: createPriorityClass, key: "a"},
+				"update": {priority: updatePriorityClass, key: "b"},
+				"delete": {priority: deletePriorityClass, key: "c"},
+			},
+			pushed: []string{"create", "update", "delete", "unknown"},
+			popped: []string{"delete", "update", "create", "unknown"},
+		},
+		{
+			name: "events of the same resource in order",
+			classes: map[string]eventClass{
+				"create-a": {priority: createPriorityClass, key: "a"},
+				"delete-a": {priority: deletePriorityClass, key: "a"},
+				"update-b": {priority: updatePriorityClass, key: "b"},
+			},
+			pushed: []string{"create-a", "delete-a", "update-b"},
+			popped: []string{"update-b", "create-a", "delete-a"},
+		},
+		{
+			name: "take turns among flows",
+			classes: map[string]eventClass{
+				"noisy-1": {priority: defaultPriorityClass, flow: "noisy", key: "1"},
+				"noisy-2": {priority: defaultPriorityClass, flow: "noisy", key: "2"},
+				"noisy-3": {priority: defaultPriorityClass, flow: "noisy", key: "3"},
+				"quiet-1": {priority: defaultPriorityClass, flow: "quiet", key: "4"},
+				"other-1": {priority: defaultPriorityClass, flow: "other", key: "5"},
+			},
+			pushed: []string{"noisy-1", "noisy-2", "noisy-3", "quiet-1", "other-1"},
+			popped: []string{"noisy-1", "quiet-1", "other-1", "noisy-2", "noisy-3"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			q := newFairEventQueue()
+			for id, class := range c.classes {
+				q.classify(id, class)
+			}
+			for _, id := range c.pushed {
+				q.Push(id)
+			}
+			Expect(q.Len()).To(Equal(len(c.pushed)))
+
+			popped := []string{}
+			for q.Len() > 0 {
+				popped = append(popped, q.Pop())
+			}
+			Expect(popped).To(Equal(c.popped))
+		})
+	}
+}
+
+func TestFairEventQueueWithWorkqueue(t *testing.T) {
+	RegisterTestingT(t)
+
+	fairQueue := newFairEventQueue()
+	fairQueue.classify("create", eventClass{priority: createPriorityClass, key: "a"})
+	fairQueue.classify("update", eventClass{priority: updatePriorityClass, key: "a"})
+	fairQueue.classify("delete", eventClass{priority: deletePriorityClass, key: "b"})
+
+	queue := workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{Queue: fairQueue})
+	defer queue.ShutDown()
+	queue.Add("create")
+	queue.Add("update")
+	queue.Add("delete")
+	// the queued event is not pushed twice
+	queue.Add("create")
+	Expect(queue.Len()).To(Equal(3))
+
+	deleteEvent, _ := queue.Get()
+	Expect(deleteEvent).To(Equal("delete"))
+	createEvent, _ := queue.Get()
+	Expect(createEvent).To(Equal("create"))
+	updateEvent, _ := queue.Get()
+	Expect(updateEvent).To(Equal("update"))
+
+	// the update event waits until the create event of the same resource is released
+	acquired := make(chan struct{})
+	go func() {
+		fairQueue.acquire(updateEvent)
+		close(acquired)
+	}()
+	fairQueue.acquire(createEvent)
+	Consistently(acquired, 100*time.Millisecond).ShouldNot(BeClosed())
+	fairQueue.release(createEvent)
+	Eventually(acquired).Should(BeClosed())
+	fairQueue.release(updateEvent)
+
+	// the event of the other resource is not blocked
+	fairQueue.acquire(deleteEvent)
+	fairQueue.release(deleteEvent)
+}
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+// defaultEventRetentionSyncPeriod is the period to maintain the event partitions, it is much shorter than the
+// one hour partitions so that the next partitions are always created before the events are written to them.
+var defaultEventRetentionSyncPeriod = 10 * time.Minute
+
+// eventPartitionsAhead is how long the partitions are created ahead of the current time.
+const eventPartitionsAhead = 3 * time.Hour
+
+// eventPartitionsLockID is the id of the advisory lock that prevents the instances from maintaining the
+// partitions at the same time.
+const eventPartitionsLockID = "event_partitions"
+
+// EventRetentionController maintains the hourly partitions of the events and status events tables. It creates
+// the partitions ahead and drops the partitions that are out of the retention windows. The partitions of the
+// spec events are kept until all of their events are reconciled, and the partitions of the status events are kept
+// until all of their events are broadcast by the ready instances.
+type EventRetentionController struct {
+	lockFactory          db.LockFactory
+	partitions           dao.EventPartitionDao
+	eventRetention       time.Duration
+	statusEventRetention time.Duration
+}
+
+func NewEventRetentionController(lockFactory db.LockFactory, partitions dao.EventPartitionDao,
+	eventRetention, statusEventRetention time.Duration) *EventRetentionController {
+	return &EventRetentionController{
+		lockFactory:          lockFactory,
+		partitions:           partitions,
+		eventRetention:       eventRetention,
+		statusEventRetention: statusEventRetention,
+	}
+}
+
+func (rc *EventRetentionController) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting event retention controller")
+
+	// use a jitter to avoid multiple instances maintaining the partitions at the same time
+	go wait.JitterUntilWithContext(ctx, rc.sync, defaultEventRetentionSyncPeriod, 0.25, true)
+
+	// wait until we're told to stop
+	<-ctx.Done()
+	logger.Info("Shutting down event retention controller")
+}
+
+func (rc *EventRetentionController) sync(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+
+	// report the age before maintaining the partitions, an old unreconciled event keeps its partition
+	if err := rc.syncUnreconciledEventAge(ctx); err != nil {
+		logger.Error(err, "Failed to find the oldest unreconciled event")
+	}
+
+	lockOwnerID, acquired, err := rc.lockFactory.NewNonBlockingLock(ctx, eventPartitionsLockID, db.EventPartitions)
+	defer rc.lockFactory.Unlock(ctx, lockOwnerID)
+	if err != nil {
+		logger.Error(err, "Failed to obtain the event partitions lock")
+		eventRetentionSyncOperationsTotal.WithLabelValues(string(controllerSyncEventStatusError)).Inc()
+		return
+	}
+	if !acquired {
+		logger.V(4).Info("Event partitions are maintained by another instance")
+		return
+	}
+
+	now := time.Now()
+	retentions := map[string]time.Duration{
+		dao.EventsTable:       rc.eventRetention,
+		dao.StatusEventsTable: rc.statusEventRetention,
+	}
+	// a failed table does not stop the other one from being maintained
+	status := controllerSyncEventStatusSuccess
+	for _, table := range []string{dao.EventsTable, dao.StatusEventsTable} {
+		if err := rc.syncPartitions(ctx, table, now, now.Add(-retentions[table])); err != nil {
+			logger.Error(err, "Failed to maintain the event partitions", "table", table)
+			status = controllerSyncEventStatusError
+		}
+	}
+
+	eventRetentionSyncOperationsTotal.WithLabelValues(string(status)).Inc()
+}
+
+// syncPartitions creates the partitions of the table ahead and drops the partitions that end before the
+// expiry time. The events can only be written to the existing partitions, so a failure to create a partition
+// does not stop the expired partitions from being dropped, and the errors are returned together.
+func (rc *EventRetentionController) syncPartitions(ctx context.Context, table string, now, expiry time.Time) error {
+	logger := klog.FromContext(ctx).WithValues("table", table)
+
+	var errs []error
+	if err := rc.partitions.CreatePartitions(ctx, table, now, now.Add(eventPartitionsAhead)); err != nil {
+		errs = append(errs, err)
+	}
+
+	partitions, err := rc.partitions.FindPartitions(ctx, table)
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+	for _, partition := range partitions {
+		if partition.To.After(expiry) {
+			continue
+		}
+
+		dropped, err := rc.partitions.DropPartition(ctx, partition)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !dropped {
+			logger.Info("Event partition is expired but has pending events, keep it", "partition", partition.Name)
+			continue
+		}
+		logger.Info("Dropped expired event partition", "partition", partition.Name)
+	}
+	return errors.Join(errs...)
+}
+
+func (rc *EventRetentionController) syncUnreconciledEventAge(ctx context.Context) error {
+	oldest, err := rc.partitions.FindOldestUnreconciledEventTime(ctx)
+	if err != nil {
+		return err
+	}
+	if oldest == nil {
+		specEventUnreconciledAge.Set(0)
+		return nil
+	}
+	specEventUnreconciledAge.Set(time.Since(*oldest).Seconds())
+	return nil
+}
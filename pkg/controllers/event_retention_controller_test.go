@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+)
+
+func TestEventRetentionControllerSync(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	now := time.Now()
+	partitions := mocks.NewEventPartitionDaoMock()
+	for _, table := range []string{dao.EventsTable, dao.StatusEventsTable} {
+		Expect(partitions.CreatePartitions(ctx, table, now.Add(-5*time.Hour), now)).To(Succeed())
+	}
+	// the unreconciled event keeps the partition of four hours ago
+	partitions.AddUnreconciledEvent(now.Add(-4 * time.Hour))
+	// the status event that is not broadcast yet keeps the status partition of three hours ago
+	partitions.AddUndeliveredStatusEvent(now.Add(-3 * time.Hour))
+
+	rc := NewEventRetentionController(dbmocks.NewMockAdvisoryLockFactory(), partitions, 2*time.Hour, time.Hour)
+	rc.sync(ctx)
+
+	hours := func(table string) []time.Time {
+		list, err := partitions.FindPartitions(ctx, table)
+		Expect(err).NotTo(HaveOccurred())
+		hours := []time.Time{}
+		for _, partition := range list {
+			hours = append(hours, partition.From)
+		}
+		return hours
+	}
+	hour := func(d time.Duration) time.Time {
+		return now.Add(d).UTC().Truncate(time.Hour)
+	}
+
+	// the partitions are kept for the retentions and created three hours ahead
+	Expect(hours(dao.EventsTable)).To(Equal([]time.Time{
+		hour(-4 * time.Hour), hour(-2 * time.Hour), hour(-time.Hour),
+		hour(0), hour(time.Hour), hour(2 * time.Hour), hour(3 * time.Hour),
+	}))
+	Expect(hours(dao.StatusEventsTable)).To(Equal([]time.Time{
+		hour(-3 * time.Hour), hour(-time.Hour), hour(0), hour(time.Hour), hour(2 * time.Hour), hour(3 * time.Hour),
+	}))
+
+	oldest, err := partitions.FindOldestUnreconciledEventTime(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(*oldest).To(Equal(now.Add(-4 * time.Hour)))
+}
+
+func TestEventRetentionControllerSyncFailedTable(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	now := time.Now()
+	partitions := mocks.NewEventPartitionDaoMock()
+	for _, table := range []string{dao.EventsTable, dao.StatusEventsTable} {
+		Expect(partitions.CreatePartitions(ctx, table, now.Add(-3*time.Hour), now)).To(Succeed())
+	}
+	partitions.FailCreatePartitions(dao.EventsTable, fmt.Errorf("failed to create the partition"))
+
+	rc := NewEventRetentionController(dbmocks.NewMockAdvisoryLockFactory(), partitions, time.Hour, time.Hour)
+	rc.sync(ctx)
+
+	names := func(table string) []string {
+		list, err := partitions.FindPartitions(ctx, table)
+		Expect(err).NotTo(HaveOccurred())
+		names := []string{}
+		for _, partition := range list {
+			names = append(names, partition.Name)
+		}
+		return names
+	}
+	name := func(table string, d time.Duration) string {
+		return fmt.Sprintf("%s_p%s", table, now.Add(d).UTC().Format("2006010215"))
+	}
+
+	// the expired partitions of the events are still dropped when the next partitions are not created
+	Expect(names(dao.EventsTable)).To(Equal([]string{
+		name(dao.EventsTable, -time.Hour), name(dao.EventsTable, 0),
+	}))
+	// the failure of the events table does not stop the status events table from being maintained
+	Expect(names(dao.StatusEventsTable)).To(Equal([]string{
+		name(dao.StatusEventsTable, -time.Hour), name(dao.StatusEventsTable, 0), name(dao.StatusEventsTable, time.Hour),
+		name(dao.StatusEventsTable, 2*time.Hour), name(dao.StatusEventsTable, 3*time.Hour),
+	}))
+}
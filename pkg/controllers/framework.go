@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	e "errors"
 	"fmt"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/errors"
 	"github.com/openshift-online/maestro/pkg/services"
 )
 
@@ -26,7 +28,9 @@ DAOs decorated similarly to the ResourceDAO will persist Events to the database
 A worker attempting to process the Event will first obtain a fail-fast advisory lock. Of many competing workers, only
 one would first successfully obtain the lock. All other workers will *not* wait to obtain the lock.
 
-Any successful processing of an Event will remove it from the Events table permanently.
+Any successful processing of an Event will mark it as reconciled. The Events table is partitioned by the hour of the
+event creation, the partitions are dropped by the event retention controller once all of their Events are reconciled
+and they are out of the retention window.
 
 A periodic process reads from the Events table and calls pg_notify, ensuring any failed Events are re-processed. Competing
 consumers for the lock will fail fast on redundant messages.
@@ -54,9 +58,25 @@ type KindControllerManager struct {
 	eventFilter EventFilter
 	events      services.EventService
 	eventsQueue workqueue.TypedRateLimitingInterface[string]
+	fairQueue   *fairEventQueue
+	queueConfig EventQueueConfig
+	// unclassifiedEvents are the notified events that are classified by the classify workers before they are
+	// added to the events queue, so the notification listener does not wait for the database.
+	unclassifiedEvents workqueue.TypedInterface[string]
 }
 
 func NewKindControllerManager(eventFilter EventFilter, events services.EventService) *KindControllerManager {
+	return NewKindControllerManagerWithConfig(eventFilter, events, EventQueueConfig{Workers: 1})
+}
+
+// NewKindControllerManagerWithConfig creates the kind controller manager whose events are handled by multiple
+// workers, and are ordered by the fair queuing and the priority classes of the queue config.
+func NewKindControllerManagerWithConfig(eventFilter EventFilter, events services.EventService, queueConfig EventQueueConfig) *KindControllerManager {
+	if queueConfig.Workers < 1 {
+		queueConfig.Workers = 1
+	}
+
+	fairQueue := newFairEventQueue()
 	return &KindControllerManager{
 		controllers: map[string]map[api.EventType][]ControllerHandlerFunc{},
 		eventFilter: eventFilter,
@@ -66,8 +86,22 @@ func NewKindControllerManager(eventFilter EventFilter, events services.EventServ
 			workqueue.TypedRateLimitingQueueConfig[string]{
 				Name:            "event-controller",
 				MetricsProvider: prometheusMetricsProvider{},
+				DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[string]{
+					Name:            "event-controller",
+					MetricsProvider: prometheusMetricsProvider{},
+					Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{
+						Name:            "event-controller",
+						MetricsProvider: prometheusMetricsProvider{},
+						Queue:           fairQueue,
+					}),
+				}),
 			},
 		),
+		fairQueue:   fairQueue,
+		queueConfig: queueConfig,
+		unclassifiedEvents: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{
+			Name: "event-classifier",
+		}),
 	}
 }
 
@@ -81,22 +115,79 @@ func (km *KindControllerManager) Add(config *ControllerConfig) {
 	}
 }
 
+// AddEvent adds the notified event to the queue. The event is loaded and classified by a classify worker before
+// it is added if the events are classified, so the notification listener is not blocked by the database.
 func (km *KindControllerManager) AddEvent(id string) {
-	km.eventsQueue.Add(id)
+	if !km.classifiesEvents() {
+		km.eventsQueue.Add(id)
+		return
+	}
+	km.unclassifiedEvents.Add(id)
+}
+
+// classifiesEvents returns false if the events are handled by one worker in order, they are not classified.
+func (km *KindControllerManager) classifiesEvents() bool {
+	return km.queueConfig.Workers > 1 || km.queueConfig.PriorityClasses || km.queueConfig.Flow != nil
+}
+
+// classifyEvent sets the priority class, the flow and the resource of the event before it is added to the queue.
+// The event is loaded if it is not given, it is queued in the default class and flow if it cannot be loaded.
+func (km *KindControllerManager) classifyEvent(ctx context.Context, id string, event *api.Event) {
+	if !km.classifiesEvents() {
+		return
+	}
+	if km.fairQueue.classified(id) {
+		return
+	}
+
+	logger := klog.FromContext(ctx).WithValues(EventID, id)
+	if event == nil {
+		var svcErr *errors.ServiceError
+		event, svcErr = km.events.Get(ctx, id)
+		if svcErr != nil {
+			logger.V(4).Info("Failed to get the event to classify it", "error", svcErr)
+			return
+		}
+	}
+
+	class := eventClass{
+		priority: defaultPriorityClass,
+		key:      fmt.Sprintf("%s/%s", event.Source, event.SourceID),
+	}
+	if priority, ok := eventPriorityClasses[event.EventType]; ok && km.queueConfig.PriorityClasses {
+		class.priority = priority
+	}
+	if km.queueConfig.Flow != nil {
+		flow, err := km.queueConfig.Flow(ctx, event)
+		if err != nil {
+			logger.V(4).Info("Failed to get the flow of the event", "error", err)
+		}
+		class.flow = flow
+	}
+	km.fairQueue.classify(id, class)
 }
 
 func (km *KindControllerManager) Run(ctx context.Context) {
 	logger := klog.FromContext(ctx)
 	logger.Info("Starting event controller")
 	defer km.eventsQueue.ShutDown()
+	defer km.unclassifiedEvents.ShutDown()
 
 	// start a goroutine to sync all events periodically
 	// use a jitter to avoid multiple instances syncing the events at the same time
 	go wait.JitterUntilWithContext(ctx, km.syncEvents, defaultEventsSyncPeriod, 0.25, true)
 
-	// start a goroutine to handle the event from the event queue
+	// start the goroutines to handle the events from the event queue
 	// the .Until will re-kick the runWorker one second after the runWorker completes
-	go wait.UntilWithContext(ctx, km.runWorker, time.Second)
+	logger.Info("Starting event workers", "workers", km.queueConfig.Workers)
+	for i := 0; i < km.queueConfig.Workers; i++ {
+		go wait.UntilWithContext(ctx, km.runWorker, time.Second)
+	}
+	if km.classifiesEvents() {
+		for i := 0; i < km.queueConfig.Workers; i++ {
+			go wait.UntilWithContext(ctx, km.runClassifyWorker, time.Second)
+		}
+	}
 
 	// wait until we're told to stop
 	<-ctx.Done()
@@ -122,6 +213,11 @@ func (km *KindControllerManager) handleEvent(ctx context.Context, id string) (bo
 	// check if the event should be processed by this instance
 	shouldProcess, err := km.eventFilter.Filter(reqContext, id)
 	defer km.eventFilter.DeferredAction(reqContext, id)
+	if e.Is(err, ErrEventSkipped) {
+		// the event is handled by another instance, it is not put to the queue again
+		logger.V(4).Info("Event is skipped by this instance")
+		return true, nil
+	}
 	if err != nil {
 		return false, fmt.Errorf("error filtering event with id (%s): %s", id, err)
 	}
@@ -213,34 +309,52 @@ func (km *KindControllerManager) processNextEvent(ctx context.Context) bool {
 
 	logger := klog.FromContext(ctx).WithValues("key", key)
 
-	if reconciled, err := km.handleEvent(ctx, key); !reconciled {
+	// wait until the earlier events of the same resource are handled by the other workers
+	km.fairQueue.acquire(key)
+	reconciled, err := km.handleEvent(ctx, key)
+	km.fairQueue.release(key)
+
+	if !reconciled {
 		if err != nil {
 			logger.Error(err, "Failed to handle the event")
 		}
 
 		// the event is not reconciled, we requeue it to work on later
 		// this method will add a backoff to avoid hotlooping on particular items
-		km.eventsQueue.AddRateLimited(key)
-		return true
+		// the event is dropped if the queue is shut down, it is added again by the sync of the next run
+		if !km.eventsQueue.ShuttingDown() {
+			km.eventsQueue.AddRateLimited(key)
+			return true
+		}
 	}
 
-	// we handle the event successfully, tell the queue to stop tracking history for this event
+	// the event leaves the queue, tell the queue to stop tracking history for this event
 	km.eventsQueue.Forget(key)
+	km.fairQueue.forget(key)
 	return true
 }
 
-func (km *KindControllerManager) syncEvents(ctx context.Context) {
-	logger := klog.FromContext(ctx)
-	logger.Info("purge all reconciled events")
-	// delete the reconciled events from the database firstly
-	if err := km.events.DeleteAllReconciledEvents(ctx); err != nil {
-		// this process is called periodically, so if the error happened, we will wait for the next cycle to handle
-		// this again
-		logger.Error(err, "Failed to delete reconciled events from db")
-		specControllerSyncEventOperationsTotal.WithLabelValues(string(controllerSyncEventStatusError)).Inc()
-		return
+func (km *KindControllerManager) runClassifyWorker(ctx context.Context) {
+	for km.classifyNextEvent(ctx) {
 	}
+}
+
+// classifyNextEvent classifies the next notified event and adds it to the events queue.
+func (km *KindControllerManager) classifyNextEvent(ctx context.Context) bool {
+	id, quit := km.unclassifiedEvents.Get()
+	if quit {
+		return false
+	}
+	defer km.unclassifiedEvents.Done(id)
 
+	km.classifyEvent(ctx, id, nil)
+	km.eventsQueue.Add(id)
+	return true
+}
+
+func (km *KindControllerManager) syncEvents(ctx context.Context) {
+	// the reconciled events are removed with their partitions by the event retention controller
+	logger := klog.FromContext(ctx)
 	logger.Info("sync all unreconciled events")
 	unreconciledEvents, err := km.events.FindAllUnreconciledEvents(ctx)
 	if err != nil {
@@ -251,6 +365,7 @@ func (km *KindControllerManager) syncEvents(ctx context.Context) {
 
 	// add the unreconciled events back to the controller queue
 	for _, event := range unreconciledEvents {
+		km.classifyEvent(ctx, event.ID, event)
 		km.eventsQueue.Add(event.ID)
 	}
 
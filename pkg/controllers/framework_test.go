@@ -216,3 +216,134 @@ func TestControllerFrameworkWithPredicatedEventFilter(t *testing.T) {
 	Expect(err).To(BeNil())
 	Expect(eve.ReconciledDate).To(BeNil(), "event reconcile date should not be set")
 }
+
+func TestControllerFrameworkWithSkippedEvent(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	eventsDao := mocks.NewEventDao()
+	events := services.NewEventService(eventsDao)
+	// the events are handled by another instance
+	mgr := NewKindControllerManager(NewPredicatedEventFilter(func(ctx context.Context, eventID string) (bool, error) {
+		return false, ErrEventSkipped
+	}), events)
+
+	ctrl := &exampleController{}
+	config := newExampleControllerConfig(ctrl)
+	mgr.Add(config)
+
+	_, err := eventsDao.Create(ctx, &api.Event{
+		Meta:      api.Meta{ID: "1"},
+		Source:    config.Source,
+		SourceID:  uuid.New().String(),
+		EventType: api.CreateEventType,
+	})
+	Expect(err).To(BeNil())
+
+	// the skipped event is not put to the queue again
+	reconciled, err := mgr.handleEvent(ctx, "1")
+	Expect(err).To(BeNil())
+	Expect(reconciled).To(BeTrue())
+	Expect(ctrl.addCounter).To(Equal(0))
+
+	eve, err := eventsDao.Get(ctx, "1")
+	Expect(err).To(BeNil())
+	Expect(eve.ReconciledDate).To(BeNil(), "event reconcile date should not be set")
+}
+
+func TestControllerFrameworkForgetsEventClasses(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	eventsDao := mocks.NewEventDao()
+	events := services.NewEventService(eventsDao)
+	mgr := NewKindControllerManagerWithConfig(NewPredicatedEventFilter(func(ctx context.Context, eventID string) (bool, error) {
+		return true, nil
+	}), events, EventQueueConfig{Workers: 2, PriorityClasses: true})
+
+	ctrl := &exampleController{}
+	config := newExampleControllerConfig(ctrl)
+	mgr.Add(config)
+	// the update events cannot be handled
+	mgr.Add(&ControllerConfig{
+		Source: config.Source,
+		Handlers: map[api.EventType][]ControllerHandlerFunc{
+			api.UpdateEventType: {func(ctx context.Context, id string) error {
+				return fmt.Errorf("failed to handle %s", id)
+			}},
+		},
+	})
+
+	for id, source := range map[string]string{"1": config.Source, "2": "unknown-source"} {
+		_, err := eventsDao.Create(ctx, &api.Event{
+			Meta:      api.Meta{ID: id},
+			Source:    source,
+			SourceID:  uuid.New().String(),
+			EventType: api.CreateEventType,
+		})
+		Expect(err).To(BeNil())
+	}
+
+	// the classes of the handled and the skipped events are removed
+	mgr.syncEvents(ctx)
+	Expect(mgr.fairQueue.classes).To(HaveLen(2))
+	Expect(mgr.processNextEvent(ctx)).To(BeTrue())
+	Expect(mgr.processNextEvent(ctx)).To(BeTrue())
+	Expect(ctrl.addCounter).To(Equal(1))
+	Expect(mgr.fairQueue.classes).To(BeEmpty())
+	Expect(mgr.fairQueue.items).To(BeEmpty())
+	Expect(eventsDao.Delete(ctx, "2")).To(BeNil())
+
+	_, err := eventsDao.Create(ctx, &api.Event{
+		Meta:      api.Meta{ID: "3"},
+		Source:    config.Source,
+		SourceID:  uuid.New().String(),
+		EventType: api.UpdateEventType,
+	})
+	Expect(err).To(BeNil())
+
+	// the class of the failed event is kept while the event is requeued
+	mgr.syncEvents(ctx)
+	Expect(mgr.processNextEvent(ctx)).To(BeTrue())
+	Expect(mgr.fairQueue.classes).To(HaveKey("3"))
+
+	// the class of the failed event is removed once the event is dropped by the queue that is shut down
+	Eventually(mgr.eventsQueue.Len).Should(Equal(1))
+	mgr.eventsQueue.ShutDown()
+	Expect(mgr.processNextEvent(ctx)).To(BeTrue())
+	Expect(mgr.fairQueue.classes).To(BeEmpty())
+	Expect(mgr.fairQueue.items).To(BeEmpty())
+	Expect(mgr.processNextEvent(ctx)).To(BeFalse())
+}
+
+func TestControllerFrameworkClassifiesNotifiedEvents(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := context.Background()
+	eventsDao := mocks.NewEventDao()
+	events := services.NewEventService(eventsDao)
+	mgr := NewKindControllerManagerWithConfig(NewPredicatedEventFilter(func(ctx context.Context, eventID string) (bool, error) {
+		return true, nil
+	}), events, EventQueueConfig{Workers: 2, PriorityClasses: true})
+
+	resID := uuid.New().String()
+	_, err := eventsDao.Create(ctx, &api.Event{
+		Meta:      api.Meta{ID: "1"},
+		Source:    "my-event-source",
+		SourceID:  resID,
+		EventType: api.DeleteEventType,
+	})
+	Expect(err).To(BeNil())
+
+	// the notified event is classified by a classify worker, not by the notification listener
+	mgr.AddEvent("1")
+	Expect(mgr.fairQueue.classified("1")).To(BeFalse())
+	Expect(mgr.eventsQueue.Len()).To(Equal(0))
+
+	Expect(mgr.classifyNextEvent(ctx)).To(BeTrue())
+	Expect(mgr.fairQueue.classes["1"]).To(Equal(eventClass{priority: deletePriorityClass, key: "my-event-source/" + resID}))
+	Expect(mgr.eventsQueue.Len()).To(Equal(1))
+
+	mgr.unclassifiedEvents.ShutDown()
+	Expect(mgr.classifyNextEvent(ctx)).To(BeFalse())
+}
@@ -9,6 +9,7 @@ import (
 const (
 	specControllerMetricsSubsystem   = "spec_controller"
 	statusControllerMetricsSubsystem = "status_controller"
+	retentionControllerSubsystem     = "event_retention_controller"
 	workqueueMetricsSubsystem        = "workqueue"
 )
 
@@ -17,6 +18,10 @@ const (
 	eventReconcileTotalMetric     = "event_reconcile_total"
 	eventReconcileDurationMetric  = "event_reconcile_duration_seconds"
 	eventSyncOperationTotalMetric = "event_sync_operation_total"
+	eventQueueDepthMetric         = "event_queue_depth"
+	eventQueueWaitDurationMetric  = "event_queue_wait_duration_seconds"
+	unreconciledEventAgeMetric    = "unreconciled_event_age_seconds"
+	syncOperationTotalMetric      = "sync_operation_total"
 	DepthMetric                   = "depth"
 	AddsTotalMetric               = "adds_total"
 	QueueDurationMetric           = "queue_duration_seconds"
@@ -30,6 +35,7 @@ const (
 const (
 	controllerMetricsTypeLabel   = "event_type"
 	controllerMetricsStatusLabel = "status"
+	priorityClassLabel           = "priority_class"
 	workqueueNameLabel           = "queue_name"
 )
 
@@ -85,6 +91,50 @@ var (
 		[]string{controllerMetricsStatusLabel},
 	)
 
+	// specEventQueueDepth is a gauge of the current number of events in the
+	// spec controller event queue, labeled by priority class:
+	specEventQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: specControllerMetricsSubsystem,
+			Name:      eventQueueDepthMetric,
+			Help:      "Current number of events in the spec controller event queue",
+		},
+		[]string{priorityClassLabel},
+	)
+
+	// specEventQueueWaitDuration is a histogram of the time events wait in the
+	// spec controller event queue before being handled, labeled by priority class:
+	specEventQueueWaitDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Subsystem: specControllerMetricsSubsystem,
+			Name:      eventQueueWaitDurationMetric,
+			Help:      "Time events wait in the spec controller event queue before being handled",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{priorityClassLabel},
+	)
+
+	// specEventUnreconciledAge is a gauge of the age of the oldest spec event
+	// that is not reconciled, it is 0 if all of the spec events are reconciled:
+	specEventUnreconciledAge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: specControllerMetricsSubsystem,
+			Name:      unreconciledEventAgeMetric,
+			Help:      "Age in seconds of the oldest spec event that is not reconciled",
+		},
+	)
+
+	// eventRetentionSyncOperationsTotal is a counter of the total number of
+	// event partition maintenances performed by the event retention controller, labeled by status:
+	eventRetentionSyncOperationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: retentionControllerSubsystem,
+			Name:      syncOperationTotalMetric,
+			Help:      "Total number of event partition maintenances performed by the event retention controller",
+		},
+		[]string{controllerMetricsStatusLabel},
+	)
+
 	// statusEventReconciledTotal is a counter of the total number of events
 	// reconciled by the status controller, labeled by type and status:
 	statusEventReconciledTotal = prometheus.NewCounterVec(
@@ -214,6 +264,10 @@ func init() {
 	prometheus.MustRegister(specEventReconciledTotal)
 	prometheus.MustRegister(specEventReconcileDuration)
 	prometheus.MustRegister(specControllerSyncEventOperationsTotal)
+	prometheus.MustRegister(specEventQueueDepth)
+	prometheus.MustRegister(specEventQueueWaitDuration)
+	prometheus.MustRegister(specEventUnreconciledAge)
+	prometheus.MustRegister(eventRetentionSyncOperationsTotal)
 	prometheus.MustRegister(statusEventReconciledTotal)
 	prometheus.MustRegister(statusEventReconcileDuration)
 	prometheus.MustRegister(statusControllerSyncEventOperationsTotal)
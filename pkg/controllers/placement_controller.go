@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+const PlacementID ControllerHandlerContextKey = "placement"
+
+// allPlacementsKey is the queue key to reconcile all of the placements.
+const allPlacementsKey = ""
+
+// defaultPlacementsSyncPeriod is the period to reconcile all of the placements, it recreates the resources that
+// were deleted while their consumers were still selected and removes the deleting placements without resources.
+var defaultPlacementsSyncPeriod = time.Minute
+
+// PlacementController reconciles the resources of the placements with the consumers that the placements select.
+type PlacementController struct {
+	placements      services.PlacementService
+	placementsQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+func NewPlacementController(placements services.PlacementService) *PlacementController {
+	return &PlacementController{
+		placements: placements,
+		placementsQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name:            "placement-controller",
+				MetricsProvider: prometheusMetricsProvider{},
+			},
+		),
+	}
+}
+
+// AddPlacement adds a placement to the queue to be reconciled, an empty id adds all of the placements.
+func (pc *PlacementController) AddPlacement(id string) {
+	pc.placementsQueue.Add(id)
+}
+
+func (pc *PlacementController) Run(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	logger.Info("Starting placement controller")
+	defer pc.placementsQueue.ShutDown()
+
+	// use a jitter to avoid multiple instances syncing the placements at the same time
+	go wait.JitterUntilWithContext(ctx, func(ctx context.Context) {
+		pc.AddPlacement(allPlacementsKey)
+	}, defaultPlacementsSyncPeriod, 0.25, true)
+
+	// start a goroutine to reconcile the placements from the queue
+	// the .Until will re-kick the runWorker one second after the runWorker completes
+	go wait.UntilWithContext(ctx, pc.runWorker, time.Second)
+
+	// wait until we're told to stop
+	<-ctx.Done()
+	logger.Info("Shutting down placement controller")
+}
+
+func (pc *PlacementController) runWorker(ctx context.Context) {
+	// hot loop until we're told to stop. processNextPlacement will automatically wait until there's work available,
+	// so we don't worry about secondary waits
+	for pc.processNextPlacement(ctx) {
+	}
+}
+
+// processNextPlacement deals with one key off the queue.
+func (pc *PlacementController) processNextPlacement(ctx context.Context) bool {
+	logger := klog.FromContext(ctx)
+	key, quit := pc.placementsQueue.Get()
+	if quit {
+		// the current queue is shutdown and becomes empty, quit this process
+		return false
+	}
+	defer pc.placementsQueue.Done(key)
+
+	if err := pc.handlePlacement(ctx, key); err != nil {
+		logger.Error(err, "Failed to reconcile the placement", "key", key)
+
+		// we failed to reconcile the placement, we should requeue the item to work on later
+		// this method will add a backoff to avoid hotlooping on particular items
+		pc.placementsQueue.AddRateLimited(key)
+		return true
+	}
+
+	// we reconcile the placement successfully, tell the queue to stop tracking history for this placement
+	pc.placementsQueue.Forget(key)
+	return true
+}
+
+// handlePlacement reconciles the placement with the given id, or adds all of the placements to the queue if the
+// id is empty.
+func (pc *PlacementController) handlePlacement(ctx context.Context, id string) error {
+	if id == allPlacementsKey {
+		placements, svcErr := pc.placements.All(ctx)
+		if svcErr != nil {
+			return fmt.Errorf("error listing placements: %s", svcErr)
+		}
+		for _, placement := range placements {
+			pc.AddPlacement(placement.ID)
+		}
+		return nil
+	}
+
+	logger := klog.FromContext(ctx).WithValues(PlacementID, id)
+	reqContext := context.WithValue(klog.NewContext(ctx, logger), PlacementID, id)
+	if svcErr := pc.placements.Reconcile(reqContext, id); svcErr != nil {
+		return fmt.Errorf("error reconciling placement %s: %s", id, svcErr)
+	}
+	return nil
+}
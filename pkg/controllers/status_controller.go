@@ -10,30 +10,27 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/openshift-online/maestro/pkg/api"
-	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/pkg/services"
 )
 
 const StatusEventID ControllerHandlerContextKey = "status_event"
 
+// defaultStatusRevisionRetention is a default retention period (24 hours) of the status revisions,
+// the status subscribers can resume their subscriptions from a revision within this period.
+var defaultStatusRevisionRetention = 24 * time.Hour
+
 type StatusHandlerFunc func(ctx context.Context, eventID, sourceID string) error
 
 type StatusController struct {
-	controllers      map[api.StatusEventType][]StatusHandlerFunc
-	statusEvents     services.StatusEventService
-	instanceDao      dao.InstanceDao
-	eventInstanceDao dao.EventInstanceDao
-	eventsQueue      workqueue.TypedRateLimitingInterface[string]
+	controllers  map[api.StatusEventType][]StatusHandlerFunc
+	statusEvents services.StatusEventService
+	eventsQueue  workqueue.TypedRateLimitingInterface[string]
 }
 
-func NewStatusController(statusEvents services.StatusEventService,
-	instanceDao dao.InstanceDao,
-	eventInstanceDao dao.EventInstanceDao) *StatusController {
+func NewStatusController(statusEvents services.StatusEventService) *StatusController {
 	return &StatusController{
-		controllers:      map[api.StatusEventType][]StatusHandlerFunc{},
-		statusEvents:     statusEvents,
-		instanceDao:      instanceDao,
-		eventInstanceDao: eventInstanceDao,
+		controllers:  map[api.StatusEventType][]StatusHandlerFunc{},
+		statusEvents: statusEvents,
 		eventsQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			workqueue.DefaultTypedControllerRateLimiter[string](),
 			workqueue.TypedRateLimitingQueueConfig[string]{
@@ -159,45 +156,15 @@ func (sc *StatusController) add(ev api.StatusEventType, fns []StatusHandlerFunc)
 	sc.controllers[ev] = append(sc.controllers[ev], fns...)
 }
 
+// syncStatusEvents purges the status revisions that are out of the retention period, the status events are removed
+// with their partitions by the event retention controller.
 func (sc *StatusController) syncStatusEvents(ctx context.Context) {
 	logger := klog.FromContext(ctx)
-	readyInstanceIDs, err := sc.instanceDao.FindReadyIDs(ctx)
-	if err != nil {
-		logger.Error(err, "Failed to find ready instances from db")
-		statusControllerSyncEventOperationsTotal.WithLabelValues(string(controllerSyncEventStatusError)).Inc()
-		return
-	}
-	logger.V(2).Info("purge status events on the ready instances", "readyInstanceIDs", readyInstanceIDs)
-
-	// find the status events that already were dispatched to all ready instances
-	statusEventIDs, err := sc.eventInstanceDao.GetEventsAssociatedWithInstances(ctx, readyInstanceIDs)
-	if err != nil {
-		logger.Error(err, "Failed to find handled status events from db")
+	if err := sc.statusEvents.DeleteRevisionsBefore(ctx, time.Now().Add(-defaultStatusRevisionRetention)); err != nil {
+		logger.Error(err, "Failed to delete expired status revisions from db")
 		statusControllerSyncEventOperationsTotal.WithLabelValues(string(controllerSyncEventStatusError)).Inc()
 		return
 	}
 
-	// batch delete the handled status events
-	batches := batchStatusEventIDs(statusEventIDs, 500)
-	for _, batch := range batches {
-		if err := sc.statusEvents.DeleteAllEvents(ctx, batch); err != nil {
-			logger.Error(err, "Failed to delete handled status events from db")
-			statusControllerSyncEventOperationsTotal.WithLabelValues(string(controllerSyncEventStatusError)).Inc()
-			return
-		}
-	}
-
 	statusControllerSyncEventOperationsTotal.WithLabelValues(string(controllerSyncEventStatusSuccess)).Inc()
 }
-
-func batchStatusEventIDs(statusEventIDs []string, batchSize int) [][]string {
-	batches := [][]string{}
-	for i := 0; i < len(statusEventIDs); i += batchSize {
-		end := i + batchSize
-		if end > len(statusEventIDs) {
-			end = len(statusEventIDs)
-		}
-		batches = append(batches, statusEventIDs[i:end])
-	}
-	return batches
-}
@@ -0,0 +1,44 @@
+package dao
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+type AuditEventDao interface {
+	Create(ctx context.Context, auditEvent *api.AuditEvent) (*api.AuditEvent, error)
+	FindByTargetID(ctx context.Context, targetID string) (api.AuditEventList, error)
+}
+
+var _ AuditEventDao = &sqlAuditEventDao{}
+
+type sqlAuditEventDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewAuditEventDao(sessionFactory *db.SessionFactory) AuditEventDao {
+	return &sqlAuditEventDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlAuditEventDao) Create(ctx context.Context, auditEvent *api.AuditEvent) (*api.AuditEvent, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(auditEvent).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return auditEvent, nil
+}
+
+// FindByTargetID returns the audit events of the target in the order they were recorded.
+func (d *sqlAuditEventDao) FindByTargetID(ctx context.Context, targetID string) (api.AuditEventList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	auditEvents := api.AuditEventList{}
+	if err := g2.Where("target_id = ?", targetID).Order("created_at").Find(&auditEvents).Error; err != nil {
+		return nil, err
+	}
+	return auditEvents, nil
+}
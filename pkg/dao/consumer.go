@@ -11,6 +11,7 @@ import (
 
 type ConsumerDao interface {
 	Get(ctx context.Context, id string) (*api.Consumer, error)
+	GetByName(ctx context.Context, name string) (*api.Consumer, error)
 	Create(ctx context.Context, consumer *api.Consumer) (*api.Consumer, error)
 	Replace(ctx context.Context, consumer *api.Consumer) (*api.Consumer, error)
 	Delete(ctx context.Context, id string, unscoped bool) error
@@ -38,6 +39,17 @@ func (d *sqlConsumerDao) Get(ctx context.Context, id string) (*api.Consumer, err
 	return &consumer, nil
 }
 
+// GetByName returns the consumer with the given name, including the consumer that is being deleted. The consumer
+// is read with a share lock, so the read waits for a concurrent deletion of the consumer to finish.
+func (d *sqlConsumerDao) GetByName(ctx context.Context, name string) (*api.Consumer, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var consumer api.Consumer
+	if err := g2.Unscoped().Clauses(clause.Locking{Strength: "SHARE"}).Take(&consumer, "name = ?", name).Error; err != nil {
+		return nil, err
+	}
+	return &consumer, nil
+}
+
 func (d *sqlConsumerDao) Create(ctx context.Context, consumer *api.Consumer) (*api.Consumer, error) {
 	g2 := (*d.sessionFactory).New(ctx)
 	if err := g2.Omit(clause.Associations).Create(consumer).Error; err != nil {
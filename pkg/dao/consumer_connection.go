@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+type ConsumerConnectionDao interface {
+	// Create saves the connection, it is a no-op if the connection is already saved.
+	Create(ctx context.Context, connection *api.ConsumerConnection) error
+	Delete(ctx context.Context, consumerName, instanceID string) error
+	// DeleteByInstanceIDs removes the connections of the instances, e.g. the instances that are gone.
+	DeleteByInstanceIDs(ctx context.Context, instanceIDs []string) error
+	FindByConsumerName(ctx context.Context, consumerName string) (api.ConsumerConnectionList, error)
+	All(ctx context.Context) (api.ConsumerConnectionList, error)
+}
+
+var _ ConsumerConnectionDao = &sqlConsumerConnectionDao{}
+
+type sqlConsumerConnectionDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewConsumerConnectionDao(sessionFactory *db.SessionFactory) ConsumerConnectionDao {
+	return &sqlConsumerConnectionDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlConsumerConnectionDao) Create(ctx context.Context, connection *api.ConsumerConnection) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Clauses(clause.OnConflict{DoNothing: true}).Create(connection).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlConsumerConnectionDao) Delete(ctx context.Context, consumerName, instanceID string) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Where("consumer_name = ? AND instance_id = ?", consumerName, instanceID).
+		Delete(&api.ConsumerConnection{}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlConsumerConnectionDao) DeleteByInstanceIDs(ctx context.Context, instanceIDs []string) error {
+	if len(instanceIDs) == 0 {
+		return nil
+	}
+
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Where("instance_id in (?)", instanceIDs).Delete(&api.ConsumerConnection{}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlConsumerConnectionDao) FindByConsumerName(ctx context.Context, consumerName string) (api.ConsumerConnectionList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	connections := api.ConsumerConnectionList{}
+	if err := g2.Where("consumer_name = ?", consumerName).Find(&connections).Error; err != nil {
+		return nil, err
+	}
+	return connections, nil
+}
+
+func (d *sqlConsumerConnectionDao) All(ctx context.Context) (api.ConsumerConnectionList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	connections := api.ConsumerConnectionList{}
+	if err := g2.Find(&connections).Error; err != nil {
+		return nil, err
+	}
+	return connections, nil
+}
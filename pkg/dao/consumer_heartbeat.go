@@ -0,0 +1,70 @@
+package dao
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+type ConsumerHeartbeatDao interface {
+	// Upsert saves the heartbeats, the last status received time and the agent version of a saved heartbeat are
+	// kept if they are empty in the new heartbeat.
+	Upsert(ctx context.Context, heartbeats api.ConsumerHeartbeatList) error
+	FindByConsumerNames(ctx context.Context, consumerNames []string) (api.ConsumerHeartbeatList, error)
+	All(ctx context.Context) (api.ConsumerHeartbeatList, error)
+}
+
+var _ ConsumerHeartbeatDao = &sqlConsumerHeartbeatDao{}
+
+type sqlConsumerHeartbeatDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewConsumerHeartbeatDao(sessionFactory *db.SessionFactory) ConsumerHeartbeatDao {
+	return &sqlConsumerHeartbeatDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlConsumerHeartbeatDao) Upsert(ctx context.Context, heartbeats api.ConsumerHeartbeatList) error {
+	if len(heartbeats) == 0 {
+		return nil
+	}
+
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "consumer_name"}, {Name: "instance_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"connected":      gorm.Expr("EXCLUDED.connected"),
+			"last_heartbeat": gorm.Expr("EXCLUDED.last_heartbeat"),
+			"last_status_received": gorm.Expr(
+				"COALESCE(EXCLUDED.last_status_received, consumer_heartbeats.last_status_received)"),
+			"agent_version": gorm.Expr(
+				"COALESCE(NULLIF(EXCLUDED.agent_version, ''), consumer_heartbeats.agent_version)"),
+		}),
+	}).Create(&heartbeats).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+func (d *sqlConsumerHeartbeatDao) FindByConsumerNames(ctx context.Context, consumerNames []string) (api.ConsumerHeartbeatList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	heartbeats := api.ConsumerHeartbeatList{}
+	if err := g2.Where("consumer_name in (?)", consumerNames).Find(&heartbeats).Error; err != nil {
+		return nil, err
+	}
+	return heartbeats, nil
+}
+
+func (d *sqlConsumerHeartbeatDao) All(ctx context.Context) (api.ConsumerHeartbeatList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	heartbeats := api.ConsumerHeartbeatList{}
+	if err := g2.Find(&heartbeats).Error; err != nil {
+		return nil, err
+	}
+	return heartbeats, nil
+}
@@ -13,13 +13,15 @@ import (
 type EventDao interface {
 	Get(ctx context.Context, id string) (*api.Event, error)
 	Create(ctx context.Context, event *api.Event) (*api.Event, error)
+	CreateBatch(ctx context.Context, events api.EventList) (api.EventList, error)
 	Replace(ctx context.Context, event *api.Event) (*api.Event, error)
 	Delete(ctx context.Context, id string) error
 	FindByIDs(ctx context.Context, ids []string) (api.EventList, error)
 	All(ctx context.Context) (api.EventList, error)
 
-	DeleteAllReconciledEvents(ctx context.Context) error
 	FindAllUnreconciledEvents(ctx context.Context) (api.EventList, error)
+	// NotifyUnreconciledByConsumerName notifies the unreconciled events of the resources on the consumer again.
+	NotifyUnreconciledByConsumerName(ctx context.Context, consumerName string) error
 }
 
 var _ EventDao = &sqlEventDao{}
@@ -58,27 +60,42 @@ func (d *sqlEventDao) Create(ctx context.Context, event *api.Event) (*api.Event,
 	return event, nil
 }
 
-func (d *sqlEventDao) Replace(ctx context.Context, event *api.Event) (*api.Event, error) {
+// CreateBatch inserts the events with a single statement and notifies them with another one.
+func (d *sqlEventDao) CreateBatch(ctx context.Context, events api.EventList) (api.EventList, error) {
+	if len(events) == 0 {
+		return events, nil
+	}
+
 	g2 := (*d.sessionFactory).New(ctx)
-	if err := g2.Omit(clause.Associations).Save(event).Error; err != nil {
+	if err := g2.Omit(clause.Associations).Create(&events).Error; err != nil {
 		db.MarkForRollback(ctx, err)
 		return nil, err
 	}
-	return event, nil
+
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+
+	if err := g2.Exec("select pg_notify('events', id) from events where id in (?)", ids).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
 }
 
-func (d *sqlEventDao) Delete(ctx context.Context, id string) error {
+func (d *sqlEventDao) Replace(ctx context.Context, event *api.Event) (*api.Event, error) {
 	g2 := (*d.sessionFactory).New(ctx)
-	if err := g2.Unscoped().Omit(clause.Associations).Delete(&api.Event{Meta: api.Meta{ID: id}}).Error; err != nil {
+	if err := g2.Omit(clause.Associations).Save(event).Error; err != nil {
 		db.MarkForRollback(ctx, err)
-		return err
+		return nil, err
 	}
-	return nil
+	return event, nil
 }
 
-func (d *sqlEventDao) DeleteAllReconciledEvents(ctx context.Context) error {
+func (d *sqlEventDao) Delete(ctx context.Context, id string) error {
 	g2 := (*d.sessionFactory).New(ctx)
-	if err := g2.Unscoped().Omit(clause.Associations).Where("reconciled_date IS NOT NULL").Delete(&api.Event{}).Error; err != nil {
+	if err := g2.Unscoped().Omit(clause.Associations).Delete(&api.Event{Meta: api.Meta{ID: id}}).Error; err != nil {
 		db.MarkForRollback(ctx, err)
 		return err
 	}
@@ -103,6 +120,16 @@ func (d *sqlEventDao) FindAllUnreconciledEvents(ctx context.Context) (api.EventL
 	return events, nil
 }
 
+func (d *sqlEventDao) NotifyUnreconciledByConsumerName(ctx context.Context, consumerName string) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	// the resources that are being deleted are soft deleted, their delete events are notified too
+	if err := g2.Exec("select pg_notify('events', events.id) from events join resources on resources.id = events.source_id "+
+		"where events.reconciled_date IS NULL and resources.consumer_name = ?", consumerName).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
 func (d *sqlEventDao) All(ctx context.Context) (api.EventList, error) {
 	g2 := (*d.sessionFactory).New(ctx)
 	events := api.EventList{}
@@ -0,0 +1,204 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+// The tables of the events that are partitioned by the hour of their creation time.
+const (
+	EventsTable       = "events"
+	StatusEventsTable = "status_events"
+)
+
+// eventPartitionTimeFormat is the time format of the partition name suffix, e.g. events_p2024010215.
+const eventPartitionTimeFormat = "2006010215"
+
+// EventPartitionDao manages the hourly partitions of the events and status events tables.
+type EventPartitionDao interface {
+	// CreatePartitions creates the partitions of the table that cover the given time range.
+	CreatePartitions(ctx context.Context, table string, from, to time.Time) error
+	// FindPartitions returns the hourly partitions of the table in time order, the partitions that are detached
+	// but not dropped yet are included.
+	FindPartitions(ctx context.Context, table string) (api.EventPartitionList, error)
+	// DropPartition detaches the partition concurrently and drops it together with the event instances of its
+	// events. The partition of the events table is only dropped once all of its events are reconciled, and the
+	// partition of the status events table is only dropped once each of its events is handled or broadcast by all
+	// of the ready instances. It returns whether the partition is dropped.
+	DropPartition(ctx context.Context, partition *api.EventPartition) (bool, error)
+	// FindOldestUnreconciledEventTime returns the creation time of the oldest unreconciled event, nil is returned
+	// if all of the events are reconciled.
+	FindOldestUnreconciledEventTime(ctx context.Context) (*time.Time, error)
+}
+
+var _ EventPartitionDao = &sqlEventPartitionDao{}
+
+type sqlEventPartitionDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewEventPartitionDao(sessionFactory *db.SessionFactory) EventPartitionDao {
+	return &sqlEventPartitionDao{sessionFactory: sessionFactory}
+}
+
+func (d *sqlEventPartitionDao) CreatePartitions(ctx context.Context, table string, from, to time.Time) error {
+	if err := validateEventTable(table); err != nil {
+		return err
+	}
+
+	// a failed hour does not stop the later hours, it is created again by the next call
+	g2 := (*d.sessionFactory).New(ctx)
+	var errs []error
+	for hour := from.UTC().Truncate(time.Hour); hour.Before(to); hour = hour.Add(time.Hour) {
+		name := eventPartitionName(table, hour)
+		if err := g2.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			name, table, hour.Format(time.RFC3339), hour.Add(time.Hour).Format(time.RFC3339))).Error; err != nil {
+			errs = append(errs, fmt.Errorf("failed to create the partition %s: %s", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (d *sqlEventPartitionDao) FindPartitions(ctx context.Context, table string) (api.EventPartitionList, error) {
+	if err := validateEventTable(table); err != nil {
+		return nil, err
+	}
+
+	// the partitions are found by their names instead of pg_inherits, so that a partition that is detached by a
+	// previous DropPartition but not dropped is still found and dropped.
+	g2 := (*d.sessionFactory).New(ctx)
+	var names []string
+	if err := g2.Raw("SELECT c.relname FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace "+
+		"WHERE n.nspname = current_schema() AND c.relkind = 'r' AND starts_with(c.relname, ?) ORDER BY c.relname",
+		table+"_p").Scan(&names).Error; err != nil {
+		return nil, err
+	}
+
+	partitions := api.EventPartitionList{}
+	prefix := table + "_p"
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		from, err := time.Parse(eventPartitionTimeFormat, strings.TrimPrefix(name, prefix))
+		if err != nil {
+			// not a partition created by maestro
+			continue
+		}
+		partitions = append(partitions, &api.EventPartition{
+			Table: table,
+			Name:  name,
+			From:  from,
+			To:    from.Add(time.Hour),
+		})
+	}
+	return partitions, nil
+}
+
+func (d *sqlEventPartitionDao) DropPartition(ctx context.Context, partition *api.EventPartition) (bool, error) {
+	if err := validateEventTable(partition.Table); err != nil {
+		return false, err
+	}
+
+	g2 := (*d.sessionFactory).New(ctx)
+	var pending int64
+	if err := g2.Table(partition.Name).Where(pendingEventsCondition(partition.Table, partition.Name)).
+		Count(&pending).Error; err != nil {
+		return false, err
+	}
+	if pending > 0 {
+		return false, nil
+	}
+
+	if err := d.detachPartition(ctx, partition); err != nil {
+		return false, err
+	}
+
+	err := g2.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf("DELETE FROM event_instances WHERE %s IN (SELECT id FROM %s)",
+			eventInstanceColumn(partition.Table), partition.Name)).Error; err != nil {
+			return err
+		}
+		return tx.Exec(fmt.Sprintf("DROP TABLE IF EXISTS %s", partition.Name)).Error
+	})
+	if err != nil {
+		db.MarkForRollback(ctx, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// detachPartition detaches the partition from its table without blocking the reads and writes of the other
+// partitions. DETACH PARTITION CONCURRENTLY cannot run in a transaction block, and a detach that is interrupted
+// leaves the partition pending, which is completed with DETACH PARTITION FINALIZE.
+func (d *sqlEventPartitionDao) detachPartition(ctx context.Context, partition *api.EventPartition) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	var detachPending []bool
+	if err := g2.Raw("SELECT i.inhdetachpending FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid "+
+		"WHERE c.relname = ?", partition.Name).Scan(&detachPending).Error; err != nil {
+		return err
+	}
+	if len(detachPending) == 0 {
+		// detached already
+		return nil
+	}
+
+	mode := "CONCURRENTLY"
+	if detachPending[0] {
+		mode = "FINALIZE"
+	}
+	return g2.Exec(fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s %s", partition.Table, partition.Name, mode)).Error
+}
+
+func (d *sqlEventPartitionDao) FindOldestUnreconciledEventTime(ctx context.Context) (*time.Time, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var oldest sql.NullTime
+	if err := g2.Model(&api.Event{}).Select("MIN(created_at)").
+		Where("reconciled_date IS NULL").Scan(&oldest).Error; err != nil {
+		return nil, err
+	}
+	if !oldest.Valid {
+		return nil, nil
+	}
+	return &oldest.Time, nil
+}
+
+// pendingEventsCondition returns the condition of the events in the events table that must be kept. A spec event
+// is pending until it is reconciled, and a status event is pending until it is handled or broadcast by all of the
+// ready instances, so the status updates are never lost before they reach the subscribers.
+func pendingEventsCondition(table, events string) string {
+	if table == EventsTable {
+		return "reconciled_date IS NULL"
+	}
+	return fmt.Sprintf("reconciled_date IS NULL AND EXISTS (SELECT 1 FROM server_instances i "+
+		"WHERE i.ready AND i.deleted_at IS NULL AND NOT EXISTS (SELECT 1 FROM event_instances ei "+
+		"WHERE ei.event_id = %s.id AND ei.instance_id = i.id))", events)
+}
+
+func validateEventTable(table string) error {
+	if table != EventsTable && table != StatusEventsTable {
+		return fmt.Errorf("the table %s is not partitioned", table)
+	}
+	return nil
+}
+
+func eventPartitionName(table string, from time.Time) string {
+	return fmt.Sprintf("%s_p%s", table, from.UTC().Format(eventPartitionTimeFormat))
+}
+
+// eventInstanceColumn returns the column of the event instances that refers to the events of the table.
+func eventInstanceColumn(table string) string {
+	if table == EventsTable {
+		return "spec_event_id"
+	}
+	return "event_id"
+}
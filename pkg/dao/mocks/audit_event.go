@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.AuditEventDao = &auditEventDaoMock{}
+
+type auditEventDaoMock struct {
+	auditEvents api.AuditEventList
+}
+
+func NewAuditEventDao() *auditEventDaoMock {
+	return &auditEventDaoMock{}
+}
+
+func (d *auditEventDaoMock) Create(ctx context.Context, auditEvent *api.AuditEvent) (*api.AuditEvent, error) {
+	d.auditEvents = append(d.auditEvents, auditEvent)
+	return auditEvent, nil
+}
+
+func (d *auditEventDaoMock) FindByTargetID(ctx context.Context, targetID string) (api.AuditEventList, error) {
+	auditEvents := api.AuditEventList{}
+	for _, auditEvent := range d.auditEvents {
+		if auditEvent.TargetID == targetID {
+			auditEvents = append(auditEvents, auditEvent)
+		}
+	}
+	return auditEvents, nil
+}
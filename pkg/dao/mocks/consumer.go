@@ -29,6 +29,15 @@ func (d *consumerDaoMock) Get(ctx context.Context, id string) (*api.Consumer, er
 	return nil, gorm.ErrRecordNotFound
 }
 
+func (d *consumerDaoMock) GetByName(ctx context.Context, name string) (*api.Consumer, error) {
+	for _, consumer := range d.consumers {
+		if consumer.Name == name {
+			return consumer, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
 func (d *consumerDaoMock) Create(ctx context.Context, consumer *api.Consumer) (*api.Consumer, error) {
 	d.consumers = append(d.consumers, consumer)
 	return consumer, nil
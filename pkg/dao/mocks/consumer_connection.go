@@ -0,0 +1,87 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.ConsumerConnectionDao = &consumerConnectionDaoMock{}
+
+type consumerConnectionDaoMock struct {
+	mutex       sync.Mutex
+	connections api.ConsumerConnectionList
+}
+
+func NewConsumerConnectionDao() *consumerConnectionDaoMock {
+	return &consumerConnectionDaoMock{}
+}
+
+func (d *consumerConnectionDaoMock) Create(ctx context.Context, connection *api.ConsumerConnection) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for _, c := range d.connections {
+		if c.ConsumerName == connection.ConsumerName && c.InstanceID == connection.InstanceID {
+			return nil
+		}
+	}
+	d.connections = append(d.connections, connection)
+	return nil
+}
+
+func (d *consumerConnectionDaoMock) Delete(ctx context.Context, consumerName, instanceID string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	connections := api.ConsumerConnectionList{}
+	for _, c := range d.connections {
+		if c.ConsumerName != consumerName || c.InstanceID != instanceID {
+			connections = append(connections, c)
+		}
+	}
+	d.connections = connections
+	return nil
+}
+
+func (d *consumerConnectionDaoMock) DeleteByInstanceIDs(ctx context.Context, instanceIDs []string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	connections := api.ConsumerConnectionList{}
+	for _, c := range d.connections {
+		deleted := false
+		for _, id := range instanceIDs {
+			if c.InstanceID == id {
+				deleted = true
+			}
+		}
+		if !deleted {
+			connections = append(connections, c)
+		}
+	}
+	d.connections = connections
+	return nil
+}
+
+func (d *consumerConnectionDaoMock) FindByConsumerName(ctx context.Context, consumerName string) (api.ConsumerConnectionList, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	connections := api.ConsumerConnectionList{}
+	for _, c := range d.connections {
+		if c.ConsumerName == consumerName {
+			connections = append(connections, c)
+		}
+	}
+	return connections, nil
+}
+
+func (d *consumerConnectionDaoMock) All(ctx context.Context) (api.ConsumerConnectionList, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return append(api.ConsumerConnectionList{}, d.connections...), nil
+}
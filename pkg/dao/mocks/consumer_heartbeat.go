@@ -0,0 +1,69 @@
+package mocks
+
+import (
+	"context"
+	"sync"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.ConsumerHeartbeatDao = &consumerHeartbeatDaoMock{}
+
+type consumerHeartbeatDaoMock struct {
+	mutex      sync.Mutex
+	heartbeats api.ConsumerHeartbeatList
+}
+
+func NewConsumerHeartbeatDao() *consumerHeartbeatDaoMock {
+	return &consumerHeartbeatDaoMock{}
+}
+
+func (d *consumerHeartbeatDaoMock) Upsert(ctx context.Context, heartbeats api.ConsumerHeartbeatList) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for _, heartbeat := range heartbeats {
+		saved := *heartbeat
+		found := false
+		for i, h := range d.heartbeats {
+			if h.ConsumerName != heartbeat.ConsumerName || h.InstanceID != heartbeat.InstanceID {
+				continue
+			}
+			if saved.LastStatusReceived == nil {
+				saved.LastStatusReceived = h.LastStatusReceived
+			}
+			if saved.AgentVersion == "" {
+				saved.AgentVersion = h.AgentVersion
+			}
+			d.heartbeats[i] = &saved
+			found = true
+		}
+		if !found {
+			d.heartbeats = append(d.heartbeats, &saved)
+		}
+	}
+	return nil
+}
+
+func (d *consumerHeartbeatDaoMock) FindByConsumerNames(ctx context.Context, consumerNames []string) (api.ConsumerHeartbeatList, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	heartbeats := api.ConsumerHeartbeatList{}
+	for _, heartbeat := range d.heartbeats {
+		for _, name := range consumerNames {
+			if heartbeat.ConsumerName == name {
+				heartbeats = append(heartbeats, heartbeat)
+			}
+		}
+	}
+	return heartbeats, nil
+}
+
+func (d *consumerHeartbeatDaoMock) All(ctx context.Context) (api.ConsumerHeartbeatList, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return append(api.ConsumerHeartbeatList{}, d.heartbeats...), nil
+}
@@ -33,6 +33,11 @@ func (d *eventDaoMock) Create(ctx context.Context, event *api.Event) (*api.Event
 	return event, nil
 }
 
+func (d *eventDaoMock) CreateBatch(ctx context.Context, events api.EventList) (api.EventList, error) {
+	d.events = append(d.events, events...)
+	return events, nil
+}
+
 func (d *eventDaoMock) Replace(ctx context.Context, event *api.Event) (*api.Event, error) {
 	for i, e := range d.events {
 		if e.ID == event.ID {
@@ -73,20 +78,6 @@ func (d *eventDaoMock) All(ctx context.Context) (api.EventList, error) {
 	return d.events, nil
 }
 
-func (d *eventDaoMock) DeleteAllReconciledEvents(ctx context.Context) error {
-	newEvents := api.EventList{}
-	for _, e := range d.events {
-		if e.ReconciledDate != nil {
-			// deleting this one
-			// do not include in the new list
-		} else {
-			newEvents = append(newEvents, e)
-		}
-	}
-	d.events = newEvents
-	return nil
-}
-
 func (d *eventDaoMock) FindAllUnreconciledEvents(ctx context.Context) (api.EventList, error) {
 	filteredEvents := api.EventList{}
 	for _, e := range d.events {
@@ -98,3 +89,8 @@ func (d *eventDaoMock) FindAllUnreconciledEvents(ctx context.Context) (api.Event
 
 	return filteredEvents, nil
 }
+
+func (d *eventDaoMock) NotifyUnreconciledByConsumerName(ctx context.Context, consumerName string) error {
+	// the events are not notified by the mock
+	return nil
+}
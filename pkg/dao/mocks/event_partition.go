@@ -0,0 +1,112 @@
+package mocks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.EventPartitionDao = &eventPartitionDaoMock{}
+
+// eventPartitionDaoMock keeps the hourly partitions of the tables and the pending events of the partitions.
+type eventPartitionDaoMock struct {
+	mux        sync.RWMutex
+	partitions map[string]*api.EventPartition
+	// unreconciled are the creation times of the unreconciled events
+	unreconciled []time.Time
+	// undelivered are the creation times of the status events that are not broadcast by all of the ready instances
+	undelivered []time.Time
+	// createErrors are the errors that are returned when the partitions of the tables are created
+	createErrors map[string]error
+}
+
+func NewEventPartitionDaoMock() *eventPartitionDaoMock {
+	return &eventPartitionDaoMock{partitions: map[string]*api.EventPartition{}, createErrors: map[string]error{}}
+}
+
+// FailCreatePartitions makes the creation of the partitions of the table fail with the given error.
+func (d *eventPartitionDaoMock) FailCreatePartitions(table string, err error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.createErrors[table] = err
+}
+
+// AddUnreconciledEvent adds an unreconciled event that was created at the given time.
+func (d *eventPartitionDaoMock) AddUnreconciledEvent(createdAt time.Time) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.unreconciled = append(d.unreconciled, createdAt)
+}
+
+// AddUndeliveredStatusEvent adds a status event that was created at the given time and is not broadcast by all of
+// the ready instances.
+func (d *eventPartitionDaoMock) AddUndeliveredStatusEvent(createdAt time.Time) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.undelivered = append(d.undelivered, createdAt)
+}
+
+func (d *eventPartitionDaoMock) CreatePartitions(ctx context.Context, table string, from, to time.Time) error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	if err, ok := d.createErrors[table]; ok {
+		return err
+	}
+	for hour := from.UTC().Truncate(time.Hour); hour.Before(to); hour = hour.Add(time.Hour) {
+		name := fmt.Sprintf("%s_p%s", table, hour.Format("2006010215"))
+		if _, ok := d.partitions[name]; !ok {
+			d.partitions[name] = &api.EventPartition{Table: table, Name: name, From: hour, To: hour.Add(time.Hour)}
+		}
+	}
+	return nil
+}
+
+func (d *eventPartitionDaoMock) FindPartitions(ctx context.Context, table string) (api.EventPartitionList, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	partitions := api.EventPartitionList{}
+	for _, partition := range d.partitions {
+		if partition.Table == table {
+			partitions = append(partitions, partition)
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i].From.Before(partitions[j].From) })
+	return partitions, nil
+}
+
+func (d *eventPartitionDaoMock) DropPartition(ctx context.Context, partition *api.EventPartition) (bool, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	pending := d.unreconciled
+	if partition.Table == dao.StatusEventsTable {
+		pending = d.undelivered
+	}
+	for _, createdAt := range pending {
+		if !createdAt.Before(partition.From) && createdAt.Before(partition.To) {
+			return false, nil
+		}
+	}
+	delete(d.partitions, partition.Name)
+	return true, nil
+}
+
+func (d *eventPartitionDaoMock) FindOldestUnreconciledEventTime(ctx context.Context) (*time.Time, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+
+	var oldest *time.Time
+	for _, createdAt := range d.unreconciled {
+		if oldest == nil || createdAt.Before(*oldest) {
+			oldest = &createdAt
+		}
+	}
+	return oldest, nil
+}
@@ -0,0 +1,75 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+)
+
+var _ dao.PlacementDao = &placementDaoMock{}
+
+type placementDaoMock struct {
+	placements    api.PlacementList
+	notifications []string
+}
+
+func NewPlacementDao() *placementDaoMock {
+	return &placementDaoMock{}
+}
+
+func (d *placementDaoMock) Get(ctx context.Context, id string) (*api.Placement, error) {
+	for _, placement := range d.placements {
+		if placement.ID == id {
+			return placement, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *placementDaoMock) Create(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	d.placements = append(d.placements, placement)
+	return placement, nil
+}
+
+func (d *placementDaoMock) Replace(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	for i, p := range d.placements {
+		if p.ID == placement.ID {
+			d.placements[i] = placement
+			return placement, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (d *placementDaoMock) Delete(ctx context.Context, id string, unscoped bool) error {
+	for i, placement := range d.placements {
+		if placement.ID != id {
+			continue
+		}
+		if unscoped {
+			d.placements = append(d.placements[:i], d.placements[i+1:]...)
+			return nil
+		}
+		placement.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		return nil
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (d *placementDaoMock) All(ctx context.Context) (api.PlacementList, error) {
+	return d.placements, nil
+}
+
+func (d *placementDaoMock) Notify(ctx context.Context, id string) error {
+	d.notifications = append(d.notifications, id)
+	return nil
+}
+
+// Notifications returns the ids of the notified placements.
+func (d *placementDaoMock) Notifications() []string {
+	return d.notifications
+}
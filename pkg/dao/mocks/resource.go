@@ -2,6 +2,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -35,7 +36,13 @@ func (d *resourceDaoMock) Create(ctx context.Context, resource *api.Resource) (*
 }
 
 func (d *resourceDaoMock) Update(ctx context.Context, resource *api.Resource) (*api.Resource, error) {
-	return nil, errors.NotImplemented("Resource").AsError()
+	for i, r := range d.resources {
+		if r.ID == resource.ID {
+			d.resources[i] = resource
+			return resource, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
 }
 
 func (d *resourceDaoMock) UpdateStatus(ctx context.Context, resource *api.Resource) (*api.Resource, error) {
@@ -49,7 +56,29 @@ func (d *resourceDaoMock) UpdateStatus(ctx context.Context, resource *api.Resour
 }
 
 func (d *resourceDaoMock) Delete(ctx context.Context, id string, unscoped bool) error {
-	return errors.NotImplemented("Resource").AsError()
+	for i, resource := range d.resources {
+		if resource.ID != id {
+			continue
+		}
+		if unscoped {
+			d.resources = append(d.resources[:i], d.resources[i+1:]...)
+			return nil
+		}
+		resource.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		return nil
+	}
+	return gorm.ErrRecordNotFound
+}
+
+func (d *resourceDaoMock) DeleteByConsumerName(ctx context.Context, consumerName string) error {
+	var resources api.ResourceList
+	for _, resource := range d.resources {
+		if resource.ConsumerName != consumerName {
+			resources = append(resources, resource)
+		}
+	}
+	d.resources = resources
+	return nil
 }
 
 func (d *resourceDaoMock) FindByIDs(ctx context.Context, ids []string) (api.ResourceList, error) {
@@ -66,6 +95,16 @@ func (d *resourceDaoMock) FindByConsumerName(ctx context.Context, consumerID str
 	return resources, nil
 }
 
+func (d *resourceDaoMock) FindByPlacementID(ctx context.Context, placementID string) (api.ResourceList, error) {
+	var resources api.ResourceList
+	for _, resource := range d.resources {
+		if resource.PlacementID == placementID {
+			resources = append(resources, resource)
+		}
+	}
+	return resources, nil
+}
+
 func (d *resourceDaoMock) FindBySource(ctx context.Context, source string) (api.ResourceList, error) {
 	var resources api.ResourceList
 	for _, resource := range d.resources {
@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"context"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+)
+
+// PlacementChannel is the channel that the placement changes are notified on, the payload of the notification
+// is the id of the changed placement, an empty payload means all of the placements should be reconciled.
+const PlacementChannel = "placements"
+
+type PlacementDao interface {
+	Get(ctx context.Context, id string) (*api.Placement, error)
+	Create(ctx context.Context, placement *api.Placement) (*api.Placement, error)
+	Replace(ctx context.Context, placement *api.Placement) (*api.Placement, error)
+	Delete(ctx context.Context, id string, unscoped bool) error
+	All(ctx context.Context) (api.PlacementList, error)
+
+	Notify(ctx context.Context, id string) error
+}
+
+var _ PlacementDao = &sqlPlacementDao{}
+
+type sqlPlacementDao struct {
+	sessionFactory *db.SessionFactory
+}
+
+func NewPlacementDao(sessionFactory *db.SessionFactory) PlacementDao {
+	return &sqlPlacementDao{sessionFactory: sessionFactory}
+}
+
+// Get returns the placement with the given id, including the placement that is being deleted.
+func (d *sqlPlacementDao) Get(ctx context.Context, id string) (*api.Placement, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var placement api.Placement
+	if err := g2.Unscoped().Take(&placement, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &placement, nil
+}
+
+func (d *sqlPlacementDao) Create(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Create(placement).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return placement, nil
+}
+
+func (d *sqlPlacementDao) Replace(ctx context.Context, placement *api.Placement) (*api.Placement, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Omit(clause.Associations).Save(placement).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return nil, err
+	}
+	return placement, nil
+}
+
+func (d *sqlPlacementDao) Delete(ctx context.Context, id string, unscoped bool) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if unscoped {
+		// Unscoped is used to permanently delete the record
+		g2 = g2.Unscoped()
+	}
+	if err := g2.Omit(clause.Associations).Delete(&api.Placement{Meta: api.Meta{ID: id}}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
+// All returns all of the placements, including the placements that are being deleted.
+func (d *sqlPlacementDao) All(ctx context.Context) (api.PlacementList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	placements := api.PlacementList{}
+	if err := g2.Unscoped().Find(&placements).Error; err != nil {
+		return nil, err
+	}
+	return placements, nil
+}
+
+// Notify notifies the change of the placement on the placement channel, the notification is delivered when the
+// transaction in the context is committed.
+func (d *sqlPlacementDao) Notify(ctx context.Context, id string) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Exec("select pg_notify(?, ?)", PlacementChannel, id).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
@@ -15,9 +15,11 @@ type ResourceDao interface {
 	Update(ctx context.Context, resource *api.Resource) (*api.Resource, error)
 	UpdateStatus(ctx context.Context, resource *api.Resource) (*api.Resource, error)
 	Delete(ctx context.Context, id string, unscoped bool) error
+	DeleteByConsumerName(ctx context.Context, consumerName string) error
 	FindByIDs(ctx context.Context, ids []string) (api.ResourceList, error)
 	FindBySource(ctx context.Context, source string) (api.ResourceList, error)
 	FindByConsumerName(ctx context.Context, consumerName string) (api.ResourceList, error)
+	FindByPlacementID(ctx context.Context, placementID string) (api.ResourceList, error)
 	All(ctx context.Context) (api.ResourceList, error)
 	FirstByConsumerName(ctx context.Context, name string, unscoped bool) (api.Resource, error)
 }
@@ -54,10 +56,11 @@ func (d *sqlResourceDao) Update(ctx context.Context, resource *api.Resource) (*a
 	g2 := (*d.sessionFactory).New(ctx)
 	if err := g2.Unscoped().Omit(clause.Associations).
 		Where("id = ?", resource.ID).
-		Select("version", "payload").
+		Select("version", "payload", "managed_fields").
 		Updates(api.Resource{
-			Version: resource.Version,
-			Payload: resource.Payload,
+			Version:       resource.Version,
+			Payload:       resource.Payload,
+			ManagedFields: resource.ManagedFields,
 		}).Error; err != nil {
 		db.MarkForRollback(ctx, err)
 		return nil, err
@@ -92,6 +95,16 @@ func (d *sqlResourceDao) Delete(ctx context.Context, id string, unscoped bool) e
 	return nil
 }
 
+// DeleteByConsumerName permanently deletes all of the resources on the consumer, including the deleting resources.
+func (d *sqlResourceDao) DeleteByConsumerName(ctx context.Context, consumerName string) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Unscoped().Omit(clause.Associations).Where("consumer_name = ?", consumerName).Delete(&api.Resource{}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
+
 func (d *sqlResourceDao) FindByIDs(ctx context.Context, ids []string) (api.ResourceList, error) {
 	g2 := (*d.sessionFactory).New(ctx)
 	resources := api.ResourceList{}
@@ -119,6 +132,16 @@ func (d *sqlResourceDao) FindByConsumerName(ctx context.Context, consumerName st
 	return resources, nil
 }
 
+// FindByPlacementID returns the resources created from the placement, including the deleting resources.
+func (d *sqlResourceDao) FindByPlacementID(ctx context.Context, placementID string) (api.ResourceList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	resources := api.ResourceList{}
+	if err := g2.Unscoped().Where("placement_id = ?", placementID).Find(&resources).Error; err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
 func (d *sqlResourceDao) All(ctx context.Context) (api.ResourceList, error) {
 	g2 := (*d.sessionFactory).New(ctx)
 	resources := api.ResourceList{}
@@ -2,14 +2,27 @@ package dao
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
+	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/db"
 )
 
+const (
+	// statusBookmarkCommitTimeout is how long a status bookmark waits for the transactions that may hold lower
+	// revisions to end, the bookmark is not found if they are still in progress.
+	statusBookmarkCommitTimeout = 10 * time.Second
+	// statusBookmarkPollInterval is the interval to check whether the transactions have ended.
+	statusBookmarkPollInterval = 50 * time.Millisecond
+)
+
 type StatusEventDao interface {
 	Get(ctx context.Context, id string) (*api.StatusEvent, error)
 	Create(ctx context.Context, statusEvent *api.StatusEvent) (*api.StatusEvent, error)
@@ -18,9 +31,12 @@ type StatusEventDao interface {
 	FindByIDs(ctx context.Context, ids []string) (api.StatusEventList, error)
 	All(ctx context.Context) (api.StatusEventList, error)
 
-	DeleteAllReconciledEvents(ctx context.Context) error
-	DeleteAllEvents(ctx context.Context, eventIDs []string) error
 	FindAllUnreconciledEvents(ctx context.Context) (api.StatusEventList, error)
+
+	FindRevisionsBySource(ctx context.Context, source string, sinceRevision int64, limit int) (api.StatusRevisionList, error)
+	FirstRevision(ctx context.Context) (int64, error)
+	FindBookmarkRevision(ctx context.Context, instanceID string) (int64, error)
+	DeleteRevisionsBefore(ctx context.Context, before time.Time) error
 }
 
 var _ StatusEventDao = &sqlStatusEventDao{}
@@ -44,14 +60,37 @@ func (d *sqlStatusEventDao) Get(ctx context.Context, id string) (*api.StatusEven
 
 func (d *sqlStatusEventDao) Create(ctx context.Context, statusEvent *api.StatusEvent) (*api.StatusEvent, error) {
 	g2 := (*d.sessionFactory).New(ctx)
-	if err := g2.Omit(clause.Associations).Create(statusEvent).Error; err != nil {
+	// record the status change in the revision journal together with the status event, so that
+	// the status event carries the revision of the change.
+	err := g2.Transaction(func(tx *gorm.DB) error {
+		// assign the transaction id before the revision is allocated, so that a transaction that holds a revision
+		// is always seen as in progress until it ends, see FindBookmarkRevision.
+		if err := tx.Exec("SELECT pg_current_xact_id()").Error; err != nil {
+			return err
+		}
+
+		revision := &api.StatusRevision{
+			ResourceID:      statusEvent.ResourceID,
+			ResourceSource:  statusEvent.ResourceSource,
+			Payload:         statusEvent.Payload,
+			Status:          statusEvent.Status,
+			StatusEventType: statusEvent.StatusEventType,
+		}
+		if err := tx.Omit(clause.Associations).Create(revision).Error; err != nil {
+			return err
+		}
+
+		statusEvent.Revision = revision.Revision
+		return tx.Omit(clause.Associations).Create(statusEvent).Error
+	})
+	if err != nil {
 		db.MarkForRollback(ctx, err)
 		return nil, err
 	}
 
 	notify := fmt.Sprintf("select pg_notify('%s', '%s')", "status_events", statusEvent.ID)
 
-	err := g2.Exec(notify).Error
+	err = g2.Exec(notify).Error
 	if err != nil {
 		return nil, err
 	}
@@ -86,28 +125,6 @@ func (d *sqlStatusEventDao) FindByIDs(ctx context.Context, ids []string) (api.St
 	return statusEvents, nil
 }
 
-func (d *sqlStatusEventDao) DeleteAllReconciledEvents(ctx context.Context) error {
-	g2 := (*d.sessionFactory).New(ctx)
-	if err := g2.Unscoped().Omit(clause.Associations).Where("reconciled_date IS NOT NULL").Delete(&api.StatusEvent{}).Error; err != nil {
-		db.MarkForRollback(ctx, err)
-		return err
-	}
-	return nil
-}
-
-func (d *sqlStatusEventDao) DeleteAllEvents(ctx context.Context, eventIDs []string) error {
-	if len(eventIDs) == 0 {
-		return nil
-	}
-
-	g2 := (*d.sessionFactory).New(ctx)
-	if err := g2.Unscoped().Omit(clause.Associations).Where("id IN ?", eventIDs).Delete(&api.StatusEvent{}).Error; err != nil {
-		db.MarkForRollback(ctx, err)
-		return err
-	}
-	return nil
-}
-
 func (d *sqlStatusEventDao) FindAllUnreconciledEvents(ctx context.Context) (api.StatusEventList, error) {
 	g2 := (*d.sessionFactory).New(ctx)
 	statusEvents := api.StatusEventList{}
@@ -125,3 +142,105 @@ func (d *sqlStatusEventDao) All(ctx context.Context) (api.StatusEventList, error
 	}
 	return statusEvents, nil
 }
+
+// FindRevisionsBySource returns the status revisions of the given source that are newer than the given
+// revision in the revision order, at most limit revisions are returned.
+func (d *sqlStatusEventDao) FindRevisionsBySource(ctx context.Context, source string, sinceRevision int64, limit int) (api.StatusRevisionList, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	revisions := api.StatusRevisionList{}
+	if err := g2.Where("resource_source = ? AND revision > ?", source, sinceRevision).
+		Order("revision").Limit(limit).Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// FirstRevision returns the oldest retained status revision, 0 is returned if there is no revision.
+func (d *sqlStatusEventDao) FirstRevision(ctx context.Context) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+	var revision sql.NullInt64
+	if err := g2.Model(&api.StatusRevision{}).Select("MIN(revision)").Scan(&revision).Error; err != nil {
+		return 0, err
+	}
+	return revision.Int64, nil
+}
+
+// FindBookmarkRevision returns the revision up to which all of the status changes have been handled
+// by the given instance, it is the revision before the oldest status event that is not handled by the
+// instance yet, or the latest revision if all of the status events are handled.
+//
+// The revisions are allocated from a sequence, so they are committed out of order, a transaction that is in
+// progress may hold a revision that is lower than the committed ones. Such a transaction has its transaction
+// id when the bookmark is found, so the bookmark is only returned once the transactions that were in progress
+// end, and it is held back before the status changes that they committed but are not handled yet.
+func (d *sqlStatusEventDao) FindBookmarkRevision(ctx context.Context, instanceID string) (int64, error) {
+	g2 := (*d.sessionFactory).New(ctx)
+
+	bookmark, err := d.findHandledRevision(g2, instanceID)
+	if err != nil {
+		return 0, err
+	}
+
+	var inProgress []string
+	if err := g2.Raw("SELECT x::text FROM pg_snapshot_xip(pg_current_snapshot()) AS x").Scan(&inProgress).Error; err != nil {
+		return 0, err
+	}
+	if len(inProgress) == 0 {
+		return bookmark, nil
+	}
+
+	xids := "{" + strings.Join(inProgress, ",") + "}"
+	if err := wait.PollUntilContextTimeout(ctx, statusBookmarkPollInterval, statusBookmarkCommitTimeout, true,
+		func(ctx context.Context) (bool, error) {
+			var running int64
+			if err := g2.Raw("SELECT COUNT(*) FROM unnest(CAST(? AS xid8[])) AS x WHERE pg_xact_status(x) = 'in progress'",
+				xids).Scan(&running).Error; err != nil {
+				return false, err
+			}
+			return running == 0, nil
+		}); err != nil {
+		return 0, fmt.Errorf("failed to wait for the in progress transactions: %v", err)
+	}
+
+	handled, err := d.findHandledRevision(g2, instanceID)
+	if err != nil {
+		return 0, err
+	}
+	return min(bookmark, handled), nil
+}
+
+// findHandledRevision returns the revision before the oldest committed status event that is not handled by the
+// instance, or the latest committed revision if all of the status events are handled.
+func (d *sqlStatusEventDao) findHandledRevision(g2 *gorm.DB, instanceID string) (int64, error) {
+	var unhandled sql.NullInt64
+	if err := g2.Model(&api.StatusEvent{}).
+		Select("MIN(revision)").
+		Where("revision > 0 AND NOT EXISTS (?)", g2.Table("event_instances").
+			Select("1").
+			Where("event_instances.event_id = status_events.id AND event_instances.instance_id = ?", instanceID)).
+		Scan(&unhandled).Error; err != nil {
+		return 0, err
+	}
+	if unhandled.Valid {
+		return unhandled.Int64 - 1, nil
+	}
+
+	var latest sql.NullInt64
+	if err := g2.Model(&api.StatusRevision{}).Select("MAX(revision)").Scan(&latest).Error; err != nil {
+		return 0, err
+	}
+	return latest.Int64, nil
+}
+
+// DeleteRevisionsBefore deletes the status revisions created before the given time, the latest revision is
+// always retained so that the subscribers can tell whether their revisions are still resumable.
+func (d *sqlStatusEventDao) DeleteRevisionsBefore(ctx context.Context, before time.Time) error {
+	g2 := (*d.sessionFactory).New(ctx)
+	if err := g2.Where("created_at < ? AND revision < (?)", before,
+		g2.Model(&api.StatusRevision{}).Select("MAX(revision)")).
+		Delete(&api.StatusRevision{}).Error; err != nil {
+		db.MarkForRollback(ctx, err)
+		return err
+	}
+	return nil
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"k8s.io/klog/v2"
+
+	dbContext "github.com/openshift-online/maestro/pkg/db/db_context"
 )
 
 type (
@@ -18,11 +20,13 @@ type (
 )
 
 const (
-	Migrations     LockType = "migrations"
-	Resources      LockType = "resources"
-	ResourceStatus LockType = "resource_status"
-	Events         LockType = "events"
-	Instances      LockType = "instances"
+	Migrations      LockType = "migrations"
+	Resources       LockType = "resources"
+	ResourceStatus  LockType = "resource_status"
+	Events          LockType = "events"
+	Instances       LockType = "instances"
+	Placements      LockType = "placements"
+	EventPartitions LockType = "event_partitions"
 )
 
 // LockFactory provides the blocking/unblocking locks based on PostgreSQL advisory lock.
@@ -198,6 +202,7 @@ func (f *AdvisoryLockFactory) Unlock(ctx context.Context, uuid string) {
 type AdvisoryLock struct {
 	g2        *gorm.DB
 	txid      int64
+	joined    bool
 	uuid      *string
 	id        *string
 	lockType  *LockType
@@ -209,6 +214,23 @@ func newAdvisoryLock(ctx context.Context, connection SessionFactory) (*AdvisoryL
 	// it requires a new DB session to start the advisory lock.
 	g2 := connection.New(ctx)
 
+	// the lock is obtained in the joined transaction of the context, it is held until the transaction
+	// is resolved by its owner, so that the locked rows are not changed by others before they are committed.
+	if tx, ok := dbContext.JoinedTransaction(ctx); ok {
+		if tx.Tx() == nil {
+			return nil, errors.New("AdvisoryLock: the joined transaction is already resolved")
+		}
+		// the lock statement runs on the connection of the joined transaction itself, a lock obtained in a
+		// separate transaction would be released before the writes of the joined transaction are committed.
+		g2.Statement.ConnPool = tx.Tx()
+		return &AdvisoryLock{
+			txid:      tx.TxID(),
+			g2:        g2,
+			joined:    true,
+			startTime: time.Now(),
+		}, nil
+	}
+
 	// start a Tx to ensure gorm will obtain/release the lock using a same connection.
 	tx := g2.Begin()
 	if tx.Error != nil {
@@ -281,8 +303,11 @@ func (l *AdvisoryLock) unlock() error {
 		return errors.New("AdvisoryLock: transaction is missing")
 	}
 
-	// it ends the Tx and implicitly releases the lock.
-	err := l.g2.Commit().Error
+	var err error
+	if !l.joined {
+		// it ends the Tx and implicitly releases the lock.
+		err = l.g2.Commit().Error
+	}
 	l.g2 = nil
 	l.uuid = nil
 	l.id = nil
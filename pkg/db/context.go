@@ -22,6 +22,39 @@ func NewContext(ctx context.Context, connection SessionFactory) (context.Context
 	return ctx, nil
 }
 
+// JoinTransaction returns a new context whose database sessions join the transaction stored in the context, so
+// that their writes are committed or rolled back together when the transaction is resolved.
+func JoinTransaction(ctx context.Context) (context.Context, error) {
+	tx, ok := dbContext.Transaction(ctx)
+	if !ok || tx == nil || tx.Tx() == nil {
+		return ctx, errors.New("could not retrieve transaction from context")
+	}
+
+	return dbContext.WithJoinedTransaction(ctx), nil
+}
+
+// AfterCommit calls the function once the writes of the context are committed. If the database sessions of the
+// context join the transaction stored in it, the function is called when the transaction is committed and it is
+// never called if the transaction is rolled back, otherwise the writes are already committed and it is called
+// immediately.
+func AfterCommit(ctx context.Context, fn func()) {
+	if tx, ok := dbContext.JoinedTransaction(ctx); ok && tx != nil && tx.Tx() != nil {
+		tx.OnCommit(fn)
+		return
+	}
+	fn()
+}
+
+// Savepoint starts a savepoint in the transaction that the database sessions of the context join. The returned
+// function rolls the writes of the context back to the savepoint without rolling back the whole transaction. If the
+// database sessions do not join a transaction, their writes are already committed and the function does nothing.
+func Savepoint(ctx context.Context, name string) (func() error, error) {
+	if tx, ok := dbContext.JoinedTransaction(ctx); ok && tx != nil && tx.Tx() != nil {
+		return tx.Savepoint(name)
+	}
+	return func() error { return nil }, nil
+}
+
 // Resolve resolves the current transaction according to the rollback flag.
 func Resolve(ctx context.Context) {
 	logger := klog.FromContext(ctx)
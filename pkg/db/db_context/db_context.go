@@ -12,6 +12,7 @@ type contextKey int
 
 const (
 	transactionKey contextKey = iota
+	joinTransactionKey
 )
 
 // WithTransaction adds the transaction to the context and returns a new context
@@ -25,6 +26,21 @@ func Transaction(ctx context.Context) (tx *transaction.Transaction, ok bool) {
 	return tx, ok
 }
 
+// WithJoinedTransaction marks the context, so that the database sessions created with it join the transaction
+// stored in the context instead of committing their statements separately.
+func WithJoinedTransaction(ctx context.Context) context.Context {
+	return context.WithValue(ctx, joinTransactionKey, true)
+}
+
+// JoinedTransaction extracts the transaction value from the context if the database sessions created with the
+// context must join it.
+func JoinedTransaction(ctx context.Context) (tx *transaction.Transaction, ok bool) {
+	if joined, _ := ctx.Value(joinTransactionKey).(bool); !joined {
+		return nil, false
+	}
+	return Transaction(ctx)
+}
+
 // Return the transaction ID from the context, if it exists. If there is no transaction, ok is false.
 func TxID(ctx context.Context) (id int64, ok bool) {
 	tx, ok := Transaction(ctx)
@@ -20,6 +20,7 @@ import (
 	"github.com/openshift-online/maestro/pkg/config"
 	"github.com/openshift-online/maestro/pkg/constants"
 	"github.com/openshift-online/maestro/pkg/db"
+	dbContext "github.com/openshift-online/maestro/pkg/db/db_context"
 )
 
 type Default struct {
@@ -210,6 +211,10 @@ func (f *Default) New(ctx context.Context) *gorm.DB {
 		Context: ctx,
 		Logger:  f.g2.Logger.LogMode(gormlogger.Silent),
 	})
+	if tx, ok := dbContext.JoinedTransaction(ctx); ok && tx.Tx() != nil {
+		// run the statements in the transaction of the context, it is committed or rolled back by its owner.
+		conn.Statement.ConnPool = tx.Tx()
+	}
 	if f.config.Debug {
 		conn = conn.Debug()
 	}
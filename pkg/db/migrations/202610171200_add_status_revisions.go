@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addStatusRevisions() *gormigrate.Migration {
+	type StatusRevision struct {
+		Revision        int64          `gorm:"primaryKey;autoIncrement"`
+		ResourceID      string         // resource id
+		ResourceSource  string         `gorm:"index"`
+		Payload         datatypes.JSON `gorm:"type:json"`
+		Status          datatypes.JSON `gorm:"type:json"`
+		StatusEventType string         // Update|Delete, any string
+		CreatedAt       time.Time      `gorm:"index"`
+	}
+
+	type StatusEvent struct {
+		Revision int64 `gorm:"default:0"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610171200",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&StatusRevision{}); err != nil {
+				return err
+			}
+			return tx.AutoMigrate(&StatusEvent{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&StatusEvent{}, "revision"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&StatusRevision{})
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addPlacements() *gormigrate.Migration {
+	type Placement struct {
+		Model
+		Name             string `gorm:"uniqueIndex;not null"`
+		ConsumerSelector string
+		Payload          datatypes.JSON `gorm:"type:json"`
+	}
+
+	type Resource struct {
+		PlacementID string `gorm:"index"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610171300",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&Placement{}); err != nil {
+				return err
+			}
+			return tx.AutoMigrate(&Resource{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&Resource{}, "placement_id"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable(&Placement{})
+		},
+	}
+}
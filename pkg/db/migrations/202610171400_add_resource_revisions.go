@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addResourceRevisions() *gormigrate.Migration {
+	type ResourceRevision struct {
+		Model
+		ResourceID string `gorm:"uniqueIndex:idx_resource_revisions_resource_version;not null"`
+		Version    int    `gorm:"uniqueIndex:idx_resource_revisions_resource_version;not null"`
+		Source     string
+		Author     string
+		// Payload is the resource spec of the revision with CloudEvent format (JSON representation).
+		Payload datatypes.JSON `gorm:"type:json"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610171400",
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.AutoMigrate(&ResourceRevision{}); err != nil {
+				return err
+			}
+
+			// the revisions are removed with their resource
+			return CreateFK(tx, fkMigration{
+				"resource_revisions", "resources", "resource_id", "resources(id)", "ON DELETE CASCADE ON UPDATE RESTRICT",
+			})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&ResourceRevision{})
+		},
+	}
+}
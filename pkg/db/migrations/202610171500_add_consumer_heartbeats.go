@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addConsumerHeartbeats() *gormigrate.Migration {
+	type ConsumerHeartbeat struct {
+		ConsumerName       string `gorm:"primaryKey"`
+		InstanceID         string `gorm:"primaryKey"`
+		Connected          bool   `gorm:"default:false"`
+		LastHeartbeat      time.Time
+		LastStatusReceived *time.Time
+		AgentVersion       string
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610171500",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&ConsumerHeartbeat{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&ConsumerHeartbeat{})
+		},
+	}
+}
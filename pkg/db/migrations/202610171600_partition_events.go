@@ -0,0 +1,197 @@
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// partitionEvents moves the events and status_events tables to tables that are partitioned by the hour of their
+// created_at, so that the expired events are removed by dropping their partitions instead of deleting the rows.
+// The primary key of a partitioned table must include the partition key, so the events are keyed by
+// (id, created_at) and the foreign keys of the event_instances to the events are removed, the event instances
+// are removed together with the partitions of their events.
+//
+// The migration is not backwards compatible and requires a downtime upgrade: all of the maestro servers must be
+// stopped before it runs. The tables are renamed and copied in one transaction that locks them until it commits,
+// and the servers of the previous release write the events with the old primary key and rely on the foreign keys
+// of the event instances, which are removed. The servers of the new release are started once it completes.
+func partitionEvents() *gormigrate.Migration {
+	type eventTable struct {
+		name    string
+		indexes map[string]string
+		// fk is the foreign key of the event_instances to the table
+		fk fkMigration
+	}
+
+	tables := []eventTable{
+		{
+			name: "events",
+			indexes: map[string]string{
+				"idx_events_source":          "source",
+				"idx_events_source_id":       "source_id",
+				"idx_events_reconciled_date": "reconciled_date",
+				"idx_events_deleted_at":      "deleted_at",
+			},
+			fk: fkMigration{
+				"event_instances", "events", "spec_event_id", "events(id)", "ON DELETE CASCADE",
+			},
+		},
+		{
+			name: "status_events",
+			indexes: map[string]string{
+				"idx_status_events_resource_id":     "resource_id",
+				"idx_status_events_reconciled_date": "reconciled_date",
+				"idx_status_events_deleted_at":      "deleted_at",
+			},
+			fk: fkMigration{
+				"event_instances", "status_events", "event_id", "status_events(id)", "ON DELETE CASCADE",
+			},
+		},
+	}
+
+	// the partitions are created ahead for the next hours, the server creates the later ones
+	const partitionsAhead = 3
+
+	createPartition := func(tx *gorm.DB, table string, from time.Time) error {
+		from = from.UTC().Truncate(time.Hour)
+		return tx.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s_p%s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			table, from.Format("2006010215"), table,
+			from.Format(time.RFC3339), from.Add(time.Hour).Format(time.RFC3339))).Error
+	}
+
+	// copyTable renames the table to <table>_old and creates the table from it with the given statement. The legacy
+	// rows without created_at are backfilled first, so that they can be placed in the partitions.
+	copyTable := func(tx *gorm.DB, table, create string) error {
+		old := table + "_old"
+		if err := tx.Exec(fmt.Sprintf("ALTER TABLE %s RENAME TO %s", table, old)).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(fmt.Sprintf("UPDATE %s SET created_at = COALESCE(updated_at, now()) WHERE created_at IS NULL",
+			old)).Error; err != nil {
+			return err
+		}
+		return tx.Exec(fmt.Sprintf(create, table, old)).Error
+	}
+
+	// moveRows moves the rows of <table>_old to the table and drops <table>_old, created_at is only required once
+	// the rows are moved.
+	moveRows := func(tx *gorm.DB, table string) error {
+		old := table + "_old"
+		if err := tx.Exec(fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", table, old)).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ALTER COLUMN created_at SET NOT NULL", table)).Error; err != nil {
+			return err
+		}
+		return tx.Exec(fmt.Sprintf("DROP TABLE %s", old)).Error
+	}
+
+	createIndexes := func(tx *gorm.DB, table eventTable) error {
+		for name, column := range table.indexes {
+			if err := tx.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s)", name, table.name, column)).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610171600",
+		Migrate: func(g2 *gorm.DB) error {
+			return g2.Transaction(func(tx *gorm.DB) error {
+				for _, table := range tables {
+					if err := tx.Exec(fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s",
+						table.fk.Model, fkName(table.fk.Model, table.fk.Dest))).Error; err != nil {
+						return err
+					}
+
+					if err := copyTable(tx, table.name,
+						"CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS) PARTITION BY RANGE (created_at)"); err != nil {
+						return err
+					}
+
+					// there is no default partition, the partitions are detached concurrently before they are dropped,
+					// which postgres refuses for a table with a default partition. The partitions of all of the
+					// existing events are created, and the server creates the partitions ahead of the later events.
+					hours, err := eventHours(tx, table.name+"_old")
+					if err != nil {
+						return err
+					}
+					now := time.Now()
+					for i := 0; i <= partitionsAhead; i++ {
+						hours = append(hours, now.Add(time.Duration(i)*time.Hour))
+					}
+					for _, hour := range hours {
+						if err := createPartition(tx, table.name, hour); err != nil {
+							return err
+						}
+					}
+
+					if err := moveRows(tx, table.name); err != nil {
+						return err
+					}
+
+					if err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (id, created_at)", table.name)).Error; err != nil {
+						return err
+					}
+					if err := createIndexes(tx, table); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+		Rollback: func(g2 *gorm.DB) error {
+			return g2.Transaction(func(tx *gorm.DB) error {
+				for _, table := range tables {
+					if err := copyTable(tx, table.name, "CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS)"); err != nil {
+						return err
+					}
+					// the partitions are dropped with the partitioned table
+					if err := moveRows(tx, table.name); err != nil {
+						return err
+					}
+
+					if err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (id)", table.name)).Error; err != nil {
+						return err
+					}
+					if err := createIndexes(tx, table); err != nil {
+						return err
+					}
+
+					// remove the event instances of the events that were dropped with their partitions
+					if err := tx.Exec(fmt.Sprintf("DELETE FROM event_instances WHERE %s IS NOT NULL AND %s <> '' AND %s NOT IN (SELECT id FROM %s)",
+						table.fk.Field, table.fk.Field, table.fk.Field, table.name)).Error; err != nil {
+						return err
+					}
+					if err := CreateFK(tx, table.fk); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		},
+	}
+}
+
+// eventHours returns the hours in UTC that the events of the table were created in.
+func eventHours(tx *gorm.DB, table string) ([]time.Time, error) {
+	rows, err := tx.Raw(fmt.Sprintf("SELECT DISTINCT date_trunc('hour', created_at, 'UTC') FROM %s", table)).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hours := []time.Time{}
+	for rows.Next() {
+		var hour time.Time
+		if err := rows.Scan(&hour); err != nil {
+			return nil, err
+		}
+		hours = append(hours, hour)
+	}
+	return hours, rows.Err()
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addAuditEvents() *gormigrate.Migration {
+	type AuditEvent struct {
+		Model
+		Actor         string `gorm:"index"`
+		Source        string
+		Action        string
+		TargetType    string
+		TargetID      string `gorm:"index"`
+		VersionBefore int32
+		VersionAfter  int32
+		RequestID     string
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610171700",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&AuditEvent{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&AuditEvent{})
+		},
+	}
+}
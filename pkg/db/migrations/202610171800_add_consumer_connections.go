@@ -0,0 +1,26 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+func addConsumerConnections() *gormigrate.Migration {
+	type ConsumerConnection struct {
+		ConsumerName string `gorm:"primaryKey"`
+		InstanceID   string `gorm:"primaryKey;index"`
+		CreatedAt    time.Time
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610171800",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&ConsumerConnection{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&ConsumerConnection{})
+		},
+	}
+}
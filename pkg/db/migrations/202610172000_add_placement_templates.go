@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+func addPlacementTemplates() *gormigrate.Migration {
+	type Placement struct {
+		TemplateEngine string
+		// Values maps the consumer names to the parameters of the template (JSON representation).
+		Values datatypes.JSON `gorm:"type:json"`
+	}
+
+	return &gormigrate.Migration{
+		ID: "202610172000",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&Placement{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn(&Placement{}, "values"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&Placement{}, "template_engine")
+		},
+	}
+}
@@ -35,6 +35,15 @@ var MigrationList = []*gormigrate.Migration{
 	addEventInstances(),
 	addLastHeartBeatAndReadyColumnInServerInstancesTable(),
 	alterEventInstances(),
+	addStatusRevisions(),
+	addPlacements(),
+	addResourceRevisions(),
+	addConsumerHeartbeats(),
+	partitionEvents(),
+	addAuditEvents(),
+	addConsumerConnections(),
+	addResourceManagedFields(),
+	addPlacementTemplates(),
 }
 
 // CleanUpDirtyData clean up the dirty data before migrating the tables.
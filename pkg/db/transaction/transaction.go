@@ -14,6 +14,8 @@ type Transaction struct {
 	rollbackFlag bool
 	tx           *sql.Tx
 	txid         int64
+	// onCommit are called once the transaction is committed, they are dropped if it is rolled back.
+	onCommit []func()
 }
 
 // Build Creates a new transaction object
@@ -48,7 +50,15 @@ func (tx *Transaction) Commit() error {
 	// do *not* call commit on the underlying transaction itself. Gorm does that.
 	err := tx.tx.Commit()
 	tx.tx = nil
-	return err
+	if err != nil {
+		return err
+	}
+
+	for _, fn := range tx.onCommit {
+		fn()
+	}
+	tx.onCommit = nil
+	return nil
 }
 
 // rollback ends the transaction by rolling back
@@ -59,9 +69,40 @@ func (tx *Transaction) Rollback() error {
 	}
 	err := tx.tx.Rollback()
 	tx.tx = nil
+	tx.onCommit = nil
 	return err
 }
 
+// Savepoint starts a savepoint with the given name. The returned function rolls the transaction back to the
+// savepoint, the writes, the commit callbacks and the rollback flag set after the savepoint are dropped.
+func (tx *Transaction) Savepoint(name string) (func() error, error) {
+	if tx.tx == nil {
+		return nil, errors.New("db: transaction hasn't been started yet")
+	}
+	if _, err := tx.tx.Exec("SAVEPOINT " + name); err != nil {
+		return nil, err
+	}
+
+	onCommit := len(tx.onCommit)
+	rollbackFlag := tx.rollbackFlag
+	return func() error {
+		if tx.tx == nil {
+			return errors.New("db: transaction hasn't been started yet")
+		}
+		if _, err := tx.tx.Exec("ROLLBACK TO SAVEPOINT " + name); err != nil {
+			return err
+		}
+		tx.onCommit = tx.onCommit[:onCommit]
+		tx.rollbackFlag = rollbackFlag
+		return nil
+	}, nil
+}
+
+// OnCommit registers a function to call once the transaction is committed.
+func (tx *Transaction) OnCommit(fn func()) {
+	tx.onCommit = append(tx.onCommit, fn)
+}
+
 func (tx *Transaction) SetRollbackFlag(flag bool) {
 	tx.rollbackFlag = flag
 }
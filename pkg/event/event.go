@@ -2,6 +2,7 @@ package event
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/google/uuid"
@@ -13,13 +14,52 @@ import (
 // resourceHandler is a function that can handle resource status change events.
 type resourceHandler func(res *api.Resource) error
 
+// ResourceFilter is a function that tells whether a client receives the status change events of the resource.
+type ResourceFilter func(res *api.Resource) bool
+
+// resyncHandler is a function that replays the status changes after the given revision to the client and returns
+// the latest replayed revision, it is called once the queued status change events of the client are dropped.
+type resyncHandler func(sinceRevision int64) (int64, error)
+
+// bookmarkHandler is a function that sends a status bookmark of the given revision to the client.
+type bookmarkHandler func(revision int64) error
+
+// statusBookmark is a status bookmark of a client that is waiting to be queued.
+type statusBookmark struct {
+	clientID string
+	revision int64
+}
+
+// ErrQueueOverflow is sent to the client that is disconnected because its queue is full.
+var ErrQueueOverflow = errors.New("the status event queue of the client is full")
+
 // eventClient is a client that can receive and handle resource status change events.
 type eventClient struct {
-	source  string
-	handler resourceHandler
+	source string
+	// filter selects the resources of the client regardless of their source, it is only set for the observers.
+	filter   ResourceFilter
+	handler  resourceHandler
+	resync   resyncHandler
+	bookmark bookmarkHandler
+	// sinceRevision is the status revision that the client has received the status changes up to when it is
+	// registered, the dropped status changes are replayed from it until a later revision is delivered.
+	sinceRevision int64
+	queue         *clientQueue
+	// errCh receives the error once the client is disconnected by the broadcaster.
+	errCh chan error
 }
 
-// EventBroadcaster is a component that can broadcast resource status change events to registered clients.
+// accepts returns true if the client receives the status change events of the resource.
+func (c *eventClient) accepts(res *api.Resource) bool {
+	if c.filter != nil {
+		return c.filter(res)
+	}
+	return c.source == res.Source
+}
+
+// EventBroadcaster is a component that can broadcast resource status change events to registered clients. Each
+// client has a bounded queue, the events are handled by the clients in their own goroutines, so a slow client does
+// not delay the other clients unless the overflow policy of the queues is block.
 type EventBroadcaster struct {
 	mu sync.RWMutex
 
@@ -28,33 +68,73 @@ type EventBroadcaster struct {
 
 	// inbound messages from the clients.
 	broadcast chan *api.Resource
+
+	// bookmarks are queued by the same goroutine as the broadcast events, so a bookmark is queued after the events
+	// that were broadcast before the bookmark revision was found.
+	bookmarks chan *statusBookmark
+
+	queueOptions QueueOptions
 }
 
-// NewEventBroadcaster creates a new event broadcaster.
-func NewEventBroadcaster() *EventBroadcaster {
+// NewEventBroadcaster creates a new event broadcaster, the queues of the clients are created with the options.
+func NewEventBroadcaster(queueOptions QueueOptions) *EventBroadcaster {
+	if queueOptions.Size < 1 {
+		queueOptions.Size = DefaultQueueSize
+	}
+	if queueOptions.Overflow == "" {
+		queueOptions.Overflow = OverflowBlock
+	}
 	return &EventBroadcaster{
-		clients:   make(map[string]*eventClient),
-		broadcast: make(chan *api.Resource),
+		clients:      make(map[string]*eventClient),
+		broadcast:    make(chan *api.Resource),
+		bookmarks:    make(chan *statusBookmark),
+		queueOptions: queueOptions,
 	}
 }
 
-// Register registers a client and return client id and error channel.
-func (h *EventBroadcaster) Register(ctx context.Context, source string, handler resourceHandler) string {
+// Register registers a client and return client id and error channel. The client has received the status changes
+// up to the sinceRevision. The resync handler replays the missed status changes once the queued events of the client
+// are dropped, the client is disconnected instead if it is nil. The bookmark handler sends the status bookmarks that
+// are queued by Bookmark, it may be nil if the client receives no bookmarks.
+func (h *EventBroadcaster) Register(ctx context.Context, source string, sinceRevision int64,
+	handler resourceHandler, resync resyncHandler, bookmark bookmarkHandler) (string, <-chan error) {
+	return h.register(ctx, &eventClient{
+		source:        source,
+		handler:       handler,
+		resync:        resync,
+		bookmark:      bookmark,
+		sinceRevision: sinceRevision,
+	})
+}
+
+// RegisterObserver registers a client that receives the status change events of the resources of all the sources
+// that are accepted by the filter, the client is registered with the given source in the metrics. The missed status
+// changes cannot be replayed to an observer, so it is disconnected once its queued events are dropped.
+func (h *EventBroadcaster) RegisterObserver(ctx context.Context, source string, filter ResourceFilter, handler resourceHandler) (string, <-chan error) {
+	return h.register(ctx, &eventClient{source: source, filter: filter, handler: handler})
+}
+
+func (h *EventBroadcaster) register(ctx context.Context, client *eventClient) (string, <-chan error) {
 	logger := klog.FromContext(ctx)
 
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	id := uuid.NewString()
-	h.clients[id] = &eventClient{
-		source:  source,
-		handler: handler,
+	overflow := h.queueOptions.Overflow
+	if overflow == OverflowDropAndResync && client.resync == nil {
+		overflow = OverflowDisconnect
 	}
+	client.queue = newClientQueue(client.source, h.queueOptions.Size, overflow, h.queueOptions.Coalesce, client.sinceRevision)
+	client.errCh = make(chan error, 1)
+
+	id := uuid.NewString()
+	h.clients[id] = client
+	go h.run(ctx, id, client)
 
-	logger.Info("registered a broadcaster client", "id", id, "source", source)
-	grpcRegisteredSourceClientsGaugeMetric.WithLabelValues(source).Inc()
+	logger.Info("registered a broadcaster client", "id", id, "source", client.source, "observer", client.filter != nil)
+	grpcRegisteredSourceClientsGaugeMetric.WithLabelValues(client.source).Inc()
 
-	return id
+	return id, client.errCh
 }
 
 // Unregister unregisters a client by id
@@ -69,16 +149,82 @@ func (h *EventBroadcaster) Unregister(ctx context.Context, id string) {
 		return
 	}
 
-	delete(h.clients, id)
+	h.remove(id, client)
 	logger.Info("unregistered broadcaster client", "source", client.source)
+}
+
+// disconnect unregisters the client and sends the error to the client.
+func (h *EventBroadcaster) disconnect(ctx context.Context, id string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	client, exists := h.clients[id]
+	if !exists {
+		return
+	}
+
+	h.remove(id, client)
+	klog.FromContext(ctx).Error(err, "disconnected broadcaster client", "id", id, "source", client.source)
+	select {
+	case client.errCh <- err:
+	default:
+	}
+}
+
+func (h *EventBroadcaster) remove(id string, client *eventClient) {
+	delete(h.clients, id)
+	client.queue.close()
 	grpcRegisteredSourceClientsGaugeMetric.WithLabelValues(client.source).Dec()
 }
 
+// run handles the queued events of the client until the client is unregistered.
+func (h *EventBroadcaster) run(ctx context.Context, id string, client *eventClient) {
+	logger := klog.FromContext(ctx)
+	for {
+		item, sinceRevision, resync, ok := client.queue.pop()
+		if !ok {
+			return
+		}
+
+		if resync {
+			logger.Info("resync the broadcaster client", "id", id, "source", client.source, "sinceRevision", sinceRevision)
+			replayedRevision, err := client.resync(sinceRevision)
+			if err != nil {
+				h.disconnect(ctx, id, err)
+				return
+			}
+			client.queue.resynced(replayedRevision)
+			continue
+		}
+
+		if item.res == nil {
+			if err := client.bookmark(item.bookmark); err != nil {
+				logger.Error(err, "failed to send status bookmark", "revision", item.bookmark)
+			}
+			continue
+		}
+
+		if err := client.handler(item.res); err != nil {
+			logger.Error(err, "failed to handle resource", "resourceID", item.res.ID)
+		}
+	}
+}
+
 // Broadcast broadcasts a resource status change event to all registered clients.
 func (h *EventBroadcaster) Broadcast(res *api.Resource) {
 	h.broadcast <- res
 }
 
+// Bookmark queues a status bookmark of the revision for the client. The bookmark is queued after the status change
+// events that were broadcast before it, so the client receives the bookmark after those events. The client must
+// have a bookmark handler.
+func (h *EventBroadcaster) Bookmark(ctx context.Context, id string, revision int64) {
+	select {
+	case h.bookmarks <- &statusBookmark{clientID: id, revision: revision}:
+	case <-ctx.Done():
+	}
+}
+
 // Start starts the event broadcaster and waits for events to broadcast.
 func (h *EventBroadcaster) Start(ctx context.Context) {
 	logger := klog.FromContext(ctx)
@@ -89,20 +235,32 @@ func (h *EventBroadcaster) Start(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case res := <-h.broadcast:
+			// the events are queued without holding the lock, so that a client can be unregistered while the
+			// broadcaster is blocked by its full queue.
 			h.mu.RLock()
-
 			if len(h.clients) == 0 {
 				logger.Info("no clients registered on this instance")
 			}
+			accepted := map[string]*eventClient{}
+			for id, client := range h.clients {
+				if client.accepts(res) {
+					accepted[id] = client
+				}
+			}
+			h.mu.RUnlock()
 
-			for _, client := range h.clients {
-				if client.source == res.Source {
-					if err := client.handler(res); err != nil {
-						logger.Error(err, "failed to handle resource", "resourceID", res.ID)
-					}
+			for id, client := range accepted {
+				if !client.queue.push(res) {
+					h.disconnect(ctx, id, ErrQueueOverflow)
 				}
 			}
+		case bookmark := <-h.bookmarks:
+			h.mu.RLock()
+			client, exists := h.clients[bookmark.clientID]
 			h.mu.RUnlock()
+			if exists && client.bookmark != nil {
+				client.queue.pushBookmark(bookmark.revision)
+			}
 		}
 	}
 }
@@ -0,0 +1,257 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+func TestEventBroadcasterObserver(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broadcaster := NewEventBroadcaster(QueueOptions{})
+	go broadcaster.Start(ctx)
+
+	var mu sync.Mutex
+	received := map[string][]string{}
+	handler := func(name string) resourceHandler {
+		return func(res *api.Resource) error {
+			mu.Lock()
+			defer mu.Unlock()
+			received[name] = append(received[name], res.ID)
+			return nil
+		}
+	}
+
+	broadcaster.Register(ctx, "source1", 0, handler("subscriber"), nil, nil)
+	observerID, _ := broadcaster.RegisterObserver(ctx, "*", func(res *api.Resource) bool {
+		return res.ConsumerName == "cluster1"
+	}, handler("observer"))
+
+	broadcaster.Broadcast(&api.Resource{Meta: api.Meta{ID: "r1"}, Source: "source1", ConsumerName: "cluster1"})
+	broadcaster.Broadcast(&api.Resource{Meta: api.Meta{ID: "r2"}, Source: "source2", ConsumerName: "cluster1"})
+	broadcaster.Broadcast(&api.Resource{Meta: api.Meta{ID: "r3"}, Source: "source1", ConsumerName: "cluster2"})
+
+	// the subscriber receives the resources of its source, the observer receives the resources of the observed
+	// consumer from all of the sources
+	Eventually(func() map[string][]string {
+		mu.Lock()
+		defer mu.Unlock()
+		return map[string][]string{"subscriber": received["subscriber"], "observer": received["observer"]}
+	}, 5*time.Second, 100*time.Millisecond).Should(Equal(map[string][]string{
+		"subscriber": {"r1", "r3"},
+		"observer":   {"r1", "r2"},
+	}))
+
+	broadcaster.Unregister(ctx, observerID)
+	broadcaster.Broadcast(&api.Resource{Meta: api.Meta{ID: "r4"}, Source: "source2", ConsumerName: "cluster1"})
+	// the events are queued in order, r4 is queued before r5 is queued for the subscriber
+	broadcaster.Broadcast(&api.Resource{Meta: api.Meta{ID: "r5"}, Source: "source1", ConsumerName: "cluster2"})
+
+	Eventually(func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return received["subscriber"]
+	}, 5*time.Second, 100*time.Millisecond).Should(Equal([]string{"r1", "r3", "r5"}))
+	mu.Lock()
+	defer mu.Unlock()
+	Expect(received["observer"]).To(Equal([]string{"r1", "r2"}))
+}
+
+func TestEventBroadcasterOverflow(t *testing.T) {
+	RegisterTestingT(t)
+
+	cases := []struct {
+		name    string
+		options QueueOptions
+		resync  bool
+		// unrevisioned is the resource whose event has no status revision, e.g. it responds a status resync request.
+		unrevisioned string
+		expected     []string
+		// resyncRevision is the revision that the dropped status changes are replayed after.
+		resyncRevision int64
+		disconnected   bool
+	}{
+		{
+			name:         "disconnect",
+			options:      QueueOptions{Size: 2, Overflow: OverflowDisconnect},
+			expected:     []string{"r1@1"},
+			disconnected: true,
+		},
+		{
+			name:           "drop and resync",
+			options:        QueueOptions{Size: 2, Overflow: OverflowDropAndResync},
+			resync:         true,
+			expected:       []string{"r1@1", "resync", "r5@5"},
+			resyncRevision: 1,
+		},
+		{
+			name:         "drop and resync without resync handler",
+			options:      QueueOptions{Size: 2, Overflow: OverflowDropAndResync},
+			expected:     []string{"r1@1"},
+			disconnected: true,
+		},
+		{
+			name:           "drop and resync with an event without revision",
+			options:        QueueOptions{Size: 2, Overflow: OverflowDropAndResync},
+			resync:         true,
+			unrevisioned:   "r3",
+			expected:       []string{"r1@1", "resync", "r5@5"},
+			resyncRevision: 1,
+		},
+		{
+			name:     "coalesce",
+			options:  QueueOptions{Size: 3, Overflow: OverflowDisconnect, Coalesce: true},
+			expected: []string{"r1@1", "r2@4", "r3@3", "r5@5"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			broadcaster := NewEventBroadcaster(tc.options)
+			go broadcaster.Start(ctx)
+
+			// the subscriber is blocked on the first event until the other events are broadcast
+			var mu sync.Mutex
+			received := []string{}
+			started := make(chan struct{}, 1)
+			unblock := make(chan struct{})
+			handler := func(res *api.Resource) error {
+				select {
+				case started <- struct{}{}:
+				default:
+				}
+				<-unblock
+				mu.Lock()
+				defer mu.Unlock()
+				received = append(received, fmt.Sprintf("%s@%d", res.ID, res.StatusRevision))
+				return nil
+			}
+			var resync resyncHandler
+			var resyncRevision int64
+			if tc.resync {
+				resync = func(sinceRevision int64) (int64, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					resyncRevision = sinceRevision
+					received = append(received, "resync")
+					return 4, nil
+				}
+			}
+			id, disconnectedCh := broadcaster.Register(ctx, "source1", 0, handler, resync, nil)
+			other := make(chan string, 10)
+			broadcaster.Register(ctx, "source1", 0, func(res *api.Resource) error {
+				other <- res.ID
+				return nil
+			}, nil, nil)
+
+			// r1 is being handled, then r2, r3 and r2 again are queued, the queue overflows at the second r2
+			// unless it is coalesced.
+			for i, resourceID := range []string{"r1", "r2", "r3", "r2", "r5"} {
+				revision := int64(i + 1)
+				if resourceID == tc.unrevisioned {
+					revision = 0
+				}
+				broadcaster.Broadcast(&api.Resource{
+					Meta:           api.Meta{ID: resourceID},
+					Source:         "source1",
+					StatusRevision: revision,
+				})
+				if i == 0 {
+					Eventually(started, 5*time.Second).Should(Receive())
+				}
+				// the other subscriber is not blocked by the slow subscriber
+				Eventually(other, 5*time.Second).Should(Receive(Equal(resourceID)))
+			}
+
+			close(unblock)
+			if tc.disconnected {
+				Eventually(disconnectedCh, 5*time.Second).Should(Receive(MatchError(ErrQueueOverflow)))
+				broadcaster.mu.RLock()
+				_, registered := broadcaster.clients[id]
+				broadcaster.mu.RUnlock()
+				Expect(registered).To(BeFalse())
+			}
+
+			Eventually(func() []string {
+				mu.Lock()
+				defer mu.Unlock()
+				return append([]string{}, received...)
+			}, 5*time.Second, 10*time.Millisecond).Should(Equal(tc.expected))
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(resyncRevision).To(Equal(tc.resyncRevision))
+		})
+	}
+}
+
+func TestEventBroadcasterBookmark(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	broadcaster := NewEventBroadcaster(QueueOptions{Size: 10})
+	go broadcaster.Start(ctx)
+
+	var mu sync.Mutex
+	received := []string{}
+	started := make(chan struct{}, 1)
+	unblock := make(chan struct{})
+	handler := func(res *api.Resource) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-unblock
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, fmt.Sprintf("%s@%d", res.ID, res.StatusRevision))
+		return nil
+	}
+	bookmark := func(revision int64) error {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, fmt.Sprintf("bookmark@%d", revision))
+		return nil
+	}
+	id, _ := broadcaster.Register(ctx, "source1", 0, handler, nil, bookmark)
+
+	// the bookmarks are queued after the status changes that were broadcast before them, and a queued bookmark
+	// is replaced by the next one
+	broadcaster.Broadcast(&api.Resource{Meta: api.Meta{ID: "r1"}, Source: "source1", StatusRevision: 1})
+	Eventually(started, 5*time.Second).Should(Receive())
+	broadcaster.Broadcast(&api.Resource{Meta: api.Meta{ID: "r2"}, Source: "source1", StatusRevision: 2})
+	broadcaster.Bookmark(ctx, id, 1)
+	broadcaster.Bookmark(ctx, id, 2)
+	Eventually(func() int64 {
+		broadcaster.mu.RLock()
+		queue := broadcaster.clients[id].queue
+		broadcaster.mu.RUnlock()
+		queue.mu.Lock()
+		defer queue.mu.Unlock()
+		if len(queue.items) != 2 {
+			return 0
+		}
+		return queue.items[1].bookmark
+	}, 5*time.Second, 10*time.Millisecond).Should(Equal(int64(2)))
+	close(unblock)
+
+	Eventually(func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, received...)
+	}, 5*time.Second, 10*time.Millisecond).Should(Equal([]string{"r1@1", "r2@2", "bookmark@2"}))
+}
@@ -5,6 +5,11 @@ import "github.com/prometheus/client_golang/prometheus"
 func init() {
 	// Register the metrics:
 	prometheus.MustRegister(grpcRegisteredSourceClientsGaugeMetric)
+	prometheus.MustRegister(restRegisteredWatchersGaugeMetric)
+	prometheus.MustRegister(subscriberQueueLengthMetric)
+	prometheus.MustRegister(subscriberQueueLagMetric)
+	prometheus.MustRegister(subscriberOverflowsMetric)
+	prometheus.MustRegister(subscriberCoalescedEventsMetric)
 }
 
 // Description of the gRPC registered source clients gauge metric:
@@ -16,3 +21,53 @@ var grpcRegisteredSourceClientsGaugeMetric = prometheus.NewGaugeVec(
 	},
 	[]string{"source"},
 )
+
+// Description of the REST registered resource watchers gauge metric:
+var restRegisteredWatchersGaugeMetric = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Subsystem: "rest_api",
+		Name:      "registered_watchers",
+		Help:      "Number of registered resource watchers on the REST API server.",
+	},
+)
+
+// Description of the subscriber queue length gauge metric:
+var subscriberQueueLengthMetric = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: "grpc_server",
+		Name:      "subscriber_queue_length",
+		Help:      "Number of the status events that are queued for the source clients on the grpc server.",
+	},
+	[]string{"source"},
+)
+
+// Description of the subscriber queue lag histogram metric:
+var subscriberQueueLagMetric = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Subsystem: "grpc_server",
+		Name:      "subscriber_queue_lag_seconds",
+		Help:      "How long the status events are queued before they are handled by the source clients on the grpc server.",
+		Buckets:   prometheus.DefBuckets,
+	},
+	[]string{"source"},
+)
+
+// Description of the subscriber overflows counter metric:
+var subscriberOverflowsMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: "grpc_server",
+		Name:      "subscriber_overflows_total",
+		Help:      "Number of times that the status events of the source clients are dropped or the clients are disconnected because their queues are full.",
+	},
+	[]string{"source", "policy"},
+)
+
+// Description of the subscriber coalesced events counter metric:
+var subscriberCoalescedEventsMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: "grpc_server",
+		Name:      "subscriber_coalesced_events_total",
+		Help:      "Number of the status events that are coalesced into the queued status events of the same resources.",
+	},
+	[]string{"source"},
+)
@@ -0,0 +1,234 @@
+package event
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+// DefaultQueueSize is the default number of the events that are queued for a client.
+const DefaultQueueSize = 100
+
+// OverflowPolicy is how the broadcaster handles a client whose queue is full.
+type OverflowPolicy string
+
+const (
+	// OverflowBlock waits until the client handles its queued events, all of the clients are delayed meanwhile.
+	OverflowBlock OverflowPolicy = "block"
+	// OverflowDisconnect unregisters the client, the client has to resync the status after it reconnects.
+	OverflowDisconnect OverflowPolicy = "disconnect"
+	// OverflowDropAndResync drops the queued events of the client and replays the missed status changes to the
+	// client once it catches up.
+	OverflowDropAndResync OverflowPolicy = "drop-and-resync"
+)
+
+// QueueOptions configures the queues of the clients of the EventBroadcaster.
+type QueueOptions struct {
+	// Size is the number of the events that are queued for a client.
+	Size int
+	// Overflow is how the broadcaster handles a client whose queue is full.
+	Overflow OverflowPolicy
+	// Coalesce replaces the queued event of a resource with its latest event instead of queuing both of them.
+	Coalesce bool
+}
+
+// queuedResource is a resource status change event or a status bookmark that is waiting to be handled by a client.
+type queuedResource struct {
+	// res is nil if the item is a status bookmark.
+	res *api.Resource
+	// bookmark is the revision of the status bookmark.
+	bookmark int64
+	queuedAt time.Time
+}
+
+// clientQueue is the bounded queue of the events of a client.
+type clientQueue struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+
+	source   string
+	size     int
+	overflow OverflowPolicy
+	coalesce bool
+
+	items []*queuedResource
+	// pending is the queued events by resource ID, it is only used to coalesce the events.
+	pending map[string]*queuedResource
+	closed  bool
+
+	// resync is set once the queued events are dropped, the status changes after the resyncRevision have to be
+	// replayed to the client.
+	resync         bool
+	resyncRevision int64
+	// delivered is the latest status revision that was delivered to the client by an event, a bookmark or a resync.
+	delivered int64
+}
+
+func newClientQueue(source string, size int, overflow OverflowPolicy, coalesce bool, sinceRevision int64) *clientQueue {
+	q := &clientQueue{
+		source:    source,
+		size:      size,
+		overflow:  overflow,
+		coalesce:  coalesce,
+		pending:   map[string]*queuedResource{},
+		delivered: sinceRevision,
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// push queues the event of the resource, false is returned if the queue is full and the client has to be
+// disconnected.
+func (q *clientQueue) push(res *api.Resource) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		if q.closed {
+			return true
+		}
+
+		if q.coalesce {
+			if item, ok := q.pending[res.ID]; ok {
+				item.res = res
+				subscriberCoalescedEventsMetric.WithLabelValues(q.source).Inc()
+				return true
+			}
+		}
+
+		if len(q.items) < q.size {
+			break
+		}
+
+		switch q.overflow {
+		case OverflowDisconnect:
+			subscriberOverflowsMetric.WithLabelValues(q.source, string(q.overflow)).Inc()
+			return false
+		case OverflowDropAndResync:
+			subscriberOverflowsMetric.WithLabelValues(q.source, string(q.overflow)).Inc()
+			q.drop(res)
+			q.cond.Broadcast()
+			return true
+		default:
+			q.cond.Wait()
+		}
+	}
+
+	item := &queuedResource{res: res, queuedAt: time.Now()}
+	q.items = append(q.items, item)
+	if q.coalesce {
+		q.pending[res.ID] = item
+	}
+	subscriberQueueLengthMetric.WithLabelValues(q.source).Inc()
+	q.cond.Broadcast()
+	return true
+}
+
+// pushBookmark queues a status bookmark of the revision after the queued events. A queued bookmark that is not
+// handled yet is replaced, so the bookmarks never fill the queue of a slow client.
+func (q *clientQueue) pushBookmark(revision int64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	if last := len(q.items) - 1; last >= 0 && q.items[last].res == nil {
+		q.items[last].bookmark = revision
+		return
+	}
+
+	q.items = append(q.items, &queuedResource{bookmark: revision, queuedAt: time.Now()})
+	subscriberQueueLengthMetric.WithLabelValues(q.source).Inc()
+	q.cond.Broadcast()
+}
+
+// drop drops the queued events and the event of the resource, and marks the queue to resync from the latest
+// revision delivered to the client. A dropped event whose revision is not newer than the delivered revision, e.g.
+// its status change committed after a newer one, moves the resync back before its revision.
+func (q *clientQueue) drop(res *api.Resource) {
+	revision := revisionBefore(res, q.delivered)
+	for _, item := range q.items {
+		if item.res != nil {
+			revision = revisionBefore(item.res, revision)
+		}
+	}
+	if q.resync {
+		revision = min(revision, q.resyncRevision)
+	}
+
+	subscriberQueueLengthMetric.WithLabelValues(q.source).Sub(float64(len(q.items)))
+	q.items = nil
+	q.pending = map[string]*queuedResource{}
+	q.resync = true
+	q.resyncRevision = revision
+}
+
+// revisionBefore returns the revision that the status changes have to be replayed after, so that both the status
+// change of the resource and the status changes after the given revision are replayed. The revision is kept if the
+// status change has no revision, e.g. it responds a status resync request.
+func revisionBefore(res *api.Resource, revision int64) int64 {
+	if res.StatusRevision <= 0 {
+		return revision
+	}
+	return min(revision, res.StatusRevision-1)
+}
+
+// resynced records that the status changes up to the revision were replayed to the client.
+func (q *clientQueue) resynced(revision int64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.delivered = max(q.delivered, revision)
+}
+
+// pop waits for the next event or bookmark of the client and returns it, or returns true with the revision to
+// resync from if the queued events were dropped. false is returned once the queue is closed.
+func (q *clientQueue) pop() (*queuedResource, int64, bool, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for !q.closed && !q.resync && len(q.items) == 0 {
+		q.cond.Wait()
+	}
+
+	if q.closed {
+		return nil, 0, false, false
+	}
+
+	if q.resync {
+		q.resync = false
+		return nil, q.resyncRevision, true, true
+	}
+
+	item := q.items[0]
+	q.items[0] = nil
+	q.items = q.items[1:]
+	if item.res == nil {
+		q.delivered = max(q.delivered, item.bookmark)
+	} else {
+		if q.coalesce && q.pending[item.res.ID] == item {
+			delete(q.pending, item.res.ID)
+		}
+		q.delivered = max(q.delivered, item.res.StatusRevision)
+	}
+	subscriberQueueLengthMetric.WithLabelValues(q.source).Dec()
+	subscriberQueueLagMetric.WithLabelValues(q.source).Observe(time.Since(item.queuedAt).Seconds())
+	q.cond.Broadcast()
+	return item, 0, false, true
+}
+
+// close drops the queued events and wakes up the waiting broadcaster and client.
+func (q *clientQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	subscriberQueueLengthMetric.WithLabelValues(q.source).Sub(float64(len(q.items)))
+	q.items = nil
+	q.pending = nil
+	q.closed = true
+	q.cond.Broadcast()
+}
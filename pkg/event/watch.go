@@ -0,0 +1,92 @@
+package event
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"k8s.io/klog/v2"
+)
+
+// WatchEventType defines the type of a resource watch event.
+type WatchEventType string
+
+const (
+	// WatchEventAdded indicates the resource is created.
+	WatchEventAdded WatchEventType = "ADDED"
+	// WatchEventModified indicates the resource spec or status is changed.
+	WatchEventModified WatchEventType = "MODIFIED"
+	// WatchEventDeleted indicates the resource is deleted.
+	WatchEventDeleted WatchEventType = "DELETED"
+)
+
+// WatchEvent is a resource change event, it only carries the resource id, the watchers
+// are responsible for retrieving the latest resource if needed.
+type WatchEvent struct {
+	Type       WatchEventType
+	ResourceID string
+}
+
+// watchHandler is a function that can handle resource watch events, the handler is called
+// synchronously while broadcasting, so it must not block.
+type watchHandler func(evt *WatchEvent)
+
+// WatchBroadcaster is a component that can broadcast resource change events to registered watchers.
+// Unlike the EventBroadcaster, it broadcasts the changes of the resources from all sources, including
+// the resource spec changes, so it can be used to serve the RESTful watch requests.
+type WatchBroadcaster struct {
+	mu sync.RWMutex
+
+	// registered watchers.
+	watchers map[string]watchHandler
+}
+
+// NewWatchBroadcaster creates a new watch broadcaster.
+func NewWatchBroadcaster() *WatchBroadcaster {
+	return &WatchBroadcaster{
+		watchers: make(map[string]watchHandler),
+	}
+}
+
+// Register registers a watcher and returns the watcher id.
+func (b *WatchBroadcaster) Register(ctx context.Context, handler watchHandler) string {
+	logger := klog.FromContext(ctx)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := uuid.NewString()
+	b.watchers[id] = handler
+
+	logger.Info("registered a resource watcher", "id", id)
+	restRegisteredWatchersGaugeMetric.Inc()
+
+	return id
+}
+
+// Unregister unregisters a watcher by id.
+func (b *WatchBroadcaster) Unregister(ctx context.Context, id string) {
+	logger := klog.FromContext(ctx).WithValues("id", id)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, exists := b.watchers[id]; !exists {
+		logger.Info("attempted to unregister non-existent resource watcher")
+		return
+	}
+
+	delete(b.watchers, id)
+	logger.Info("unregistered resource watcher")
+	restRegisteredWatchersGaugeMetric.Dec()
+}
+
+// Broadcast broadcasts a resource change event to all registered watchers.
+func (b *WatchBroadcaster) Broadcast(evt *WatchEvent) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, handler := range b.watchers {
+		handler(evt)
+	}
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// auditEventHandler serves the audit events, they are read only.
+type auditEventHandler struct {
+	generic services.GenericService
+}
+
+func NewAuditEventHandler(generic services.GenericService) *auditEventHandler {
+	return &auditEventHandler{
+		generic: generic,
+	}
+}
+
+func (h auditEventHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if serviceErr := authorizeAuditEvents(ctx); serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			listArgs := services.NewListArguments(r.URL.Query())
+			auditEvents := []api.AuditEvent{}
+			paging, serviceErr := h.generic.List(ctx, auth.UsernameFromContext(ctx), listArgs, &auditEvents)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			auditEventList := openapi.AuditEventList{
+				Kind:  *presenters.ObjectKind(auditEvents),
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []openapi.AuditEvent{},
+			}
+			if paging.Continue != "" {
+				auditEventList.Continue = openapi.PtrString(paging.Continue)
+			}
+
+			for _, auditEvent := range auditEvents {
+				auditEventList.Items = append(auditEventList.Items, presenters.PresentAuditEvent(&auditEvent))
+			}
+			if listArgs.Fields != nil {
+				filteredItems, err := presenters.SliceFilter(listArgs.Fields, auditEventList.Items)
+				if err != nil {
+					return nil, err
+				}
+				return filteredItems, nil
+			}
+			return auditEventList, nil
+		},
+	}
+
+	handleList(w, r, cfg)
+}
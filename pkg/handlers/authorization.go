@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/constants"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// authorizeResource returns a not found error if the resource is out of the scope of the caller,
+// so that the caller cannot tell whether the resources of others exist.
+func authorizeResource(ctx context.Context, resource *api.Resource) *errors.ServiceError {
+	scope := auth.ScopeFromContext(ctx)
+	if !scope.AllowsSource(resource.Source) || !scope.AllowsConsumer(resource.ConsumerName) {
+		return errors.NotFound("Resource with id='%s' not found", resource.ID)
+	}
+	return nil
+}
+
+// authorizeConsumer returns a not found error if the consumer is out of the scope of the caller.
+func authorizeConsumer(ctx context.Context, consumer *api.Consumer) *errors.ServiceError {
+	if !auth.ScopeFromContext(ctx).AllowsConsumer(consumer.Name) {
+		return errors.NotFound("Consumer with id='%s' not found", consumer.ID)
+	}
+	return nil
+}
+
+// authorizePlacements returns a forbidden error if the caller is restricted to some of the consumers or is not
+// allowed to access the maestro source, since the placements create the resource bundles on any of the consumers.
+func authorizePlacements(ctx context.Context) *errors.ServiceError {
+	scope := auth.ScopeFromContext(ctx)
+	if !scope.AllowsSource(constants.DefaultSourceID) || (scope != nil && scope.Consumers != nil) {
+		return errors.Forbidden("not allowed to access placements, the placements require the access to all of the consumers")
+	}
+	return nil
+}
+
+// authorizeAuditEvents returns a forbidden error if the caller is restricted to some of the sources or consumers,
+// since the audit events record the operations on the resource bundles and the consumers of all of them.
+func authorizeAuditEvents(ctx context.Context) *errors.ServiceError {
+	scope := auth.ScopeFromContext(ctx)
+	if scope != nil && (scope.Sources != nil || scope.Consumers != nil) {
+		return errors.Forbidden("not allowed to access audit events, the audit events require the access to all of the sources and consumers")
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -8,6 +9,7 @@ import (
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/db"
 	"github.com/openshift-online/maestro/pkg/errors"
 	"github.com/openshift-online/maestro/pkg/services"
@@ -16,16 +18,19 @@ import (
 var _ RestHandler = consumerHandler{}
 
 type consumerHandler struct {
-	consumer services.ConsumerService
-	resource services.ResourceService
-	generic  services.GenericService
+	consumer   services.ConsumerService
+	resource   services.ResourceService
+	generic    services.GenericService
+	heartbeats services.ConsumerHeartbeatService
 }
 
-func NewConsumerHandler(consumer services.ConsumerService, resource services.ResourceService, generic services.GenericService) *consumerHandler {
+func NewConsumerHandler(consumer services.ConsumerService, resource services.ResourceService, generic services.GenericService,
+	heartbeats services.ConsumerHeartbeatService) *consumerHandler {
 	return &consumerHandler{
-		consumer: consumer,
-		resource: resource,
-		generic:  generic,
+		consumer:   consumer,
+		resource:   resource,
+		generic:    generic,
+		heartbeats: heartbeats,
 	}
 }
 
@@ -39,11 +44,14 @@ func (h consumerHandler) Create(w http.ResponseWriter, r *http.Request) {
 		func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
 			consumer := presenters.ConvertConsumer(consumer)
+			if !auth.ScopeFromContext(ctx).AllowsConsumer(consumer.Name) {
+				return nil, errors.Forbidden("not allowed to create consumer %s", consumer.Name)
+			}
 			consumer, err := h.consumer.Create(ctx, consumer)
 			if err != nil {
 				return nil, err
 			}
-			return presenters.PresentConsumer(consumer), nil
+			return h.presentConsumer(ctx, consumer)
 		},
 		handleError,
 	}
@@ -64,6 +72,9 @@ func (h consumerHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			if err := authorizeConsumer(ctx, found); err != nil {
+				return nil, err
+			}
 			if patch.Labels != nil {
 				found.Labels = db.EmptyMapToNilStringMap(patch.Labels)
 			}
@@ -72,7 +83,7 @@ func (h consumerHandler) Patch(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
-			return presenters.PresentConsumer(consumer), nil
+			return h.presentConsumer(ctx, consumer)
 		},
 		handleError,
 	}
@@ -86,8 +97,9 @@ func (h consumerHandler) List(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
 
 			listArgs := services.NewListArguments(r.URL.Query())
+			listArgs.Scope = auth.ScopeFromContext(ctx)
 			consumers := []api.Consumer{}
-			paging, err := h.generic.List(ctx, "username", listArgs, &consumers)
+			paging, err := h.generic.List(ctx, auth.UsernameFromContext(ctx), listArgs, &consumers)
 			if err != nil {
 				return nil, err
 			}
@@ -98,9 +110,21 @@ func (h consumerHandler) List(w http.ResponseWriter, r *http.Request) {
 				Total: int32(paging.Total),
 				Items: []openapi.Consumer{},
 			}
+			if paging.Continue != "" {
+				consumerList.Continue = openapi.PtrString(paging.Continue)
+			}
 
+			consumerNames := []string{}
+			for _, consumer := range consumers {
+				consumerNames = append(consumerNames, consumer.Name)
+			}
+			statuses, err := h.heartbeats.FindStatuses(ctx, consumerNames)
+			if err != nil {
+				return nil, err
+			}
 			for _, consumer := range consumers {
 				converted := presenters.PresentConsumer(&consumer)
+				converted.Status = presenters.PresentConsumerStatus(statuses[consumer.Name])
 				consumerList.Items = append(consumerList.Items, converted)
 			}
 			if listArgs.Fields != nil {
@@ -126,8 +150,11 @@ func (h consumerHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if err != nil {
 				return nil, err
 			}
+			if err := authorizeConsumer(ctx, consumer); err != nil {
+				return nil, err
+			}
 
-			return presenters.PresentConsumer(consumer), nil
+			return h.presentConsumer(ctx, consumer)
 		},
 	}
 
@@ -135,14 +162,43 @@ func (h consumerHandler) Get(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h consumerHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	var policy api.ConsumerDeletionPolicy
 	cfg := &handlerConfig{
+		Validate: []validate{
+			validateConsumerDeletionPolicy(r.URL.Query().Get("deletionPolicy"), &policy),
+		},
 		Action: func() (interface{}, *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
-			if err := h.consumer.Delete(r.Context(), id); err != nil {
+			ctx := r.Context()
+			found, err := h.consumer.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if err := authorizeConsumer(ctx, found); err != nil {
+				return nil, err
+			}
+			if err := h.consumer.Delete(ctx, id, policy); err != nil {
 				return nil, err
 			}
 			return nil, nil
 		},
 	}
-	handleDelete(w, r, cfg, http.StatusNoContent)
+
+	// the cascade deletion is finished asynchronously once the agent confirms the deletion of the resources
+	httpStatus := http.StatusNoContent
+	if r.URL.Query().Get("deletionPolicy") == string(api.ConsumerDeletionPolicyCascade) {
+		httpStatus = http.StatusAccepted
+	}
+	handleDelete(w, r, cfg, httpStatus)
+}
+
+// presentConsumer presents the consumer with the status of its agent.
+func (h consumerHandler) presentConsumer(ctx context.Context, consumer *api.Consumer) (*openapi.Consumer, *errors.ServiceError) {
+	statuses, err := h.heartbeats.FindStatuses(ctx, []string{consumer.Name})
+	if err != nil {
+		return nil, err
+	}
+	presented := presenters.PresentConsumer(consumer)
+	presented.Status = presenters.PresentConsumerStatus(statuses[consumer.Name])
+	return &presented, nil
 }
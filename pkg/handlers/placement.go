@@ -0,0 +1,234 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/constants"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+var _ RestHandler = placementHandler{}
+
+type placementHandler struct {
+	placement services.PlacementService
+	generic   services.GenericService
+}
+
+func NewPlacementHandler(placement services.PlacementService, generic services.GenericService) *placementHandler {
+	return &placementHandler{
+		placement: placement,
+		generic:   generic,
+	}
+}
+
+func (h placementHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var p openapi.Placement
+	cfg := &handlerConfig{
+		&p,
+		[]validate{
+			validateEmpty(&p, "Id", "id"),
+			validateManifestsNotEmpty(&p.Manifests),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if serviceErr := authorizePlacements(ctx); serviceErr != nil {
+				return nil, serviceErr
+			}
+			placement, err := presenters.ConvertPlacement(p)
+			if err != nil {
+				return nil, errors.Validation("the manifest bundle in the placement is invalid, %v", err)
+			}
+			placement, serviceErr := h.placement.Create(ctx, placement)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			created, err := presenters.PresentPlacement(placement)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present placement: %s", err)
+			}
+			return created, nil
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusCreated)
+}
+
+func (h placementHandler) Patch(w http.ResponseWriter, r *http.Request) {
+	var patch openapi.PlacementPatchRequest
+
+	cfg := &handlerConfig{
+		&patch,
+		[]validate{},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if serviceErr := authorizePlacements(ctx); serviceErr != nil {
+				return nil, serviceErr
+			}
+			id := mux.Vars(r)["id"]
+			found, serviceErr := h.placement.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			if serviceErr := patchPlacement(found, &patch); serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			placement, serviceErr := h.placement.Replace(ctx, found)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			updated, err := presenters.PresentPlacement(placement)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present placement: %s", err)
+			}
+			return updated, nil
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
+
+func (h placementHandler) Get(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			if serviceErr := authorizePlacements(ctx); serviceErr != nil {
+				return nil, serviceErr
+			}
+			placement, serviceErr := h.placement.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			p, err := presenters.PresentPlacement(placement)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present placement: %s", err)
+			}
+			return p, nil
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
+
+func (h placementHandler) List(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			if serviceErr := authorizePlacements(ctx); serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			listArgs := services.NewListArguments(r.URL.Query())
+			placements := []api.Placement{}
+			paging, serviceErr := h.generic.List(ctx, auth.UsernameFromContext(ctx), listArgs, &placements)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			placementList := openapi.PlacementList{
+				Kind:  *presenters.ObjectKind(placements),
+				Page:  int32(paging.Page),
+				Size:  int32(paging.Size),
+				Total: int32(paging.Total),
+				Items: []openapi.Placement{},
+			}
+			if paging.Continue != "" {
+				placementList.Continue = openapi.PtrString(paging.Continue)
+			}
+
+			for _, placement := range placements {
+				converted, err := presenters.PresentPlacement(&placement)
+				if err != nil {
+					return nil, errors.GeneralError("failed to present placement: %s", err)
+				}
+				placementList.Items = append(placementList.Items, *converted)
+			}
+			if listArgs.Fields != nil {
+				filteredItems, err := presenters.SliceFilter(listArgs.Fields, placementList.Items)
+				if err != nil {
+					return nil, err
+				}
+				return filteredItems, nil
+			}
+			return placementList, nil
+		},
+	}
+
+	handleList(w, r, cfg)
+}
+
+// Delete marks the placement as deleting, the placement is removed once the agents confirm the deletion of all
+// of its resource bundles.
+func (h placementHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			if serviceErr := authorizePlacements(ctx); serviceErr != nil {
+				return nil, serviceErr
+			}
+			if _, serviceErr := h.placement.Get(ctx, id); serviceErr != nil {
+				return nil, serviceErr
+			}
+			if serviceErr := h.placement.Delete(ctx, id); serviceErr != nil {
+				return nil, serviceErr
+			}
+			return nil, nil
+		},
+	}
+	handleDelete(w, r, cfg, http.StatusAccepted)
+}
+
+// patchPlacement applies the patch to the consumer selector, the manifest bundle and the template of the found
+// placement.
+func patchPlacement(found *api.Placement, patch *openapi.PlacementPatchRequest) *errors.ServiceError {
+	manifestBundle, err := api.DecodeManifestBundle(found.Payload)
+	if err != nil {
+		return errors.GeneralError("failed to decode manifest bundle: %s", err)
+	}
+	if manifestBundle == nil {
+		manifestBundle = &api.ManifestBundleWrapper{}
+	}
+
+	if patch.ConsumerSelector != nil {
+		found.ConsumerSelector = *patch.ConsumerSelector
+	}
+	if patch.Metadata != nil {
+		manifestBundle.Meta = patch.Metadata
+	}
+	if patch.Manifests != nil {
+		if len(patch.Manifests) == 0 {
+			return errors.Validation("manifests must specify at least one item")
+		}
+		manifestBundle.Manifests = patch.Manifests
+	}
+	if patch.ManifestConfigs != nil {
+		manifestBundle.ManifestConfigs = patch.ManifestConfigs
+	}
+	if patch.DeleteOption != nil {
+		manifestBundle.DeleteOption = patch.DeleteOption
+	}
+	if patch.TemplateEngine != nil {
+		found.TemplateEngine = *patch.TemplateEngine
+	}
+	if patch.Values != nil {
+		found.Values = patch.Values
+	}
+
+	found.Payload, err = api.EncodeManifestBundle(constants.DefaultSourceID, manifestBundle)
+	if err != nil {
+		return errors.Validation("the manifest bundle in the placement is invalid, %v", err)
+	}
+	return nil
+}
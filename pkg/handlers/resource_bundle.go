@@ -2,13 +2,16 @@ package handlers
 
 import (
 	"net/http"
+	"reflect"
 
 	"github.com/gorilla/mux"
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
 	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/event"
 	"github.com/openshift-online/maestro/pkg/services"
 )
 
@@ -17,26 +20,184 @@ var _ RestHandler = resourceBundleHandler{}
 type resourceBundleHandler struct {
 	resource services.ResourceService
 	generic  services.GenericService
+	watcher  *event.WatchBroadcaster
 }
 
-func NewResourceBundleHandler(resource services.ResourceService, generic services.GenericService) *resourceBundleHandler {
+func NewResourceBundleHandler(resource services.ResourceService, generic services.GenericService, watcher *event.WatchBroadcaster) *resourceBundleHandler {
 	return &resourceBundleHandler{
 		resource: resource,
 		generic:  generic,
+		watcher:  watcher,
 	}
 }
 
 func (h resourceBundleHandler) Create(w http.ResponseWriter, r *http.Request) {
-	// not implemented
-	http.Error(w, "Not Implemented Yet", http.StatusNotImplemented)
+	var rb openapi.ResourceBundle
+	cfg := &handlerConfig{
+		&rb,
+		[]validate{
+			validateEmpty(&rb, "Id", "id"),
+			validateNotEmpty(&rb, "ConsumerName", "consumer_name"),
+			validateManifestsNotEmpty(&rb.Manifests),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			resource, err := presenters.ConvertResourceBundle(rb)
+			if err != nil {
+				return nil, errors.Validation("the manifest bundle in the resource bundle is invalid, %v", err)
+			}
+			scope := auth.ScopeFromContext(ctx)
+			if !scope.AllowsSource(resource.Source) || !scope.AllowsConsumer(resource.ConsumerName) {
+				return nil, errors.Forbidden("not allowed to create resource bundles for consumer %s", resource.ConsumerName)
+			}
+			if IsDryRunRequest(r) {
+				return h.dryRun(ctx, api.CreateEventType, resource)
+			}
+			resource, serviceErr := h.resource.Create(ctx, resource)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			created, err := presenters.PresentResourceBundle(resource)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+			}
+			return created, nil
+		},
+		handleError,
+	}
+
+	// nothing is created in a dry run
+	status := http.StatusCreated
+	if IsDryRunRequest(r) {
+		status = http.StatusOK
+	}
+	handle(w, r, cfg, status)
 }
 
 func (h resourceBundleHandler) Patch(w http.ResponseWriter, r *http.Request) {
-	// not implemented
-	http.Error(w, "Not Implemented Yet", http.StatusNotImplemented)
+	if patchType, ok := manifestBundlePatchType(r); ok {
+		h.patchManifestBundle(w, r, patchType)
+		return
+	}
+
+	var patch openapi.ResourceBundlePatchRequest
+
+	cfg := &handlerConfig{
+		&patch,
+		[]validate{
+			validateVersionPositive(&patch.Version),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			found, serviceErr := h.resource.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			if serviceErr := authorizeResource(ctx, found); serviceErr != nil {
+				return nil, serviceErr
+			}
+			return h.update(r, found, &patch)
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
+
+// Replace replaces the metadata, manifests, manifest configs and delete option of the resource bundle, the
+// fields that are not set in the request are removed.
+func (h resourceBundleHandler) Replace(w http.ResponseWriter, r *http.Request) {
+	var rb openapi.ResourceBundle
+
+	cfg := &handlerConfig{
+		&rb,
+		[]validate{
+			validateVersionRequired(&rb.Version),
+			validateManifestsNotEmpty(&rb.Manifests),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			found, serviceErr := h.resource.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			if serviceErr := authorizeResource(ctx, found); serviceErr != nil {
+				return nil, serviceErr
+			}
+			if rb.Id != nil && *rb.Id != found.ID {
+				return nil, errors.Validation("id must be %s", found.ID)
+			}
+			if rb.ConsumerName != nil && *rb.ConsumerName != found.ConsumerName {
+				return nil, errors.Validation("consumer_name cannot be changed")
+			}
+
+			// the fields that are not set are replaced with the empty values, so they are removed.
+			patch := openapi.ResourceBundlePatchRequest{
+				Version:         *rb.Version,
+				Metadata:        rb.Metadata,
+				Manifests:       rb.Manifests,
+				DeleteOption:    rb.DeleteOption,
+				ManifestConfigs: rb.ManifestConfigs,
+			}
+			if patch.Metadata == nil {
+				patch.Metadata = map[string]interface{}{}
+			}
+			if patch.DeleteOption == nil {
+				patch.DeleteOption = map[string]interface{}{}
+			}
+			if patch.ManifestConfigs == nil {
+				patch.ManifestConfigs = []map[string]interface{}{}
+			}
+			return h.update(r, found, &patch)
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
+
+// update applies the patch to the found resource bundle and updates it, or returns its changes in a dry run.
+func (h resourceBundleHandler) update(r *http.Request, found *api.Resource, patch *openapi.ResourceBundlePatchRequest) (interface{}, *errors.ServiceError) {
+	ctx := r.Context()
+	resource, serviceErr := patchResourceBundle(found, patch)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if IsDryRunRequest(r) {
+		return h.dryRun(ctx, api.UpdateEventType, resource)
+	}
+
+	// the manifest bundle is not changed, the update action is not needed.
+	if resource.Payload == nil {
+		if resource.Version != found.Version {
+			return nil, errors.Conflict("the resource version is not the latest, the latest version: %d", found.Version)
+		}
+		unchanged, err := presenters.PresentResourceBundle(found)
+		if err != nil {
+			return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+		}
+		return unchanged, nil
+	}
+
+	resource, serviceErr = h.resource.Update(ctx, resource)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	updated, err := presenters.PresentResourceBundle(resource)
+	if err != nil {
+		return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+	}
+	return updated, nil
 }
 
 func (h resourceBundleHandler) Get(w http.ResponseWriter, r *http.Request) {
+	if IsWaitRequest(r) {
+		h.wait(w, r)
+		return
+	}
+
 	cfg := &handlerConfig{
 		Action: func() (interface{}, *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
@@ -45,6 +206,9 @@ func (h resourceBundleHandler) Get(w http.ResponseWriter, r *http.Request) {
 			if serviceErr != nil {
 				return nil, serviceErr
 			}
+			if serviceErr := authorizeResource(ctx, resource); serviceErr != nil {
+				return nil, serviceErr
+			}
 
 			rb, err := presenters.PresentResourceBundle(resource)
 			if err != nil {
@@ -58,13 +222,19 @@ func (h resourceBundleHandler) Get(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h resourceBundleHandler) List(w http.ResponseWriter, r *http.Request) {
+	if IsWatchRequest(r) {
+		h.watch(w, r)
+		return
+	}
+
 	cfg := &handlerConfig{
 		Action: func() (interface{}, *errors.ServiceError) {
 			ctx := r.Context()
 
 			listArgs := services.NewListArguments(r.URL.Query())
+			listArgs.Scope = auth.ScopeFromContext(ctx)
 			var resources []api.Resource
-			paging, serviceErr := h.resource.ListWithArgs(ctx, "username", listArgs, &resources)
+			paging, serviceErr := h.resource.ListWithArgs(ctx, auth.UsernameFromContext(ctx), listArgs, &resources)
 			if serviceErr != nil {
 				return nil, serviceErr
 			}
@@ -75,6 +245,9 @@ func (h resourceBundleHandler) List(w http.ResponseWriter, r *http.Request) {
 				Total: int32(paging.Total),
 				Items: []openapi.ResourceBundle{},
 			}
+			if paging.Continue != "" {
+				resourceBundleList.Continue = openapi.PtrString(paging.Continue)
+			}
 
 			for _, resource := range resources {
 				converted, err := presenters.PresentResourceBundle(&resource)
@@ -108,7 +281,14 @@ func (h resourceBundleHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		Action: func() (interface{}, *errors.ServiceError) {
 			id := mux.Vars(r)["id"]
 			ctx := r.Context()
-			err := h.resource.MarkAsDeleting(ctx, id)
+			found, err := h.resource.Get(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if err := authorizeResource(ctx, found); err != nil {
+				return nil, err
+			}
+			err = h.resource.MarkAsDeleting(ctx, id)
 			if err != nil {
 				return nil, err
 			}
@@ -117,3 +297,63 @@ func (h resourceBundleHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 	handleDelete(w, r, cfg, http.StatusNoContent)
 }
+
+// patchResourceBundle applies the patch to the manifest bundle of the found resource and returns the resource
+// to update, the payload of the returned resource is nil if the manifest bundle is not changed. The resource is
+// updated from the version of the patch, so the update fails with a conflict if it is not the current version.
+func patchResourceBundle(found *api.Resource, patch *openapi.ResourceBundlePatchRequest) (*api.Resource, *errors.ServiceError) {
+	manifestBundle, err := api.DecodeManifestBundle(found.Payload)
+	if err != nil {
+		return nil, errors.GeneralError("failed to decode manifest bundle: %s", err)
+	}
+	if manifestBundle == nil {
+		manifestBundle = &api.ManifestBundleWrapper{}
+	}
+
+	// the creationTimestamp and deletionTimestamp of the work metadata are synced from the
+	// resource meta, they are read only and should not be saved in the manifest bundle.
+	removeReadOnlyWorkMeta(manifestBundle.Meta)
+	original := *manifestBundle
+
+	if patch.Metadata != nil {
+		removeReadOnlyWorkMeta(patch.Metadata)
+		manifestBundle.Meta = patch.Metadata
+	}
+	if patch.Manifests != nil {
+		if len(patch.Manifests) == 0 {
+			return nil, errors.Validation("manifests must specify at least one item")
+		}
+		manifestBundle.Manifests = patch.Manifests
+	}
+	if patch.ManifestConfigs != nil {
+		manifestBundle.ManifestConfigs = patch.ManifestConfigs
+	}
+	if patch.DeleteOption != nil {
+		manifestBundle.DeleteOption = patch.DeleteOption
+	}
+
+	resource := &api.Resource{
+		Meta:         api.Meta{ID: found.ID},
+		Version:      patch.Version,
+		Source:       found.Source,
+		ConsumerName: found.ConsumerName,
+	}
+
+	if reflect.DeepEqual(original, *manifestBundle) {
+		return resource, nil
+	}
+
+	// the manifest bundle is encoded with the source of the resource, the resources of the grpc sources are
+	// updated by the REST API too.
+	resource.Payload, err = api.EncodeManifestBundle(found.Source, manifestBundle)
+	if err != nil {
+		return nil, errors.Validation("the manifest bundle in the resource bundle is invalid, %v", err)
+	}
+	return resource, nil
+}
+
+// removeReadOnlyWorkMeta removes the work metadata fields that are synced from the resource meta.
+func removeReadOnlyWorkMeta(meta map[string]interface{}) {
+	delete(meta, "creationTimestamp")
+	delete(meta, "deletionTimestamp")
+}
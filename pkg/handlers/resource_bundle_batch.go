@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/errors"
+	loggertracing "github.com/openshift-online/maestro/pkg/logger"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// The actions of the resource bundle batch operations.
+const (
+	batchActionCreate = "create"
+	batchActionUpdate = "update"
+	batchActionDelete = "delete"
+)
+
+// Batch creates, updates or deletes the resource bundles in one request and responds the result of each
+// operation. The operations are applied in the transaction of the request. In the atomic mode, either all of
+// them are applied or none of them, otherwise a failed operation is rolled back alone.
+func (h resourceBundleHandler) Batch(w http.ResponseWriter, r *http.Request) {
+	var batch openapi.ResourceBundleBatchRequest
+	cfg := &handlerConfig{
+		&batch,
+		[]validate{
+			validateBatchOperations(&batch.Operations),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			operations := make([]services.ResourceOperation, 0, len(batch.Operations))
+			for _, operation := range batch.Operations {
+				operations = append(operations, h.prepareOperation(ctx, operation))
+			}
+
+			atomic := batch.GetAtomic()
+			results, serviceErr := h.resource.Batch(ctx, operations, atomic)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			operationID := loggertracing.GetOperationID(ctx)
+			response := openapi.ResourceBundleBatchResponse{
+				Kind:   "ResourceBundleBatchResponse",
+				Atomic: atomic,
+				Items:  []openapi.ResourceBundleBatchResult{},
+			}
+			for i, result := range results {
+				operation := batch.Operations[i]
+				item := openapi.ResourceBundleBatchResult{
+					Action:    operation.Action,
+					Id:        operation.Id,
+					Succeeded: result.Error == nil,
+				}
+
+				switch {
+				case result.Error != nil:
+					openapiErr := result.Error.AsOpenapiError(operationID)
+					item.Error = &openapiErr
+				case operation.Action != batchActionDelete:
+					resourceBundle, err := presenters.PresentResourceBundle(result.Resource)
+					if err != nil {
+						return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+					}
+					item.Id = resourceBundle.Id
+					item.ResourceBundle = resourceBundle
+				}
+
+				response.Items = append(response.Items, item)
+			}
+			return response, nil
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
+
+// prepareOperation converts a batch operation of the request to a resource operation, the error of the
+// resource operation is set if the batch operation is invalid or the caller is not allowed to perform it.
+func (h resourceBundleHandler) prepareOperation(ctx context.Context, operation openapi.ResourceBundleBatchOperation) services.ResourceOperation {
+	switch operation.Action {
+	case batchActionCreate:
+		rb := operation.ResourceBundle
+		if rb == nil {
+			return failedOperation(api.CreateEventType, errors.Validation("resource_bundle is required to create a resource bundle"))
+		}
+		if rb.Id != nil {
+			return failedOperation(api.CreateEventType, errors.Validation("id must be empty"))
+		}
+		if rb.GetConsumerName() == "" {
+			return failedOperation(api.CreateEventType, errors.Validation("consumer_name is required"))
+		}
+		if len(rb.Manifests) == 0 {
+			return failedOperation(api.CreateEventType, errors.Validation("manifests must specify at least one item"))
+		}
+
+		resource, err := presenters.ConvertResourceBundle(*rb)
+		if err != nil {
+			return failedOperation(api.CreateEventType,
+				errors.Validation("the manifest bundle in the resource bundle is invalid, %v", err))
+		}
+		scope := auth.ScopeFromContext(ctx)
+		if !scope.AllowsSource(resource.Source) || !scope.AllowsConsumer(resource.ConsumerName) {
+			return failedOperation(api.CreateEventType,
+				errors.Forbidden("not allowed to create resource bundles for consumer %s", resource.ConsumerName))
+		}
+		return services.ResourceOperation{Type: api.CreateEventType, Resource: resource}
+	case batchActionUpdate:
+		if operation.Patch == nil {
+			return failedOperation(api.UpdateEventType, errors.Validation("patch is required to update a resource bundle"))
+		}
+		if operation.Patch.Version <= 0 {
+			return failedOperation(api.UpdateEventType, errors.Validation("version must be a positive integer"))
+		}
+		found, serviceErr := h.getAuthorizedResource(ctx, operation.GetId())
+		if serviceErr != nil {
+			return failedOperation(api.UpdateEventType, serviceErr)
+		}
+		resource, serviceErr := patchResourceBundle(found, operation.Patch)
+		if serviceErr != nil {
+			return failedOperation(api.UpdateEventType, serviceErr)
+		}
+		return services.ResourceOperation{Type: api.UpdateEventType, Resource: resource}
+	case batchActionDelete:
+		found, serviceErr := h.getAuthorizedResource(ctx, operation.GetId())
+		if serviceErr != nil {
+			return failedOperation(api.DeleteEventType, serviceErr)
+		}
+		return services.ResourceOperation{Type: api.DeleteEventType, Resource: found}
+	default:
+		return failedOperation("", errors.Validation("unsupported action %q, the action must be one of %s, %s and %s",
+			operation.Action, batchActionCreate, batchActionUpdate, batchActionDelete))
+	}
+}
+
+// getAuthorizedResource gets the resource to update or delete in a batch.
+func (h resourceBundleHandler) getAuthorizedResource(ctx context.Context, id string) (*api.Resource, *errors.ServiceError) {
+	if id == "" {
+		return nil, errors.Validation("id is required")
+	}
+	found, serviceErr := h.resource.Get(ctx, id)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	if serviceErr := authorizeResource(ctx, found); serviceErr != nil {
+		return nil, serviceErr
+	}
+	return found, nil
+}
+
+func failedOperation(eventType api.EventType, err *errors.ServiceError) services.ResourceOperation {
+	return services.ResourceOperation{Type: eventType, Error: err}
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// IsDryRunRequest returns true if the request is a dry run of a resource bundle create or update.
+func IsDryRunRequest(r *http.Request) bool {
+	return (r.Method == http.MethodPost || r.Method == http.MethodPatch || r.Method == http.MethodPut) && r.URL.Query().Get("dryRun") == "true"
+}
+
+// dryRun returns the changes that the create or update of the resource would apply, nothing is applied.
+func (h resourceBundleHandler) dryRun(ctx context.Context, action api.EventType, resource *api.Resource) (interface{}, *errors.ServiceError) {
+	diff, serviceErr := h.resource.DryRun(ctx, action, resource)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+	return presenters.PresentResourceDiff(diff), nil
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// manifestBundlePatchType returns the patch type of the request if its content type is a JSON patch or a JSON merge
+// patch, the other requests are the ResourceBundlePatchRequest.
+func manifestBundlePatchType(r *http.Request) (api.PatchType, bool) {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return "", false
+	}
+	switch patchType := api.PatchType(mediaType); patchType {
+	case api.JSONPatchType, api.MergePatchType:
+		return patchType, true
+	default:
+		return "", false
+	}
+}
+
+// patchManifestBundle applies the JSON patch or JSON merge patch in the request body to the manifest bundle of the
+// resource bundle. The patch is applied by the update of the resource to its latest manifest bundle, so the
+// concurrent changes are not overwritten.
+func (h resourceBundleHandler) patchManifestBundle(w http.ResponseWriter, r *http.Request, patchType api.PatchType) {
+	var patch json.RawMessage
+
+	cfg := &handlerConfig{
+		&patch,
+		[]validate{},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			found, serviceErr := h.resource.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			if serviceErr := authorizeResource(ctx, found); serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			resource := &api.Resource{
+				Meta:         api.Meta{ID: found.ID},
+				ConsumerName: found.ConsumerName,
+				Patch:        patch,
+				PatchType:    patchType,
+			}
+			if IsDryRunRequest(r) {
+				return h.dryRun(ctx, api.UpdateEventType, resource)
+			}
+
+			resource, serviceErr = h.resource.Update(ctx, resource)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			updated, err := presenters.PresentResourceBundle(resource)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+			}
+			return updated, nil
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
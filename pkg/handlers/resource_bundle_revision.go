@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// Revisions responds the revision history of the resource bundle, the latest revision first.
+func (h resourceBundleHandler) Revisions(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			id := mux.Vars(r)["id"]
+			ctx := r.Context()
+			resource, serviceErr := h.resource.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			if serviceErr := authorizeResource(ctx, resource); serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			revisions, serviceErr := h.resource.Revisions(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			revisionList := openapi.ResourceBundleRevisionList{
+				Kind:  *presenters.ObjectKind(revisions),
+				Page:  1,
+				Size:  int32(len(revisions)),
+				Total: int32(len(revisions)),
+				Items: []openapi.ResourceBundleRevision{},
+			}
+			for _, revision := range revisions {
+				presented, err := presenters.PresentResourceRevision(revision)
+				if err != nil {
+					return nil, errors.GeneralError("failed to present resource bundle revision: %s", err)
+				}
+				revisionList.Items = append(revisionList.Items, *presented)
+			}
+			return revisionList, nil
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
+
+// Rollback re-applies the manifest bundle of a revision as a new version of the resource bundle.
+func (h resourceBundleHandler) Rollback(w http.ResponseWriter, r *http.Request) {
+	var rollback openapi.ResourceBundleRollbackRequest
+	cfg := &handlerConfig{
+		&rollback,
+		[]validate{
+			validateVersionPositive(&rollback.Version),
+		},
+		func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			found, serviceErr := h.resource.Get(ctx, id)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			if serviceErr := authorizeResource(ctx, found); serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			resource, serviceErr := h.resource.Rollback(ctx, id, rollback.Version)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+			rolledBack, err := presenters.PresentResourceBundle(resource)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+			}
+			return rolledBack, nil
+		},
+		handleError,
+	}
+
+	handle(w, r, cfg, http.StatusOK)
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/event"
+)
+
+const (
+	// defaultWaitTimeout is the wait timeout if the request does not specify one.
+	defaultWaitTimeout = 30 * time.Second
+	// maxWaitTimeout is the longest wait timeout that a request can specify.
+	maxWaitTimeout = 5 * time.Minute
+	// waitWriteTimeout is the time to write the response after the wait.
+	waitWriteTimeout = 30 * time.Second
+)
+
+// IsWaitRequest returns true if the request waits for the rollout phase of a resource bundle.
+func IsWaitRequest(r *http.Request) bool {
+	return r.Method == http.MethodGet && r.URL.Query().Get("waitFor") != ""
+}
+
+// wait returns the resource bundle once it reaches the rollout phase of the waitFor parameter. If the timeout
+// expires first, the resource bundle is returned as it is, the client should check its rollout phase.
+func (h resourceBundleHandler) wait(w http.ResponseWriter, r *http.Request) {
+	cfg := &handlerConfig{
+		Action: func() (interface{}, *errors.ServiceError) {
+			ctx := r.Context()
+			id := mux.Vars(r)["id"]
+			waitFor, timeout, serviceErr := parseWaitParams(r)
+			if serviceErr != nil {
+				return nil, serviceErr
+			}
+
+			// register the watcher before getting the resource bundle to avoid missing the changes
+			changed := make(chan struct{}, 1)
+			watcherID := h.watcher.Register(ctx, func(evt *event.WatchEvent) {
+				if evt.ResourceID != id {
+					return
+				}
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			})
+			defer h.watcher.Unregister(ctx, watcherID)
+
+			// the wait may be longer than the write timeout of the server
+			_ = http.NewResponseController(w).SetWriteDeadline(time.Now().Add(timeout + waitWriteTimeout))
+
+			timer := time.NewTimer(timeout)
+			defer timer.Stop()
+			for {
+				resource, serviceErr := h.resource.Get(ctx, id)
+				if serviceErr != nil {
+					return nil, serviceErr
+				}
+				if serviceErr := authorizeResource(ctx, resource); serviceErr != nil {
+					return nil, serviceErr
+				}
+
+				rollout, err := api.NewResourceBundleRollout(resource)
+				if err != nil {
+					return nil, errors.GeneralError("failed to compute the rollout of resource bundle: %s", err)
+				}
+				if rollout.Phase.Reached(waitFor) {
+					return presentWaitedResourceBundle(resource)
+				}
+
+				select {
+				case <-ctx.Done():
+					return nil, errors.GeneralError("the request is canceled while waiting for the resource bundle")
+				case <-timer.C:
+					return presentWaitedResourceBundle(resource)
+				case <-changed:
+				}
+			}
+		},
+	}
+
+	handleGet(w, r, cfg)
+}
+
+func presentWaitedResourceBundle(resource *api.Resource) (interface{}, *errors.ServiceError) {
+	rb, err := presenters.PresentResourceBundle(resource)
+	if err != nil {
+		return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+	}
+	return rb, nil
+}
+
+func parseWaitParams(r *http.Request) (api.RolloutPhase, time.Duration, *errors.ServiceError) {
+	waitFor, err := api.ParseRolloutPhase(r.URL.Query().Get("waitFor"))
+	if err != nil {
+		return "", 0, errors.Validation("invalid waitFor parameter, %v", err)
+	}
+
+	timeout := defaultWaitTimeout
+	if value := r.URL.Query().Get("timeout"); value != "" {
+		timeout, err = time.ParseDuration(value)
+		if err != nil {
+			return "", 0, errors.Validation("invalid timeout parameter, %v", err)
+		}
+		if timeout <= 0 || timeout > maxWaitTimeout {
+			return "", 0, errors.Validation("the timeout must be positive and not longer than %s", maxWaitTimeout)
+		}
+	}
+	return waitFor, timeout, nil
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/api/presenters"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/event"
+	"github.com/openshift-online/maestro/pkg/services"
+)
+
+// watchEventBufferSize is the number of the resource change events that can be buffered for a watcher,
+// the watch stream is closed if the watcher cannot keep up with the changes, the client should
+// restart the watch to resync the resource bundles.
+const watchEventBufferSize = 100
+
+// resourceBundleWatchEvent is the representation of a resource bundle watch event in the stream.
+type resourceBundleWatchEvent struct {
+	Type   event.WatchEventType    `json:"type"`
+	Object *openapi.ResourceBundle `json:"object"`
+}
+
+// IsWatchRequest returns true if the request is a watch request.
+func IsWatchRequest(r *http.Request) bool {
+	return r.Method == http.MethodGet && r.URL.Query().Get("watch") == "true"
+}
+
+// watch streams the changes of the resource bundles matching the search criteria of the request.
+// It starts with an ADDED event for each existing resource bundle, and then streams the ADDED,
+// MODIFIED and DELETED events until the client goes away. The events are sent as server-sent
+// events if the client accepts text/event-stream, otherwise as newline-delimited JSON.
+func (h resourceBundleHandler) watch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := klog.FromContext(ctx)
+
+	listArgs := services.NewListArguments(r.URL.Query())
+	listArgs.Scope = auth.ScopeFromContext(ctx)
+
+	// register the watcher before listing the existing resource bundles to avoid missing the changes
+	events := make(chan *event.WatchEvent, watchEventBufferSize)
+	overflowed := make(chan struct{})
+	var overflowOnce sync.Once
+	watcherID := h.watcher.Register(ctx, func(evt *event.WatchEvent) {
+		select {
+		case events <- evt:
+		default:
+			overflowOnce.Do(func() { close(overflowed) })
+		}
+	})
+	defer h.watcher.Unregister(ctx, watcherID)
+
+	existing, serviceErr := h.listAll(r, listArgs)
+	if serviceErr != nil {
+		handleError(ctx, w, serviceErr)
+		return
+	}
+
+	stream := newWatchStream(w, r)
+	watched := map[string]bool{}
+	for _, rb := range existing {
+		watched[*rb.Id] = true
+		if err := stream.send(event.WatchEventAdded, rb); err != nil {
+			logger.Error(err, "failed to send resource bundle watch event")
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-overflowed:
+			logger.Info("resource bundle watcher cannot keep up with the changes, closing the watch stream")
+			return
+		case evt := <-events:
+			eventType, rb, serviceErr := h.resolveWatchEvent(r, listArgs, evt, watched[evt.ResourceID])
+			if serviceErr != nil {
+				logger.Error(serviceErr, "failed to resolve resource bundle watch event", "resourceID", evt.ResourceID)
+				return
+			}
+			if rb == nil {
+				// the resource bundle does not match the search criteria
+				continue
+			}
+
+			if eventType == event.WatchEventDeleted {
+				delete(watched, evt.ResourceID)
+			} else {
+				watched[evt.ResourceID] = true
+			}
+			if err := stream.send(eventType, rb); err != nil {
+				logger.Error(err, "failed to send resource bundle watch event")
+				return
+			}
+		}
+	}
+}
+
+// listAll lists all of the resource bundles matching the search criteria page by page.
+func (h resourceBundleHandler) listAll(r *http.Request, listArgs *services.ListArguments) ([]*openapi.ResourceBundle, *errors.ServiceError) {
+	args := *listArgs
+	args.Page = 1
+
+	items := []*openapi.ResourceBundle{}
+	for {
+		var resources []api.Resource
+		paging, serviceErr := h.resource.ListWithArgs(r.Context(), auth.UsernameFromContext(r.Context()), &args, &resources)
+		if serviceErr != nil {
+			return nil, serviceErr
+		}
+		for _, resource := range resources {
+			rb, err := presenters.PresentResourceBundle(&resource)
+			if err != nil {
+				return nil, errors.GeneralError("failed to present resource bundle: %s", err)
+			}
+			items = append(items, rb)
+		}
+		if paging.Continue != "" {
+			// continue after the last resource bundle of the page, so that no resource bundle is missed or
+			// listed twice when the resource bundles are created or deleted during the list
+			args.Continue = paging.Continue
+			continue
+		}
+		if len(args.OrderBy) == 0 || paging.Size == 0 || int64(len(items)) >= paging.Total {
+			return items, nil
+		}
+		args.Page++
+	}
+}
+
+// resolveWatchEvent resolves the watch event type and the resource bundle of a resource change event
+// according to the search criteria, a nil resource bundle is returned if the resource bundle is not
+// watched by the request.
+func (h resourceBundleHandler) resolveWatchEvent(r *http.Request, listArgs *services.ListArguments,
+	evt *event.WatchEvent, watched bool) (event.WatchEventType, *openapi.ResourceBundle, *errors.ServiceError) {
+	if evt.Type == event.WatchEventDeleted {
+		if !watched {
+			return evt.Type, nil, nil
+		}
+		return evt.Type, presentDeletedResourceBundle(evt.ResourceID), nil
+	}
+
+	// the resource bundle matches the search criteria if it can be listed with the search criteria
+	args := *listArgs
+	args.Page = 1
+	args.Size = 1
+	args.IDs = []string{evt.ResourceID}
+	var resources []api.Resource
+	if _, serviceErr := h.resource.ListWithArgs(r.Context(), auth.UsernameFromContext(r.Context()), &args, &resources); serviceErr != nil {
+		return evt.Type, nil, serviceErr
+	}
+
+	if len(resources) == 0 {
+		if watched {
+			// the resource bundle was deleted or no longer matches the search criteria
+			return event.WatchEventDeleted, presentDeletedResourceBundle(evt.ResourceID), nil
+		}
+		return evt.Type, nil, nil
+	}
+
+	rb, err := presenters.PresentResourceBundle(&resources[0])
+	if err != nil {
+		return evt.Type, nil, errors.GeneralError("failed to present resource bundle: %s", err)
+	}
+
+	if !watched {
+		// the resource bundle is new to the watcher
+		return event.WatchEventAdded, rb, nil
+	}
+	return event.WatchEventModified, rb, nil
+}
+
+func presentDeletedResourceBundle(id string) *openapi.ResourceBundle {
+	reference := presenters.PresentReference(id, &api.Resource{})
+	return &openapi.ResourceBundle{
+		Id:   reference.Id,
+		Kind: reference.Kind,
+		Href: reference.Href,
+	}
+}
+
+// watchStream writes the resource bundle watch events to the response.
+type watchStream struct {
+	w   http.ResponseWriter
+	rc  *http.ResponseController
+	sse bool
+}
+
+func newWatchStream(w http.ResponseWriter, r *http.Request) *watchStream {
+	sse := strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+	if sse {
+		w.Header().Set("Content-Type", "text/event-stream")
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Vary", "Authorization")
+	w.WriteHeader(http.StatusOK)
+
+	stream := &watchStream{w: w, rc: http.NewResponseController(w), sse: sse}
+	// flush the headers so that the client knows the watch is started
+	_ = stream.rc.Flush()
+	return stream
+}
+
+func (s *watchStream) send(eventType event.WatchEventType, rb *openapi.ResourceBundle) error {
+	data, err := json.Marshal(&resourceBundleWatchEvent{Type: eventType, Object: rb})
+	if err != nil {
+		return err
+	}
+
+	if s.sse {
+		_, err = fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", eventType, data)
+	} else {
+		_, err = fmt.Fprintf(s.w, "%s\n", data)
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.rc.Flush()
+}
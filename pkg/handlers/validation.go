@@ -3,7 +3,10 @@ package handlers
 import (
 	"reflect"
 
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
 	"github.com/openshift-online/maestro/pkg/errors"
+	"github.com/openshift-online/maestro/pkg/services"
 )
 
 func validateNotEmpty(i interface{}, fieldName string, field string) validate {
@@ -37,3 +40,53 @@ func validateEmpty(i interface{}, fieldName string, field string) validate {
 		return nil
 	}
 }
+
+func validateManifestsNotEmpty(manifests *[]map[string]interface{}) validate {
+	return func() *errors.ServiceError {
+		if len(*manifests) == 0 {
+			return errors.Validation("manifests must specify at least one item")
+		}
+		return nil
+	}
+}
+
+func validateVersionPositive(version *int32) validate {
+	return func() *errors.ServiceError {
+		if *version <= 0 {
+			return errors.Validation("version must be a positive integer")
+		}
+		return nil
+	}
+}
+
+func validateVersionRequired(version **int32) validate {
+	return func() *errors.ServiceError {
+		if *version == nil {
+			return errors.Validation("version is required")
+		}
+		return validateVersionPositive(*version)()
+	}
+}
+
+func validateConsumerDeletionPolicy(value string, policy *api.ConsumerDeletionPolicy) validate {
+	return func() *errors.ServiceError {
+		parsed, err := api.ParseConsumerDeletionPolicy(value)
+		if err != nil {
+			return errors.Validation("%s", err)
+		}
+		*policy = parsed
+		return nil
+	}
+}
+
+func validateBatchOperations(operations *[]openapi.ResourceBundleBatchOperation) validate {
+	return func() *errors.ServiceError {
+		if len(*operations) == 0 {
+			return errors.Validation("operations must specify at least one item")
+		}
+		if len(*operations) > services.MaxBatchOperations {
+			return errors.Validation("operations must not specify more than %d items", services.MaxBatchOperations)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,309 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	e "errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/config"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// AdmissionOperation is the write operation that is admitted.
+type AdmissionOperation string
+
+const (
+	AdmissionCreate AdmissionOperation = "CREATE"
+	AdmissionUpdate AdmissionOperation = "UPDATE"
+)
+
+// AdmissionRequest describes a resource bundle write to the admission plugins. It is posted to the admission
+// webhooks in an AdmissionReview.
+type AdmissionRequest struct {
+	// UID identifies the request, the webhooks must return it in the response.
+	UID          string             `json:"uid"`
+	Operation    AdmissionOperation `json:"operation"`
+	ResourceID   string             `json:"resourceID,omitempty"`
+	ResourceName string             `json:"resourceName,omitempty"`
+	ConsumerName string             `json:"consumerName"`
+	// ConsumerLabels are the labels of the consumer, they are empty if the consumer does not exist.
+	ConsumerLabels map[string]string `json:"consumerLabels,omitempty"`
+	// Source is the source ID of the resource bundle.
+	Source string `json:"source,omitempty"`
+	// Version is the current version of the resource bundle, it is 0 on create.
+	Version int32 `json:"version,omitempty"`
+	// Username is the user who requests the write, it is empty if the write is not requested by a user.
+	Username string `json:"username,omitempty"`
+	// DryRun is true if the write is not applied, the plugins must not have side effects then.
+	DryRun bool `json:"dryRun"`
+	// Object is the manifest bundle to write, the mutating plugins may change it.
+	Object *AdmissionManifestBundle `json:"object"`
+	// OldObject is the current manifest bundle of the resource bundle, it is nil on create.
+	OldObject *AdmissionManifestBundle `json:"oldObject,omitempty"`
+}
+
+// AdmissionManifestBundle is the manifest bundle of a resource bundle in the same form as the REST API.
+type AdmissionManifestBundle struct {
+	Metadata        map[string]interface{}   `json:"metadata,omitempty"`
+	Manifests       []map[string]interface{} `json:"manifests"`
+	ManifestConfigs []map[string]interface{} `json:"manifest_configs,omitempty"`
+	DeleteOption    map[string]interface{}   `json:"delete_option,omitempty"`
+}
+
+// AdmissionPlugin is an admission plugin that runs in the resource bundle writes, it must implement
+// MutatingAdmissionPlugin or ValidatingAdmissionPlugin or both.
+type AdmissionPlugin interface {
+	Name() string
+}
+
+// MutatingAdmissionPlugin may change the manifest bundle of the request object. The mutating plugins run
+// one by one before the validating plugins.
+type MutatingAdmissionPlugin interface {
+	AdmissionPlugin
+	Mutate(ctx context.Context, request *AdmissionRequest) error
+}
+
+// ValidatingAdmissionPlugin admits or rejects the request, it must not change the request.
+type ValidatingAdmissionPlugin interface {
+	AdmissionPlugin
+	Validate(ctx context.Context, request *AdmissionRequest) error
+}
+
+// AdmissionDeniedError is returned by the admission plugins to reject a request, other errors mean that the
+// plugin failed to admit the request.
+type AdmissionDeniedError struct {
+	// Plugin is the name of the plugin that rejects the request, it is set by the admission chain.
+	Plugin  string
+	Message string
+}
+
+func (err *AdmissionDeniedError) Error() string {
+	return fmt.Sprintf("the request is denied by the admission plugin %s, %s", err.Plugin, err.Message)
+}
+
+// NewAdmissionDeniedError returns the error for an admission plugin to reject a request with the message.
+func NewAdmissionDeniedError(format string, args ...interface{}) error {
+	return &AdmissionDeniedError{Message: fmt.Sprintf(format, args...)}
+}
+
+var (
+	admissionPluginsLock sync.RWMutex
+	admissionPlugins     = map[string]AdmissionPlugin{}
+)
+
+// RegisterAdmissionPlugin registers an in-process admission plugin, the registered plugins are enabled by
+// their names in the admission config file. It is expected to be called in the init function of the plugin.
+func RegisterAdmissionPlugin(plugin AdmissionPlugin) {
+	admissionPluginsLock.Lock()
+	defer admissionPluginsLock.Unlock()
+
+	if _, ok := admissionPlugins[plugin.Name()]; ok {
+		panic(fmt.Sprintf("admission plugin %s is already registered", plugin.Name()))
+	}
+	admissionPlugins[plugin.Name()] = plugin
+}
+
+// AdmissionChain runs the mutating plugins and then the validating plugins on the resource bundle writes.
+// A nil chain admits all the requests.
+type AdmissionChain struct {
+	mutating   []MutatingAdmissionPlugin
+	validating []ValidatingAdmissionPlugin
+}
+
+// NewAdmissionChain returns the admission chain of the plugins, the plugins run in the given order.
+func NewAdmissionChain(plugins ...AdmissionPlugin) *AdmissionChain {
+	chain := &AdmissionChain{}
+	for _, plugin := range plugins {
+		if mutating, ok := plugin.(MutatingAdmissionPlugin); ok {
+			chain.mutating = append(chain.mutating, mutating)
+		}
+		if validating, ok := plugin.(ValidatingAdmissionPlugin); ok {
+			chain.validating = append(chain.validating, validating)
+		}
+	}
+	return chain
+}
+
+// NewAdmissionChainFromConfig returns the admission chain of the registered plugins, the CEL policies and the
+// webhooks in the config, the plugins run before the policies and the webhooks. It returns nil if nothing is
+// configured.
+func NewAdmissionChainFromConfig(cfg *config.AdmissionConfig, policyCfg *config.PolicyConfig) (*AdmissionChain, error) {
+	if len(cfg.Plugins) == 0 && len(cfg.Webhooks) == 0 && len(policyCfg.Policies) == 0 {
+		return nil, nil
+	}
+
+	plugins := []AdmissionPlugin{}
+	admissionPluginsLock.RLock()
+	for _, name := range cfg.Plugins {
+		plugin, ok := admissionPlugins[name]
+		if !ok {
+			admissionPluginsLock.RUnlock()
+			return nil, fmt.Errorf("the admission plugin %s is not registered", name)
+		}
+		plugins = append(plugins, plugin)
+	}
+	admissionPluginsLock.RUnlock()
+
+	if len(policyCfg.Policies) > 0 {
+		policies, err := NewPolicyAdmissionPlugin(policyCfg.Policies)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, policies)
+	}
+
+	for _, webhookConfig := range cfg.Webhooks {
+		webhook, err := NewAdmissionWebhook(webhookConfig)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, webhook)
+	}
+
+	return NewAdmissionChain(plugins...), nil
+}
+
+// Admit runs the admission plugins on the request. The request object is changed in place by the mutating
+// plugins. An AdmissionDeniedError is returned if a plugin rejects the request.
+func (c *AdmissionChain) Admit(ctx context.Context, request *AdmissionRequest) error {
+	if c == nil {
+		return nil
+	}
+
+	for _, plugin := range c.mutating {
+		if err := admissionError(plugin, plugin.Mutate(ctx, request)); err != nil {
+			return err
+		}
+	}
+	for _, plugin := range c.validating {
+		if err := admissionError(plugin, plugin.Validate(ctx, request)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func admissionError(plugin AdmissionPlugin, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var denied *AdmissionDeniedError
+	if e.As(err, &denied) {
+		return &AdmissionDeniedError{Plugin: plugin.Name(), Message: denied.Message}
+	}
+	return fmt.Errorf("the admission plugin %s failed, %v", plugin.Name(), err)
+}
+
+// admit runs the admission chain on the manifest bundle of the resource, the found resource is the current one
+// on update. It returns the admitted manifest bundle, which is re-encoded if a mutating plugin changed it.
+func (s *sqlResourceService) admit(ctx context.Context, operation AdmissionOperation, resource, found *api.Resource,
+	dryRun bool) (datatypes.JSONMap, *errors.ServiceError) {
+	if s.admission == nil {
+		return resource.Payload, nil
+	}
+
+	manifestBundle, err := api.DecodeManifestBundle(resource.Payload)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to decode the manifest bundle of the resource: %s", err)
+	}
+	request := &AdmissionRequest{
+		UID:          uuid.New().String(),
+		Operation:    operation,
+		ResourceID:   resource.ID,
+		ResourceName: resource.Name,
+		ConsumerName: resource.ConsumerName,
+		Source:       resource.Source,
+		Username:     auth.UsernameFromContext(ctx),
+		DryRun:       dryRun,
+		Object:       toAdmissionManifestBundle(manifestBundle),
+	}
+	if found != nil {
+		oldManifestBundle, err := api.DecodeManifestBundle(found.Payload)
+		if err != nil {
+			return nil, errors.GeneralError("Unable to decode the manifest bundle of resource %s: %s", found.ID, err)
+		}
+		request.ResourceName = found.Name
+		request.ConsumerName = found.ConsumerName
+		request.Source = found.Source
+		request.Version = found.Version
+		request.OldObject = toAdmissionManifestBundle(oldManifestBundle)
+	}
+
+	consumer, err := s.consumerDao.GetByName(ctx, request.ConsumerName)
+	if err != nil && !e.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.GeneralError("Unable to get consumer %s: %s", request.ConsumerName, err)
+	}
+	if consumer != nil && consumer.Labels != nil {
+		request.ConsumerLabels = *consumer.Labels
+	}
+
+	// keep the original object to find out whether the mutating plugins change it
+	original, err := json.Marshal(request.Object)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to marshal the manifest bundle of the resource: %s", err)
+	}
+
+	if err := s.admission.Admit(ctx, request); err != nil {
+		var denied *AdmissionDeniedError
+		if e.As(err, &denied) {
+			return nil, errors.Validation("the resource is rejected by the admission plugin %s, %s", denied.Plugin, denied.Message)
+		}
+		return nil, errors.GeneralError("Unable to admit the resource: %s", err)
+	}
+
+	if request.Object == nil {
+		return nil, errors.Validation("the manifest bundle is removed by the admission plugins")
+	}
+	admitted, err := json.Marshal(request.Object)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to marshal the admitted manifest bundle of the resource: %s", err)
+	}
+	if bytes.Equal(original, admitted) {
+		return resource.Payload, nil
+	}
+
+	// encode the mutated manifest bundle with the source of the original one
+	evt, err := api.JSONMAPToCloudEvent(resource.Payload)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to convert the manifest bundle of the resource to cloudevent: %s", err)
+	}
+	payload, err := api.EncodeManifestBundle(evt.Source(), fromAdmissionManifestBundle(request.Object))
+	if err != nil {
+		return nil, errors.Validation("the manifest bundle mutated by the admission plugins is invalid, %v", err)
+	}
+	if err := ValidateManifestBundle(payload); err != nil {
+		return nil, errors.Validation("the manifest bundle mutated by the admission plugins is invalid, %v", err)
+	}
+	return payload, nil
+}
+
+func toAdmissionManifestBundle(manifestBundle *api.ManifestBundleWrapper) *AdmissionManifestBundle {
+	if manifestBundle == nil {
+		return nil
+	}
+	return &AdmissionManifestBundle{
+		Metadata:        manifestBundle.Meta,
+		Manifests:       manifestBundle.Manifests,
+		ManifestConfigs: manifestBundle.ManifestConfigs,
+		DeleteOption:    manifestBundle.DeleteOption,
+	}
+}
+
+func fromAdmissionManifestBundle(manifestBundle *AdmissionManifestBundle) *api.ManifestBundleWrapper {
+	return &api.ManifestBundleWrapper{
+		Meta:            manifestBundle.Metadata,
+		Manifests:       manifestBundle.Manifests,
+		ManifestConfigs: manifestBundle.ManifestConfigs,
+		DeleteOption:    manifestBundle.DeleteOption,
+	}
+}
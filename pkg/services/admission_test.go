@@ -0,0 +1,279 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/config"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// labelAdmissionPlugin labels the manifests and rejects the manifests of the forbidden version.
+type labelAdmissionPlugin struct{}
+
+func (p *labelAdmissionPlugin) Name() string {
+	return "label"
+}
+
+func (p *labelAdmissionPlugin) Mutate(ctx context.Context, request *AdmissionRequest) error {
+	for _, manifest := range request.Object.Manifests {
+		metadata := manifest["metadata"].(map[string]interface{})
+		metadata["labels"] = map[string]interface{}{"admitted": "true"}
+	}
+	return nil
+}
+
+func (p *labelAdmissionPlugin) Validate(ctx context.Context, request *AdmissionRequest) error {
+	for _, manifest := range request.Object.Manifests {
+		if data, ok := manifest["data"].(map[string]interface{}); ok && data["version"] == "forbidden" {
+			return NewAdmissionDeniedError("the version is forbidden")
+		}
+	}
+	return nil
+}
+
+func TestResourceAdmission(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	consumerDAO := mocks.NewConsumerDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), mocks.NewResourceDao(), consumerDAO,
+		NewEventService(mocks.NewEventDao()), nil, ResourceServiceOptions{Admission: NewAdmissionChain(&labelAdmissionPlugin{})})
+	_, err := consumerDAO.Create(ctx, &api.Consumer{Name: Fukuisaurus})
+	gm.Expect(err).To(gm.BeNil())
+
+	// the manifests are mutated by the plugin
+	resource, svcErr := resourceService.Create(ctx, &api.Resource{
+		Meta:         api.Meta{ID: Breviceratops},
+		Version:      1,
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(admittedLabels(t, resource)).To(gm.Equal(map[string]interface{}{"admitted": "true"}))
+
+	// the rejection is a validation error with the message of the plugin
+	_, svcErr = resourceService.Create(ctx, &api.Resource{
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "forbidden"),
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorValidation))
+	gm.Expect(svcErr.Reason).To(gm.Equal("the resource is rejected by the admission plugin label, the version is forbidden"))
+
+	_, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: resource.Version,
+		Payload: newPlacementPayload(t, "forbidden"),
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorValidation))
+
+	updated, svcErr := resourceService.Update(ctx, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: resource.Version,
+		Payload: newPlacementPayload(t, "v2"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(updated.Version).To(gm.Equal(int32(2)))
+	gm.Expect(admittedLabels(t, updated)).To(gm.Equal(map[string]interface{}{"admitted": "true"}))
+
+	// the dry run reports the rejection as a validation error
+	diff, svcErr := resourceService.DryRun(ctx, api.UpdateEventType, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: updated.Version,
+		Payload: newPlacementPayload(t, "forbidden"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(diff.ValidationErrors).To(gm.ConsistOf("the resource is rejected by the admission plugin label, the version is forbidden"))
+}
+
+// racingAdmissionPlugin changes the resource while its update is admitted, the first races admissions race with
+// another update of the resource.
+type racingAdmissionPlugin struct {
+	resourceDAO interface {
+		Get(ctx context.Context, id string) (*api.Resource, error)
+		Update(ctx context.Context, resource *api.Resource) (*api.Resource, error)
+	}
+	races int
+}
+
+func (p *racingAdmissionPlugin) Name() string {
+	return "racing"
+}
+
+func (p *racingAdmissionPlugin) Validate(ctx context.Context, request *AdmissionRequest) error {
+	if request.Operation != AdmissionUpdate || p.races == 0 {
+		return nil
+	}
+	p.races--
+
+	found, err := p.resourceDAO.Get(ctx, request.ResourceID)
+	if err != nil {
+		return err
+	}
+	changed := *found
+	changed.Version++
+	_, err = p.resourceDAO.Update(ctx, &changed)
+	return err
+}
+
+func TestResourceAdmissionRace(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	resourceDAO := mocks.NewResourceDao()
+	plugin := &racingAdmissionPlugin{resourceDAO: resourceDAO}
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewConsumerDao(),
+		NewEventService(mocks.NewEventDao()), nil, ResourceServiceOptions{Admission: NewAdmissionChain(plugin)})
+
+	resource, svcErr := resourceService.Create(ctx, &api.Resource{
+		Meta:         api.Meta{ID: Breviceratops},
+		Version:      1,
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	// the patch without version is admitted again from the version changed during its admission
+	plugin.races = 1
+	resource, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:      api.Meta{ID: resource.ID},
+		Patch:     []byte(`[{"op":"replace","path":"/manifests/0/data/version","value":"v2"}]`),
+		PatchType: api.JSONPatchType,
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(int32(3)))
+	gm.Expect(configMapData(t, resource)).To(gm.Equal(map[string]interface{}{"version": "v2"}))
+
+	// the update of a version is a conflict if the version is changed during its admission
+	plugin.races = 1
+	_, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: resource.Version,
+		Payload: newPlacementPayload(t, "v3"),
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorConflict))
+
+	// the update fails after the resource is changed during each of its admissions
+	plugin.races = maxUpdateAttempts
+	_, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:      api.Meta{ID: resource.ID},
+		Patch:     []byte(`[{"op":"replace","path":"/manifests/0/data/version","value":"v4"}]`),
+		PatchType: api.JSONPatchType,
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorConflict))
+	gm.Expect(plugin.races).To(gm.Equal(0))
+}
+
+func TestAdmissionWebhook(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		review := &AdmissionReview{}
+		if err := json.NewDecoder(r.Body).Decode(review); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		response := &AdmissionResponse{UID: review.Request.UID, Allowed: true}
+		switch r.URL.Path {
+		case "/mutate":
+			response.PatchType = AdmissionPatchTypeJSONPatch
+			response.Patch = []byte(`[{"op":"add","path":"/manifests/0/metadata/labels","value":{"admitted":"true"}}]`)
+		case "/validate":
+			data := review.Request.Object.Manifests[0]["data"].(map[string]interface{})
+			if data["version"] == "forbidden" {
+				response.Allowed = false
+				response.Status = &AdmissionStatus{Code: http.StatusForbidden, Message: "the version is forbidden"}
+			}
+		case "/mismatch":
+			response.UID = "mismatch"
+		}
+		review.Response = response
+		review.Request = nil
+		_ = json.NewEncoder(w).Encode(review)
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	gm.Expect(os.WriteFile(caFile, caPEM, 0o600)).To(gm.Succeed())
+
+	newWebhook := func(path string, webhookType config.AdmissionWebhookType, failurePolicy config.AdmissionFailurePolicy) AdmissionPlugin {
+		webhook, err := NewAdmissionWebhook(config.AdmissionWebhookConfig{
+			Name:           path,
+			Type:           webhookType,
+			URL:            fmt.Sprintf("%s/%s", server.URL, path),
+			CAFile:         caFile,
+			TimeoutSeconds: 10,
+			FailurePolicy:  failurePolicy,
+		})
+		gm.Expect(err).To(gm.BeNil())
+		return webhook
+	}
+
+	_, err := NewAdmissionWebhook(config.AdmissionWebhookConfig{Name: "http", URL: "http://localhost/validate"})
+	gm.Expect(err).To(gm.MatchError("the url of admission webhook http must be https"))
+
+	consumerDAO := mocks.NewConsumerDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), mocks.NewResourceDao(), consumerDAO,
+		NewEventService(mocks.NewEventDao()), nil, ResourceServiceOptions{Admission: NewAdmissionChain(
+			newWebhook("validate", config.ValidatingAdmissionWebhook, config.AdmissionFailurePolicyFail),
+			newWebhook("mutate", config.MutatingAdmissionWebhook, config.AdmissionFailurePolicyFail),
+			newWebhook("mismatch", config.ValidatingAdmissionWebhook, config.AdmissionFailurePolicyIgnore),
+		)})
+	_, err = consumerDAO.Create(ctx, &api.Consumer{Name: Fukuisaurus})
+	gm.Expect(err).To(gm.BeNil())
+
+	// the patch of the mutating webhook is applied, the failure of the ignored webhook is ignored
+	resource, svcErr := resourceService.Create(ctx, &api.Resource{
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(admittedLabels(t, resource)).To(gm.Equal(map[string]interface{}{"admitted": "true"}))
+
+	_, svcErr = resourceService.Create(ctx, &api.Resource{
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "forbidden"),
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorValidation))
+	gm.Expect(svcErr.Reason).To(gm.Equal("the resource is rejected by the admission plugin validate, the version is forbidden"))
+
+	// the webhook that cannot be called fails the request
+	resourceService = NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), mocks.NewResourceDao(), consumerDAO,
+		NewEventService(mocks.NewEventDao()), nil, ResourceServiceOptions{Admission: NewAdmissionChain(
+			newWebhook("mismatch", config.ValidatingAdmissionWebhook, config.AdmissionFailurePolicyFail),
+		)})
+	_, svcErr = resourceService.Create(ctx, &api.Resource{
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorGeneral))
+}
+
+func admittedLabels(t *testing.T, resource *api.Resource) interface{} {
+	manifestBundle, err := api.DecodeManifestBundle(resource.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return manifestBundle.Manifests[0]["metadata"].(map[string]interface{})["labels"]
+}
@@ -0,0 +1,216 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/config"
+)
+
+const (
+	AdmissionReviewAPIVersion = "admission.maestro.io/v1"
+	AdmissionReviewKind       = "AdmissionReview"
+
+	// AdmissionPatchTypeJSONPatch is the only patch type that the mutating webhooks can return.
+	AdmissionPatchTypeJSONPatch = "JSONPatch"
+
+	// maxAdmissionResponseSize is the max size of the admission review returned by a webhook.
+	maxAdmissionResponseSize = 3 * 1024 * 1024
+)
+
+// AdmissionReview is posted to the admission webhooks with the request, the webhooks return it with the
+// response of the same UID.
+type AdmissionReview struct {
+	APIVersion string             `json:"apiVersion"`
+	Kind       string             `json:"kind"`
+	Request    *AdmissionRequest  `json:"request,omitempty"`
+	Response   *AdmissionResponse `json:"response,omitempty"`
+}
+
+// AdmissionResponse is the response of an admission webhook.
+type AdmissionResponse struct {
+	UID     string           `json:"uid"`
+	Allowed bool             `json:"allowed"`
+	Status  *AdmissionStatus `json:"status,omitempty"`
+	// Patch is the JSON patch to apply on the request object, it is returned by the mutating webhooks only.
+	Patch     []byte `json:"patch,omitempty"`
+	PatchType string `json:"patchType,omitempty"`
+}
+
+// AdmissionStatus is the reason why a webhook rejects the request.
+type AdmissionStatus struct {
+	Code    int32  `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// NewAdmissionWebhook returns the admission plugin that calls the webhook of the config, it is a mutating
+// or validating plugin according to the webhook type.
+func NewAdmissionWebhook(cfg config.AdmissionWebhookConfig) (AdmissionPlugin, error) {
+	webhookURL, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("the url of admission webhook %s is invalid, %v", cfg.Name, err)
+	}
+	if webhookURL.Scheme != "https" {
+		return nil, fmt.Errorf("the url of admission webhook %s must be https", cfg.Name)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CAFile != "" {
+		caPEM, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA file of admission webhook %s: %v", cfg.Name, err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no CA certificate is found in the CA file of admission webhook %s", cfg.Name)
+		}
+	}
+
+	webhook := &admissionWebhook{
+		name:          cfg.Name,
+		url:           webhookURL.String(),
+		failurePolicy: cfg.FailurePolicy,
+		client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			Timeout:   time.Duration(cfg.TimeoutSeconds) * time.Second,
+		},
+	}
+	if cfg.Type == config.MutatingAdmissionWebhook {
+		return &mutatingAdmissionWebhook{webhook}, nil
+	}
+	return &validatingAdmissionWebhook{webhook}, nil
+}
+
+type admissionWebhook struct {
+	name          string
+	url           string
+	failurePolicy config.AdmissionFailurePolicy
+	client        *http.Client
+}
+
+func (w *admissionWebhook) Name() string {
+	return w.name
+}
+
+// admit posts the request to the webhook, the returned response is nil if the webhook cannot be called and its
+// failure policy is Ignore. A rejected request is returned as an AdmissionDeniedError.
+func (w *admissionWebhook) admit(ctx context.Context, request *AdmissionRequest) (*AdmissionResponse, error) {
+	response, err := w.call(ctx, request)
+	if err != nil {
+		if w.failurePolicy == config.AdmissionFailurePolicyIgnore {
+			klog.FromContext(ctx).Error(err, "Failed to call admission webhook, ignore it", "webhook", w.name)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if !response.Allowed {
+		message := "the request is not allowed"
+		if response.Status != nil && response.Status.Message != "" {
+			message = response.Status.Message
+		}
+		return nil, NewAdmissionDeniedError("%s", message)
+	}
+	return response, nil
+}
+
+func (w *admissionWebhook) call(ctx context.Context, request *AdmissionRequest) (*AdmissionResponse, error) {
+	body, err := json.Marshal(&AdmissionReview{
+		APIVersion: AdmissionReviewAPIVersion,
+		Kind:       AdmissionReviewKind,
+		Request:    request,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal admission review: %v", err)
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create admission webhook request: %v", err)
+	}
+	httpRequest.Header.Set("Content-Type", "application/json")
+	httpRequest.Header.Set("Accept", "application/json")
+
+	httpResponse, err := w.client.Do(httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call admission webhook: %v", err)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("admission webhook returns unexpected status %d", httpResponse.StatusCode)
+	}
+
+	review := &AdmissionReview{}
+	if err := json.NewDecoder(io.LimitReader(httpResponse.Body, maxAdmissionResponseSize)).Decode(review); err != nil {
+		return nil, fmt.Errorf("failed to decode admission review: %v", err)
+	}
+	if review.Response == nil {
+		return nil, fmt.Errorf("admission webhook returns no response")
+	}
+	if review.Response.UID != request.UID {
+		return nil, fmt.Errorf("admission webhook returns the response of uid %q, expected %q", review.Response.UID, request.UID)
+	}
+	return review.Response, nil
+}
+
+type mutatingAdmissionWebhook struct {
+	*admissionWebhook
+}
+
+var _ MutatingAdmissionPlugin = &mutatingAdmissionWebhook{}
+
+// Mutate applies the JSON patch returned by the webhook on the request object.
+func (w *mutatingAdmissionWebhook) Mutate(ctx context.Context, request *AdmissionRequest) error {
+	response, err := w.admit(ctx, request)
+	if err != nil || response == nil || len(response.Patch) == 0 {
+		return err
+	}
+	if response.PatchType != AdmissionPatchTypeJSONPatch {
+		return fmt.Errorf("admission webhook returns unsupported patch type %q", response.PatchType)
+	}
+
+	patch, err := jsonpatch.DecodePatch(response.Patch)
+	if err != nil {
+		return fmt.Errorf("failed to decode the patch of admission webhook: %v", err)
+	}
+	object, err := json.Marshal(request.Object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the request object: %v", err)
+	}
+	patched, err := patch.Apply(object)
+	if err != nil {
+		return fmt.Errorf("failed to apply the patch of admission webhook: %v", err)
+	}
+
+	mutated := &AdmissionManifestBundle{}
+	if err := json.Unmarshal(patched, mutated); err != nil {
+		return fmt.Errorf("failed to unmarshal the patched request object: %v", err)
+	}
+	request.Object = mutated
+	return nil
+}
+
+type validatingAdmissionWebhook struct {
+	*admissionWebhook
+}
+
+var _ ValidatingAdmissionPlugin = &validatingAdmissionWebhook{}
+
+// Validate admits or rejects the request by the webhook, the patch in the response is ignored.
+func (w *validatingAdmissionWebhook) Validate(ctx context.Context, request *AdmissionRequest) error {
+	_, err := w.admit(ctx, request)
+	return err
+}
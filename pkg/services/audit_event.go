@@ -0,0 +1,270 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/config"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/errors"
+	loggertracing "github.com/openshift-online/maestro/pkg/logger"
+)
+
+// auditSinkQueueSize is the number of the audit events that wait to be delivered to the sinks, the audit events
+// are dropped from the sinks if the queue is full, they are still saved in the database.
+const auditSinkQueueSize = 1000
+
+// AuditEventService records the mutating operations on the resource bundles and the consumers.
+type AuditEventService interface {
+	// Record saves the audit event, the actor and the request ID of the event are set from the context. The event
+	// is delivered to the sinks once the writes of the context are committed.
+	Record(ctx context.Context, auditEvent *api.AuditEvent) *errors.ServiceError
+	// Start delivers the recorded audit events to the sinks until the context is done.
+	Start(ctx context.Context)
+}
+
+// AuditSink is an external destination of the audit events, e.g. a SIEM.
+type AuditSink interface {
+	Name() string
+	Write(ctx context.Context, record *AuditRecord) error
+}
+
+// AuditRecord is the representation of an audit event that is delivered to the sinks.
+type AuditRecord struct {
+	ID            string    `json:"id"`
+	Time          time.Time `json:"time"`
+	Actor         string    `json:"actor,omitempty"`
+	Source        string    `json:"source,omitempty"`
+	Action        string    `json:"action"`
+	TargetType    string    `json:"target_type"`
+	TargetID      string    `json:"target_id"`
+	VersionBefore int32     `json:"version_before"`
+	VersionAfter  int32     `json:"version_after"`
+	RequestID     string    `json:"request_id,omitempty"`
+}
+
+func NewAuditEventService(auditEventDao dao.AuditEventDao, sinks ...AuditSink) AuditEventService {
+	return &auditEventService{
+		auditEventDao: auditEventDao,
+		sinks:         sinks,
+		queue:         make(chan *AuditRecord, auditSinkQueueSize),
+	}
+}
+
+// NewAuditSinksFromConfig returns the sinks that are enabled in the config.
+func NewAuditSinksFromConfig(cfg *config.AuditConfig) ([]AuditSink, error) {
+	sinks := []AuditSink{}
+	if cfg.SinkFile != "" {
+		sink, err := NewAuditFileSink(cfg.SinkFile)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.SinkWebhookURL != "" {
+		sink, err := NewAuditWebhookSink(cfg.SinkWebhookURL, cfg.SinkWebhookCAFile, cfg.SinkWebhookTimeout)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+var _ AuditEventService = &auditEventService{}
+
+type auditEventService struct {
+	auditEventDao dao.AuditEventDao
+	sinks         []AuditSink
+	queue         chan *AuditRecord
+}
+
+func (s *auditEventService) Record(ctx context.Context, auditEvent *api.AuditEvent) *errors.ServiceError {
+	auditEvent.Actor = auth.UsernameFromContext(ctx)
+	auditEvent.RequestID = loggertracing.GetOperationID(ctx)
+	if _, err := s.auditEventDao.Create(ctx, auditEvent); err != nil {
+		return handleCreateError("AuditEvent", err)
+	}
+
+	if len(s.sinks) == 0 {
+		return nil
+	}
+
+	record := newAuditRecord(auditEvent)
+	db.AfterCommit(ctx, func() {
+		select {
+		case s.queue <- record:
+		default:
+			klog.FromContext(ctx).Info("The audit sink queue is full, drop the audit event from the sinks", "auditEventID", record.ID)
+			auditSinkEventsMetric.WithLabelValues("", auditSinkDropped).Inc()
+		}
+	})
+	return nil
+}
+
+func (s *auditEventService) Start(ctx context.Context) {
+	if len(s.sinks) == 0 {
+		return
+	}
+
+	logger := klog.FromContext(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case record := <-s.queue:
+			for _, sink := range s.sinks {
+				if err := sink.Write(ctx, record); err != nil {
+					logger.Error(err, "Failed to deliver the audit event", "sink", sink.Name(), "auditEventID", record.ID)
+					auditSinkEventsMetric.WithLabelValues(sink.Name(), auditSinkFailed).Inc()
+					continue
+				}
+				auditSinkEventsMetric.WithLabelValues(sink.Name(), auditSinkDelivered).Inc()
+			}
+		}
+	}
+}
+
+func newAuditRecord(auditEvent *api.AuditEvent) *AuditRecord {
+	return &AuditRecord{
+		ID:            auditEvent.ID,
+		Time:          auditEvent.CreatedAt,
+		Actor:         auditEvent.Actor,
+		Source:        auditEvent.Source,
+		Action:        string(auditEvent.Action),
+		TargetType:    auditEvent.TargetType,
+		TargetID:      auditEvent.TargetID,
+		VersionBefore: auditEvent.VersionBefore,
+		VersionAfter:  auditEvent.VersionAfter,
+		RequestID:     auditEvent.RequestID,
+	}
+}
+
+// recordAudit records the audit event with the audit service, nothing is recorded if the audit service is nil.
+func recordAudit(ctx context.Context, audit AuditEventService, auditEvent *api.AuditEvent) *errors.ServiceError {
+	if audit == nil {
+		return nil
+	}
+	return audit.Record(ctx, auditEvent)
+}
+
+// NewAuditFileSink returns the sink that appends the audit events to the file as JSON lines.
+func NewAuditFileSink(path string) (AuditSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the audit sink file %s: %v", path, err)
+	}
+	return &auditFileSink{file: file}, nil
+}
+
+type auditFileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func (s *auditFileSink) Name() string {
+	return "file"
+}
+
+func (s *auditFileSink) Write(ctx context.Context, record *AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %v", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+// NewAuditWebhookSink returns the sink that posts the audit events to the webhook as JSON.
+func NewAuditWebhookSink(url, caFile string, timeout time.Duration) (AuditSink, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the CA file of the audit sink webhook: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no CA certificate is found in the CA file of the audit sink webhook")
+		}
+	}
+
+	return &auditWebhookSink{
+		url: url,
+		client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+			Timeout:   timeout,
+		},
+	}, nil
+}
+
+type auditWebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s *auditWebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *auditWebhookSink) Write(ctx context.Context, record *AuditRecord) error {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit event: %v", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create audit webhook request: %v", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("failed to call audit webhook: %v", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("audit webhook returns unexpected status %d", response.StatusCode)
+	}
+	return nil
+}
+
+// The delivery results of the audit sink events metric.
+const (
+	auditSinkDelivered = "delivered"
+	auditSinkFailed    = "failed"
+	auditSinkDropped   = "dropped"
+)
+
+func init() {
+	prometheus.MustRegister(auditSinkEventsMetric)
+}
+
+// Description of the audit sink events metric, the sink label is empty for the dropped events:
+var auditSinkEventsMetric = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: "audit",
+		Name:      "sink_events_total",
+		Help:      "Number of the audit events that are delivered to the sinks, failed to be delivered or dropped.",
+	},
+	[]string{"sink", "result"},
+)
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/logger"
+)
+
+func TestAuditEventRecord(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sinkFile := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewAuditFileSink(sinkFile)
+	gm.Expect(err).To(gm.BeNil())
+
+	auditEventDAO := mocks.NewAuditEventDao()
+	auditEvents := NewAuditEventService(auditEventDAO, sink)
+	go auditEvents.Start(ctx)
+
+	consumerDAO := mocks.NewConsumerDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), mocks.NewResourceDao(), consumerDAO,
+		NewEventService(mocks.NewEventDao()), nil, ResourceServiceOptions{AuditEvents: auditEvents})
+	_, err = consumerDAO.Create(ctx, &api.Consumer{Name: Fukuisaurus})
+	gm.Expect(err).To(gm.BeNil())
+
+	// the actor and the request ID are taken from the context of the request
+	requestCtx := auth.NewContextWithIdentity(ctx, &auth.Identity{User: "alice"})
+	requestCtx = context.WithValue(requestCtx, logger.OpIDKey, "op-1")
+
+	resource, svcErr := resourceService.Create(requestCtx, &api.Resource{
+		Meta:         api.Meta{ID: Breviceratops},
+		Version:      1,
+		Source:       "maestro",
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	_, svcErr = resourceService.Update(requestCtx, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: resource.Version,
+		Payload: newPlacementPayload(t, "v2"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resourceService.MarkAsDeleting(requestCtx, resource.ID)).To(gm.BeNil())
+
+	recorded, err := auditEventDAO.FindByTargetID(ctx, resource.ID)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(recorded)).To(gm.Equal(3))
+	expected := []struct {
+		action        api.AuditAction
+		before, after int32
+	}{
+		{api.AuditActionCreate, 0, 1},
+		{api.AuditActionUpdate, 1, 2},
+		{api.AuditActionDelete, 2, 0},
+	}
+	for i, auditEvent := range recorded {
+		gm.Expect(auditEvent.Action).To(gm.Equal(expected[i].action))
+		gm.Expect(auditEvent.VersionBefore).To(gm.Equal(expected[i].before))
+		gm.Expect(auditEvent.VersionAfter).To(gm.Equal(expected[i].after))
+		gm.Expect(auditEvent.Actor).To(gm.Equal("alice"))
+		gm.Expect(auditEvent.Source).To(gm.Equal("maestro"))
+		gm.Expect(auditEvent.TargetType).To(gm.Equal(api.AuditTargetResourceBundle))
+		gm.Expect(auditEvent.RequestID).To(gm.Equal("op-1"))
+	}
+
+	// the writes are committed without a transaction, the audit events are delivered to the sink immediately
+	gm.Eventually(func() []string {
+		return readAuditRecordActions(t, sinkFile)
+	}, 5*time.Second, 100*time.Millisecond).Should(gm.Equal([]string{"create", "update", "delete"}))
+}
+
+func readAuditRecordActions(t *testing.T, sinkFile string) []string {
+	file, err := os.Open(sinkFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	actions := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := &AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			t.Fatal(err)
+		}
+		actions = append(actions, record.Action)
+	}
+	return actions
+}
@@ -3,6 +3,8 @@ package services
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/labels"
+
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/pkg/errors"
@@ -12,23 +14,34 @@ type ConsumerService interface {
 	Get(ctx context.Context, id string) (*api.Consumer, *errors.ServiceError)
 	Create(ctx context.Context, consumer *api.Consumer) (*api.Consumer, *errors.ServiceError)
 	Replace(ctx context.Context, consumer *api.Consumer) (*api.Consumer, *errors.ServiceError)
-	Delete(ctx context.Context, id string) *errors.ServiceError
+	Delete(ctx context.Context, id string, policy api.ConsumerDeletionPolicy) *errors.ServiceError
 	All(ctx context.Context) (api.ConsumerList, *errors.ServiceError)
 
 	FindByIDs(ctx context.Context, ids []string) (api.ConsumerList, *errors.ServiceError)
 	FindByNames(ctx context.Context, names []string) (api.ConsumerList, *errors.ServiceError)
+	FindByLabelSelector(ctx context.Context, selector labels.Selector) (api.ConsumerList, *errors.ServiceError)
 }
 
-func NewConsumerService(consumerDao dao.ConsumerDao) ConsumerService {
+func NewConsumerService(consumerDao dao.ConsumerDao, resourceDao dao.ResourceDao, placementDao dao.PlacementDao, resourceService ResourceService,
+	auditEvents AuditEventService) ConsumerService {
 	return &sqlConsumerService{
-		consumerDao: consumerDao,
+		consumerDao:     consumerDao,
+		resourceDao:     resourceDao,
+		placementDao:    placementDao,
+		resourceService: resourceService,
+		auditEvents:     auditEvents,
 	}
 }
 
 var _ ConsumerService = &sqlConsumerService{}
 
 type sqlConsumerService struct {
-	consumerDao dao.ConsumerDao
+	consumerDao     dao.ConsumerDao
+	resourceDao     dao.ResourceDao
+	placementDao    dao.PlacementDao
+	resourceService ResourceService
+	// auditEvents records the creates, updates and deletes of the consumers, nothing is recorded if it is nil.
+	auditEvents AuditEventService
 }
 
 func (s *sqlConsumerService) Get(ctx context.Context, id string) (*api.Consumer, *errors.ServiceError) {
@@ -50,6 +63,14 @@ func (s *sqlConsumerService) Create(ctx context.Context, consumer *api.Consumer)
 	if err != nil {
 		return nil, handleCreateError("Consumer", err)
 	}
+
+	if err := s.recordAudit(ctx, api.AuditActionCreate, consumer.ID); err != nil {
+		return nil, err
+	}
+
+	if err := s.notifyPlacements(ctx); err != nil {
+		return nil, err
+	}
 	return consumer, nil
 }
 
@@ -58,14 +79,94 @@ func (s *sqlConsumerService) Replace(ctx context.Context, consumer *api.Consumer
 	if err != nil {
 		return nil, handleUpdateError("Consumer", err)
 	}
+
+	if err := s.recordAudit(ctx, api.AuditActionUpdate, consumer.ID); err != nil {
+		return nil, err
+	}
+
+	// the labels of the consumer may be changed, the placements select the consumers by their labels
+	if err := s.notifyPlacements(ctx); err != nil {
+		return nil, err
+	}
 	return consumer, nil
 }
 
-// Delete will remove the consumer from the storage:
-// 1. Perform a hard delete on the consumer, the resource creation will be blocked after it.
-// 2. Forbid consumer deletion if there are associated resources(include the marked as deleted resources).
-// TODO: Add deletion options or strategies.
-func (s *sqlConsumerService) Delete(ctx context.Context, id string) *errors.ServiceError {
+// Delete will remove the consumer from the storage with the given deletion policy:
+//   - Restrict: Perform a hard delete on the consumer, the resource creation will be blocked after it. The deletion
+//     is forbidden if there are associated resources (include the marked as deleted resources).
+//   - Cascade: Mark the consumer and all of its resources as deleting, the resource creation will be blocked after it.
+//     The consumer is removed once the agent confirms the deletion of its last resource.
+//   - Orphan: Perform a hard delete on all of the resources of the consumer without telling the agent, and then
+//     perform a hard delete on the consumer. It is the force deletion, there is no separate force policy.
+func (s *sqlConsumerService) Delete(ctx context.Context, id string, policy api.ConsumerDeletionPolicy) *errors.ServiceError {
+	var serviceErr *errors.ServiceError
+	switch policy {
+	case api.ConsumerDeletionPolicyCascade:
+		serviceErr = s.cascadeDelete(ctx, id)
+	case api.ConsumerDeletionPolicyOrphan:
+		serviceErr = s.orphanDelete(ctx, id)
+	default:
+		if err := s.consumerDao.Delete(ctx, id, true); err != nil {
+			serviceErr = handleDeleteError("Consumer", err)
+		}
+	}
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if serviceErr := s.recordAudit(ctx, api.AuditActionDelete, id); serviceErr != nil {
+		return serviceErr
+	}
+
+	return s.notifyPlacements(ctx)
+}
+
+func (s *sqlConsumerService) cascadeDelete(ctx context.Context, id string) *errors.ServiceError {
+	consumer, err := s.consumerDao.Get(ctx, id)
+	if err != nil {
+		return handleGetError("Consumer", "id", id, err)
+	}
+
+	// mark the consumer as deleting first, so that no resources can be created on it afterward
+	if err := s.consumerDao.Delete(ctx, id, false); err != nil {
+		return handleDeleteError("Consumer", err)
+	}
+
+	resources, err := s.resourceDao.FindByConsumerName(ctx, consumer.Name)
+	if err != nil {
+		return errors.GeneralError("Unable to find resources of consumer %s: %s", consumer.Name, err)
+	}
+
+	if len(resources) == 0 {
+		if err := s.consumerDao.Delete(ctx, id, true); err != nil {
+			return handleDeleteError("Consumer", err)
+		}
+		return nil
+	}
+
+	for _, resource := range resources {
+		if !resource.DeletedAt.Time.IsZero() {
+			// the resource is already being deleted
+			continue
+		}
+		if svcErr := s.resourceService.MarkAsDeleting(ctx, resource.ID); svcErr != nil {
+			return svcErr
+		}
+	}
+
+	return nil
+}
+
+func (s *sqlConsumerService) orphanDelete(ctx context.Context, id string) *errors.ServiceError {
+	consumer, err := s.consumerDao.Get(ctx, id)
+	if err != nil {
+		return handleGetError("Consumer", "id", id, err)
+	}
+
+	if err := s.resourceDao.DeleteByConsumerName(ctx, consumer.Name); err != nil {
+		return handleDeleteError("Resource", err)
+	}
+
 	if err := s.consumerDao.Delete(ctx, id, true); err != nil {
 		return handleDeleteError("Consumer", err)
 	}
@@ -88,6 +189,44 @@ func (s *sqlConsumerService) FindByNames(ctx context.Context, names []string) (a
 	return consumers, nil
 }
 
+// FindByLabelSelector returns the consumers whose labels match the selector, the consumers that are being
+// deleted are not returned.
+func (s *sqlConsumerService) FindByLabelSelector(ctx context.Context, selector labels.Selector) (api.ConsumerList, *errors.ServiceError) {
+	consumers, err := s.consumerDao.All(ctx)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to find consumers by label selector %s: %s", selector, err)
+	}
+
+	selected := api.ConsumerList{}
+	for _, consumer := range consumers {
+		consumerLabels := labels.Set{}
+		if consumer.Labels != nil {
+			consumerLabels = labels.Set(*consumer.Labels)
+		}
+		if selector.Matches(consumerLabels) {
+			selected = append(selected, consumer)
+		}
+	}
+	return selected, nil
+}
+
+// recordAudit records the audit event of the operation on the consumer.
+func (s *sqlConsumerService) recordAudit(ctx context.Context, action api.AuditAction, id string) *errors.ServiceError {
+	return recordAudit(ctx, s.auditEvents, &api.AuditEvent{
+		Action:     action,
+		TargetType: api.AuditTargetConsumer,
+		TargetID:   id,
+	})
+}
+
+// notifyPlacements notifies all of the placements to reconcile their resources since the consumers are changed.
+func (s *sqlConsumerService) notifyPlacements(ctx context.Context) *errors.ServiceError {
+	if err := s.placementDao.Notify(ctx, ""); err != nil {
+		return errors.GeneralError("Unable to notify placements: %s", err)
+	}
+	return nil
+}
+
 func (s *sqlConsumerService) All(ctx context.Context) (api.ConsumerList, *errors.ServiceError) {
 	consumers, err := s.consumerDao.All(ctx)
 	if err != nil {
@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// ConsumerHeartbeatService tracks the liveness of the consumer agents. The signals observed by the instance are kept
+// in memory and saved periodically, so the status updates of the agents do not cause extra writes each.
+type ConsumerHeartbeatService interface {
+	// Connect records that the agent of the consumer is connected to the instance, the agent is seen on each save
+	// until it is disconnected.
+	Connect(consumerName string)
+	Disconnect(consumerName string)
+	// RecordHeartbeat records a signal from the agent of the consumer, e.g. a resync request.
+	RecordHeartbeat(consumerName, agentVersion string)
+	// RecordStatus records a resource status received from the agent of the consumer.
+	RecordStatus(consumerName, agentVersion string)
+	// Flush saves the signals observed since the last save.
+	Flush(ctx context.Context) *errors.ServiceError
+	// Start saves the signals and refreshes the disconnected consumers metric periodically until the context is done.
+	Start(ctx context.Context)
+
+	// FindStatuses returns the agent statuses of the consumers keyed by the consumer names.
+	FindStatuses(ctx context.Context, consumerNames []string) (map[string]*api.ConsumerStatus, *errors.ServiceError)
+}
+
+func NewConsumerHeartbeatService(instanceID string, heartbeatDao dao.ConsumerHeartbeatDao, consumerDao dao.ConsumerDao,
+	interval, timeout time.Duration) ConsumerHeartbeatService {
+	return &consumerHeartbeatService{
+		instanceID:   instanceID,
+		heartbeatDao: heartbeatDao,
+		consumerDao:  consumerDao,
+		interval:     interval,
+		timeout:      timeout,
+		connections:  map[string]int{},
+		pending:      map[string]*api.ConsumerHeartbeat{},
+	}
+}
+
+var _ ConsumerHeartbeatService = &consumerHeartbeatService{}
+
+type consumerHeartbeatService struct {
+	sync.Mutex
+
+	instanceID   string
+	heartbeatDao dao.ConsumerHeartbeatDao
+	consumerDao  dao.ConsumerDao
+	interval     time.Duration
+	timeout      time.Duration
+	// connections is the number of the broker connections of each consumer agent
+	connections map[string]int
+	// pending are the heartbeats observed since the last save
+	pending map[string]*api.ConsumerHeartbeat
+}
+
+func (s *consumerHeartbeatService) Connect(consumerName string) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.connections[consumerName]++
+	s.pendingHeartbeat(consumerName, time.Now()).Connected = true
+}
+
+func (s *consumerHeartbeatService) Disconnect(consumerName string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.connections[consumerName] > 1 {
+		s.connections[consumerName]--
+		return
+	}
+	delete(s.connections, consumerName)
+	s.pendingHeartbeat(consumerName, time.Now()).Connected = false
+}
+
+func (s *consumerHeartbeatService) RecordHeartbeat(consumerName, agentVersion string) {
+	s.Lock()
+	defer s.Unlock()
+
+	heartbeat := s.pendingHeartbeat(consumerName, time.Now())
+	heartbeat.Connected = true
+	if agentVersion != "" {
+		heartbeat.AgentVersion = agentVersion
+	}
+}
+
+func (s *consumerHeartbeatService) RecordStatus(consumerName, agentVersion string) {
+	s.Lock()
+	defer s.Unlock()
+
+	now := time.Now()
+	heartbeat := s.pendingHeartbeat(consumerName, now)
+	heartbeat.Connected = true
+	heartbeat.LastStatusReceived = &now
+	if agentVersion != "" {
+		heartbeat.AgentVersion = agentVersion
+	}
+}
+
+func (s *consumerHeartbeatService) Flush(ctx context.Context) *errors.ServiceError {
+	s.Lock()
+	now := time.Now()
+	// the connected agents are seen on each save
+	for consumerName := range s.connections {
+		s.pendingHeartbeat(consumerName, now).Connected = true
+	}
+	heartbeats := api.ConsumerHeartbeatList{}
+	for _, heartbeat := range s.pending {
+		heartbeats = append(heartbeats, heartbeat)
+	}
+	s.pending = map[string]*api.ConsumerHeartbeat{}
+	s.Unlock()
+
+	// save the heartbeats in a stable order to avoid the deadlocks between the instances
+	sort.Slice(heartbeats, func(i, j int) bool {
+		return heartbeats[i].ConsumerName < heartbeats[j].ConsumerName
+	})
+	if err := s.heartbeatDao.Upsert(ctx, heartbeats); err != nil {
+		return errors.GeneralError("Unable to save consumer heartbeats: %s", err)
+	}
+	return nil
+}
+
+func (s *consumerHeartbeatService) Start(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Flush(ctx); err != nil {
+				logger.Error(err, "Failed to save consumer heartbeats")
+			}
+			if err := s.refreshDisconnectedConsumersMetric(ctx); err != nil {
+				logger.Error(err, "Failed to refresh disconnected consumers metric")
+			}
+		}
+	}
+}
+
+func (s *consumerHeartbeatService) FindStatuses(ctx context.Context, consumerNames []string) (map[string]*api.ConsumerStatus, *errors.ServiceError) {
+	heartbeats, err := s.heartbeatDao.FindByConsumerNames(ctx, consumerNames)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to find consumer heartbeats: %s", err)
+	}
+	return s.aggregate(consumerNames, heartbeats), nil
+}
+
+// refreshDisconnectedConsumersMetric counts the consumers whose agents are not connected to any instance.
+func (s *consumerHeartbeatService) refreshDisconnectedConsumersMetric(ctx context.Context) *errors.ServiceError {
+	consumers, err := s.consumerDao.All(ctx)
+	if err != nil {
+		return errors.GeneralError("Unable to get all consumers: %s", err)
+	}
+	heartbeats, err := s.heartbeatDao.All(ctx)
+	if err != nil {
+		return errors.GeneralError("Unable to get all consumer heartbeats: %s", err)
+	}
+
+	consumerNames := []string{}
+	for _, consumer := range consumers {
+		consumerNames = append(consumerNames, consumer.Name)
+	}
+	disconnected := 0
+	for _, status := range s.aggregate(consumerNames, heartbeats) {
+		if !status.Connected {
+			disconnected++
+		}
+	}
+	disconnectedConsumersGaugeMetric.Set(float64(disconnected))
+	return nil
+}
+
+// aggregate returns the statuses of the consumers, a consumer without heartbeats has never been seen.
+func (s *consumerHeartbeatService) aggregate(consumerNames []string, heartbeats api.ConsumerHeartbeatList) map[string]*api.ConsumerStatus {
+	heartbeatsByConsumer := map[string]api.ConsumerHeartbeatList{}
+	for _, heartbeat := range heartbeats {
+		heartbeatsByConsumer[heartbeat.ConsumerName] = append(heartbeatsByConsumer[heartbeat.ConsumerName], heartbeat)
+	}
+
+	now := time.Now()
+	statuses := map[string]*api.ConsumerStatus{}
+	for _, consumerName := range consumerNames {
+		statuses[consumerName] = api.NewConsumerStatus(heartbeatsByConsumer[consumerName], now, s.timeout)
+	}
+	return statuses
+}
+
+// pendingHeartbeat returns the heartbeat of the consumer to be saved, the heartbeat is seen at the given time.
+// The caller must hold the lock.
+func (s *consumerHeartbeatService) pendingHeartbeat(consumerName string, now time.Time) *api.ConsumerHeartbeat {
+	heartbeat, ok := s.pending[consumerName]
+	if !ok {
+		heartbeat = &api.ConsumerHeartbeat{
+			ConsumerName: consumerName,
+			InstanceID:   s.instanceID,
+		}
+		s.pending[consumerName] = heartbeat
+	}
+	heartbeat.LastHeartbeat = now
+	return heartbeat
+}
+
+func init() {
+	prometheus.MustRegister(disconnectedConsumersGaugeMetric)
+}
+
+// Description of the disconnected consumers gauge metric:
+var disconnectedConsumersGaugeMetric = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Subsystem: "consumers",
+		Name:      "disconnected",
+		Help:      "Number of consumers whose agent is not connected to any maestro instance.",
+	},
+)
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gm "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+)
+
+func TestConsumerHeartbeats(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	consumerDAO := mocks.NewConsumerDao()
+	heartbeatDAO := mocks.NewConsumerHeartbeatDao()
+	for _, name := range []string{"grpc-agent", "mqtt-agent", "never-seen"} {
+		_, err := consumerDAO.Create(ctx, &api.Consumer{Meta: api.Meta{ID: name}, Name: name})
+		gm.Expect(err).To(gm.BeNil())
+	}
+
+	instance1 := NewConsumerHeartbeatService("instance-1", heartbeatDAO, consumerDAO, time.Second, time.Minute)
+	instance2 := NewConsumerHeartbeatService("instance-2", heartbeatDAO, consumerDAO, time.Second, time.Minute)
+
+	// the gRPC agent is connected to the first instance and sends statuses to the second one
+	instance1.Connect("grpc-agent")
+	instance2.RecordStatus("grpc-agent", "v1.0.0")
+	// the MQTT agent only sends resync requests
+	instance1.RecordHeartbeat("mqtt-agent", "")
+	gm.Expect(instance1.Flush(ctx)).To(gm.BeNil())
+	gm.Expect(instance2.Flush(ctx)).To(gm.BeNil())
+
+	statuses, svcErr := instance1.FindStatuses(ctx, []string{"grpc-agent", "mqtt-agent", "never-seen"})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(statuses["grpc-agent"].Connected).To(gm.BeTrue())
+	gm.Expect(statuses["grpc-agent"].LastStatusReceived).NotTo(gm.BeNil())
+	gm.Expect(statuses["grpc-agent"].AgentVersion).To(gm.Equal("v1.0.0"))
+	gm.Expect(statuses["mqtt-agent"].Connected).To(gm.BeTrue())
+	gm.Expect(statuses["mqtt-agent"].LastStatusReceived).To(gm.BeNil())
+	gm.Expect(statuses["never-seen"].Connected).To(gm.BeFalse())
+	gm.Expect(statuses["never-seen"].LastSeen).To(gm.BeNil())
+
+	// the connected agent is seen on each save, and the version is kept when it is not reported again
+	instance2.RecordStatus("grpc-agent", "")
+	gm.Expect(instance2.Flush(ctx)).To(gm.BeNil())
+	gm.Expect(instance1.Flush(ctx)).To(gm.BeNil())
+	statuses, svcErr = instance1.FindStatuses(ctx, []string{"grpc-agent"})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(statuses["grpc-agent"].AgentVersion).To(gm.Equal("v1.0.0"))
+
+	// the disconnected agent is not connected anymore, though it is still seen by the second instance
+	instance1.Disconnect("grpc-agent")
+	gm.Expect(instance1.Flush(ctx)).To(gm.BeNil())
+	heartbeats, err := heartbeatDAO.FindByConsumerNames(ctx, []string{"grpc-agent"})
+	gm.Expect(err).To(gm.BeNil())
+	for _, heartbeat := range heartbeats {
+		if heartbeat.InstanceID == "instance-1" {
+			gm.Expect(heartbeat.Connected).To(gm.BeFalse())
+		}
+	}
+
+	// the MQTT agent is disconnected after the timeout
+	heartbeats, err = heartbeatDAO.FindByConsumerNames(ctx, []string{"mqtt-agent"})
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(heartbeats)).To(gm.Equal(1))
+	heartbeats[0].LastHeartbeat = time.Now().Add(-2 * time.Minute)
+
+	svc := instance1.(*consumerHeartbeatService)
+	gm.Expect(svc.refreshDisconnectedConsumersMetric(ctx)).To(gm.BeNil())
+	// the gRPC agent is still connected from the second instance within the timeout
+	gm.Expect(testutil.ToFloat64(disconnectedConsumersGaugeMetric)).To(gm.Equal(float64(2)))
+}
+
+func TestNewConsumerStatus(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	now := time.Now()
+	earlier := now.Add(-time.Minute)
+	stale := now.Add(-time.Hour)
+
+	status := api.NewConsumerStatus(api.ConsumerHeartbeatList{
+		{InstanceID: "instance-1", Connected: true, LastHeartbeat: stale, AgentVersion: "v0.9.0"},
+		{InstanceID: "instance-2", Connected: false, LastHeartbeat: now},
+		{InstanceID: "instance-3", Connected: true, LastHeartbeat: earlier, LastStatusReceived: &earlier, AgentVersion: "v1.0.0"},
+	}, now, 5*time.Minute)
+	gm.Expect(status.Connected).To(gm.BeTrue())
+	gm.Expect(*status.LastSeen).To(gm.Equal(now))
+	gm.Expect(*status.LastStatusReceived).To(gm.Equal(earlier))
+	gm.Expect(status.AgentVersion).To(gm.Equal("v1.0.0"))
+
+	// the stale heartbeats are treated as disconnected
+	status = api.NewConsumerStatus(api.ConsumerHeartbeatList{
+		{InstanceID: "instance-1", Connected: true, LastHeartbeat: stale},
+	}, now, 5*time.Minute)
+	gm.Expect(status.Connected).To(gm.BeFalse())
+	gm.Expect(*status.LastSeen).To(gm.Equal(stale))
+}
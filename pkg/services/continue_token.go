@@ -0,0 +1,77 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// continueToken is the position of the last object of a page in the list ordered by (created_at, id), the next
+// page starts after it. It is encoded as an opaque string for the clients.
+type continueToken struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+	// legacyPage is the page number of a legacy continue value. Before the continue tokens, the clients continued
+	// a list with the number of its next page, the number is still accepted for one release and the list continues
+	// from the page.
+	legacyPage int
+}
+
+func encodeContinueToken(token continueToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeContinueToken(value string) (*continueToken, *errors.ServiceError) {
+	// an encoded token is never a number, since it starts with the encoded "{"
+	if page, err := strconv.Atoi(value); err == nil {
+		if page < 1 {
+			return nil, errors.BadRequest("invalid continue page %d", page)
+		}
+		return &continueToken{legacyPage: page}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.BadRequest("invalid continue token %q", value)
+	}
+
+	token := &continueToken{}
+	if err := json.Unmarshal(data, token); err != nil || token.ID == "" {
+		return nil, errors.BadRequest("invalid continue token %q", value)
+	}
+	return token, nil
+}
+
+// continueTokenOf returns the continue token after the last object of the resource list, resourceList must be a
+// pointer to a slice of the objects that embed api.Meta.
+func continueTokenOf(resourceList interface{}) (string, *errors.ServiceError) {
+	list := reflect.ValueOf(resourceList).Elem()
+	if list.Len() == 0 {
+		return "", nil
+	}
+
+	last := reflect.Indirect(list.Index(list.Len() - 1))
+	field := last.FieldByName("Meta")
+	if !field.IsValid() {
+		return "", errors.GeneralError("Unable to build the continue token of %s", last.Type())
+	}
+	meta, ok := field.Interface().(api.Meta)
+	if !ok {
+		return "", errors.GeneralError("Unable to build the continue token of %s", last.Type())
+	}
+
+	token, err := encodeContinueToken(continueToken{CreatedAt: meta.CreatedAt, ID: meta.ID})
+	if err != nil {
+		return "", errors.GeneralError("Unable to build the continue token: %s", err)
+	}
+	return token, nil
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+func TestContinueToken(t *testing.T) {
+	RegisterTestingT(t)
+
+	createdAt := time.Date(2026, 10, 17, 8, 30, 0, 123456000, time.UTC)
+	resources := []api.Resource{
+		{Meta: api.Meta{ID: "a", CreatedAt: createdAt.Add(-time.Second)}},
+		{Meta: api.Meta{ID: "b", CreatedAt: createdAt}},
+	}
+
+	// the token is built from the last object of the list
+	value, serviceErr := continueTokenOf(&resources)
+	Expect(serviceErr).ToNot(HaveOccurred())
+	Expect(value).ToNot(BeEmpty())
+
+	token, serviceErr := decodeContinueToken(value)
+	Expect(serviceErr).ToNot(HaveOccurred())
+	Expect(token.ID).To(Equal("b"))
+	Expect(token.CreatedAt.Equal(createdAt)).To(BeTrue())
+
+	// no token is built for an empty list
+	value, serviceErr = continueTokenOf(&[]api.Resource{})
+	Expect(serviceErr).ToNot(HaveOccurred())
+	Expect(value).To(BeEmpty())
+
+	// the legacy page number is accepted
+	token, serviceErr = decodeContinueToken("3")
+	Expect(serviceErr).ToNot(HaveOccurred())
+	Expect(token.legacyPage).To(Equal(3))
+
+	// the invalid tokens are rejected
+	for _, invalid := range []string{"garbage!", "bm90IGpzb24", "e30", "0", "-1"} {
+		_, serviceErr = decodeContinueToken(invalid)
+		Expect(serviceErr).To(HaveOccurred())
+		Expect(serviceErr.Code).To(Equal(errors.ErrorBadRequest))
+	}
+}
@@ -11,6 +11,7 @@ import (
 type EventService interface {
 	Get(ctx context.Context, id string) (*api.Event, *errors.ServiceError)
 	Create(ctx context.Context, event *api.Event) (*api.Event, *errors.ServiceError)
+	CreateBatch(ctx context.Context, events api.EventList) (api.EventList, *errors.ServiceError)
 	Replace(ctx context.Context, event *api.Event) (*api.Event, *errors.ServiceError)
 	Delete(ctx context.Context, id string) *errors.ServiceError
 	All(ctx context.Context) (api.EventList, *errors.ServiceError)
@@ -18,7 +19,9 @@ type EventService interface {
 	FindByIDs(ctx context.Context, ids []string) (api.EventList, *errors.ServiceError)
 
 	FindAllUnreconciledEvents(ctx context.Context) (api.EventList, *errors.ServiceError)
-	DeleteAllReconciledEvents(ctx context.Context) *errors.ServiceError
+	// NotifyUnreconciledEvents notifies the unreconciled events of the resources on the consumer again, so that
+	// they are handled by the instance that the agent of the consumer connects to.
+	NotifyUnreconciledEvents(ctx context.Context, consumerName string) *errors.ServiceError
 }
 
 func NewEventService(eventDao dao.EventDao) EventService {
@@ -49,6 +52,14 @@ func (s *sqlEventService) Create(ctx context.Context, event *api.Event) (*api.Ev
 	return event, nil
 }
 
+func (s *sqlEventService) CreateBatch(ctx context.Context, events api.EventList) (api.EventList, *errors.ServiceError) {
+	events, err := s.eventDao.CreateBatch(ctx, events)
+	if err != nil {
+		return nil, handleCreateError("Event", err)
+	}
+	return events, nil
+}
+
 func (s *sqlEventService) Replace(ctx context.Context, event *api.Event) (*api.Event, *errors.ServiceError) {
 	event, err := s.eventDao.Replace(ctx, event)
 	if err != nil {
@@ -88,9 +99,9 @@ func (s *sqlEventService) FindAllUnreconciledEvents(ctx context.Context) (api.Ev
 	return events, nil
 }
 
-func (s *sqlEventService) DeleteAllReconciledEvents(ctx context.Context) *errors.ServiceError {
-	if err := s.eventDao.DeleteAllReconciledEvents(ctx); err != nil {
-		return handleDeleteError("Event", errors.GeneralError("Unable to delete reconciled events: %s", err))
+func (s *sqlEventService) NotifyUnreconciledEvents(ctx context.Context, consumerName string) *errors.ServiceError {
+	if err := s.eventDao.NotifyUnreconciledByConsumerName(ctx, consumerName); err != nil {
+		return errors.GeneralError("Unable to notify unreconciled events of consumer %s: %s", consumerName, err)
 	}
 	return nil
 }
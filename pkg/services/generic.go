@@ -52,6 +52,8 @@ type listContext struct {
 	joins            map[string]dao.TableRelation
 	groupBy          []string
 	set              map[string]struct{}
+	// legacyPage is the page number of a legacy continue value, the list is fetched from the page.
+	legacyPage int
 }
 
 func (s *sqlGenericService) newListContext(ctx context.Context, username string, args *ListArguments, resourceList interface{}) (*listContext, interface{}, *errors.ServiceError) {
@@ -91,6 +93,18 @@ func (s *sqlGenericService) List(ctx context.Context, username string, args *Lis
 		// add "ORDER BY"
 		s.buildOrderBy,
 
+		// add "WHERE (created_at, id) >" if the list continues from a previous page.
+		s.buildContinue,
+
+		// add "WHERE id IN" if the ids are specified.
+		s.buildIDs,
+
+		// add "WHERE source IN" and "WHERE consumer_name IN" if the caller is restricted to a scope.
+		s.buildScope,
+
+		// translate "labelSelector" and "fieldSelector" into parameterised "WHERE"(s).
+		s.buildSelectors,
+
 		// translate "search" into "WHERE"(s), and "JOIN"(s) if related resource is searched.
 		s.buildSearch,
 
@@ -133,14 +147,108 @@ func (s *sqlGenericService) buildPreload(listCtx *listContext, d *dao.GenericDao
 }
 
 func (s *sqlGenericService) buildOrderBy(listCtx *listContext, d *dao.GenericDao) (bool, *errors.ServiceError) {
+	if len(listCtx.args.OrderBy) == 0 {
+		// order by (created_at, id) by default, so that the list can continue after the last object of a page
+		tableName := (*d).GetTableName()
+		(*d).OrderBy(fmt.Sprintf("%s.created_at, %s.id", tableName, tableName))
+		return false, nil
+	}
+
+	orderByArgs, serviceErr := db.ArgsToOrderBy(listCtx.args.OrderBy, *listCtx.disallowedFields)
+	if serviceErr != nil {
+		return false, serviceErr
+	}
+	for _, orderByArg := range orderByArgs {
+		(*d).OrderBy(orderByArg)
+	}
+	return false, nil
+}
+
+func (s *sqlGenericService) buildContinue(listCtx *listContext, d *dao.GenericDao) (bool, *errors.ServiceError) {
+	if listCtx.args.Continue == "" {
+		return false, nil
+	}
+
+	token, serviceErr := decodeContinueToken(listCtx.args.Continue)
+	if serviceErr != nil {
+		return false, serviceErr
+	}
+
+	if token.legacyPage > 0 {
+		klog.FromContext(listCtx.ctx).Info("The page number as the continue value is deprecated, use the continue token of the list instead",
+			"continue", listCtx.args.Continue)
+		listCtx.legacyPage = token.legacyPage
+		listCtx.pagingMeta.Page = token.legacyPage
+		return false, nil
+	}
+
 	if len(listCtx.args.OrderBy) != 0 {
-		orderByArgs, serviceErr := db.ArgsToOrderBy(listCtx.args.OrderBy, *listCtx.disallowedFields)
+		return false, errors.BadRequest("continue cannot be used with orderBy")
+	}
+
+	tableName := (*d).GetTableName()
+	(*d).Where(fmt.Sprintf("(%s.created_at, %s.id) > (?, ?)", tableName, tableName), []interface{}{token.CreatedAt, token.ID})
+	return false, nil
+}
+
+func (s *sqlGenericService) buildIDs(listCtx *listContext, d *dao.GenericDao) (bool, *errors.ServiceError) {
+	if len(listCtx.args.IDs) != 0 {
+		(*d).Where(fmt.Sprintf("%s.id IN (?)", (*d).GetTableName()), []interface{}{listCtx.args.IDs})
+	}
+	return false, nil
+}
+
+func (s *sqlGenericService) buildScope(listCtx *listContext, d *dao.GenericDao) (bool, *errors.ServiceError) {
+	scope := listCtx.args.Scope
+	if scope == nil {
+		return false, nil
+	}
+
+	tableName := (*d).GetTableName()
+	switch listCtx.resourceType {
+	case "Resource":
+		if scope.Sources != nil {
+			(*d).Where(fmt.Sprintf("%s.source IN (?)", tableName), []interface{}{scope.Sources})
+		}
+		if scope.Consumers != nil {
+			(*d).Where(fmt.Sprintf("%s.consumer_name IN (?)", tableName), []interface{}{scope.Consumers})
+		}
+	case "Consumer":
+		if scope.Consumers != nil {
+			(*d).Where(fmt.Sprintf("%s.name IN (?)", tableName), []interface{}{scope.Consumers})
+		}
+	}
+	return false, nil
+}
+
+func (s *sqlGenericService) buildSelectors(listCtx *listContext, d *dao.GenericDao) (bool, *errors.ServiceError) {
+	args := listCtx.args
+	if args.LabelSelector == "" && args.FieldSelector == "" {
+		return false, nil
+	}
+	if listCtx.resourceType != "Resource" {
+		return false, errors.BadRequest("labelSelector and fieldSelector are only supported by resource bundles")
+	}
+
+	tableName := (*d).GetTableName()
+	conditions := []sqlCondition{}
+	if args.LabelSelector != "" {
+		labelConditions, serviceErr := labelSelectorToSQL(tableName+".payload -> 'metadata' -> 'labels'", args.LabelSelector)
 		if serviceErr != nil {
 			return false, serviceErr
 		}
-		for _, orderByArg := range orderByArgs {
-			(*d).OrderBy(orderByArg)
+		conditions = append(conditions, labelConditions...)
+	}
+	if args.FieldSelector != "" {
+		fieldConditions, serviceErr := resourceFieldSelectorToSQL(tableName, args.FieldSelector)
+		if serviceErr != nil {
+			return false, serviceErr
 		}
+		conditions = append(conditions, fieldConditions...)
+	}
+
+	for _, condition := range conditions {
+		(*d).Where(condition.sql, condition.values)
 	}
 	return false, nil
 }
@@ -221,7 +329,11 @@ func (s *sqlGenericService) loadList(listCtx *listContext, d *dao.GenericDao) *e
 	args := listCtx.args
 	logger := klog.FromContext(listCtx.ctx)
 
-	(*d).Count(listCtx.resourceList, &listCtx.pagingMeta.Total)
+	if args.SkipTotal {
+		listCtx.pagingMeta.Total = -1
+	} else {
+		(*d).Count(listCtx.resourceList, &listCtx.pagingMeta.Total)
+	}
 
 	// Set resourceList to be an empty slice with zero capacity. Real space will be allocated by g2.Find()
 	if err := zeroSlice(listCtx.resourceList, 0); err != nil {
@@ -242,7 +354,14 @@ func (s *sqlGenericService) loadList(listCtx *listContext, d *dao.GenericDao) *e
 
 	// NOTE: Limit no longer supports '0' size and will cause issues. There is an early return, do not remove it.
 	//       https://github.com/go-gorm/gorm/blob/master/clause/limit.go#L18-L21
-	if err := (*d).Fetch((args.Page-1)*int(args.Size), int(args.Size), listCtx.resourceList); err != nil {
+	offset := (args.Page - 1) * int(args.Size)
+	if listCtx.legacyPage > 0 {
+		offset = (listCtx.legacyPage - 1) * int(args.Size)
+	} else if args.Continue != "" {
+		// the previous pages are skipped by the continue token
+		offset = 0
+	}
+	if err := (*d).Fetch(offset, int(args.Size), listCtx.resourceList); err != nil {
 		if e.Is(err, gorm.ErrRecordNotFound) {
 			listCtx.pagingMeta.Size = 0
 		} else {
@@ -251,6 +370,15 @@ func (s *sqlGenericService) loadList(listCtx *listContext, d *dao.GenericDao) *e
 	}
 	listCtx.pagingMeta.Size = int64(reflect.ValueOf(listCtx.resourceList).Elem().Len())
 
+	// a full page ordered by (created_at, id) may be followed by more objects
+	if len(args.OrderBy) == 0 && args.Size > 0 && listCtx.pagingMeta.Size == args.Size {
+		token, serviceErr := continueTokenOf(listCtx.resourceList)
+		if serviceErr != nil {
+			return serviceErr
+		}
+		listCtx.pagingMeta.Continue = token
+	}
+
 	return nil
 }
 
@@ -0,0 +1,297 @@
+package services
+
+import (
+	"context"
+	e "errors"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/constants"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+type PlacementService interface {
+	Get(ctx context.Context, id string) (*api.Placement, *errors.ServiceError)
+	Create(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError)
+	Replace(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError)
+	Delete(ctx context.Context, id string) *errors.ServiceError
+	All(ctx context.Context) (api.PlacementList, *errors.ServiceError)
+
+	// Reconcile makes the resources of the placement match the consumers that the placement selects.
+	Reconcile(ctx context.Context, id string) *errors.ServiceError
+}
+
+func NewPlacementService(lockFactory db.LockFactory, placementDao dao.PlacementDao, resourceDao dao.ResourceDao,
+	consumerService ConsumerService, resourceService ResourceService) PlacementService {
+	return &sqlPlacementService{
+		lockFactory:     lockFactory,
+		placementDao:    placementDao,
+		resourceDao:     resourceDao,
+		consumerService: consumerService,
+		resourceService: resourceService,
+	}
+}
+
+var _ PlacementService = &sqlPlacementService{}
+
+type sqlPlacementService struct {
+	lockFactory     db.LockFactory
+	placementDao    dao.PlacementDao
+	resourceDao     dao.ResourceDao
+	consumerService ConsumerService
+	resourceService ResourceService
+}
+
+func (s *sqlPlacementService) Get(ctx context.Context, id string) (*api.Placement, *errors.ServiceError) {
+	placement, err := s.placementDao.Get(ctx, id)
+	if err != nil {
+		return nil, handleGetError("Placement", "id", id, err)
+	}
+	return placement, nil
+}
+
+func (s *sqlPlacementService) Create(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError) {
+	if err := ValidatePlacement(placement); err != nil {
+		return nil, errors.Validation("the placement is invalid, %v", err)
+	}
+	if serviceErr := validatePlacementPayload(placement); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	placement, err := s.placementDao.Create(ctx, placement)
+	if err != nil {
+		return nil, handleCreateError("Placement", err)
+	}
+
+	if err := s.placementDao.Notify(ctx, placement.ID); err != nil {
+		return nil, handleCreateError("Placement", err)
+	}
+	return placement, nil
+}
+
+func (s *sqlPlacementService) Replace(ctx context.Context, placement *api.Placement) (*api.Placement, *errors.ServiceError) {
+	if !placement.DeletedAt.Time.IsZero() {
+		return nil, errors.Conflict("the placement is under deletion, id: %s", placement.ID)
+	}
+	if err := ValidatePlacement(placement); err != nil {
+		return nil, errors.Validation("the placement is invalid, %v", err)
+	}
+	if serviceErr := validatePlacementPayload(placement); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	placement, err := s.placementDao.Replace(ctx, placement)
+	if err != nil {
+		return nil, handleUpdateError("Placement", err)
+	}
+
+	if err := s.placementDao.Notify(ctx, placement.ID); err != nil {
+		return nil, handleUpdateError("Placement", err)
+	}
+	return placement, nil
+}
+
+// Delete marks the placement as deleting, the reconciler marks all of the resources of the placement as deleting
+// and removes the placement once the agents confirm the deletion of its last resource.
+func (s *sqlPlacementService) Delete(ctx context.Context, id string) *errors.ServiceError {
+	if err := s.placementDao.Delete(ctx, id, false); err != nil {
+		return handleDeleteError("Placement", err)
+	}
+
+	if err := s.placementDao.Notify(ctx, id); err != nil {
+		return handleDeleteError("Placement", err)
+	}
+	return nil
+}
+
+func (s *sqlPlacementService) All(ctx context.Context) (api.PlacementList, *errors.ServiceError) {
+	placements, err := s.placementDao.All(ctx)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to get all placements: %s", err)
+	}
+	return placements, nil
+}
+
+// Reconcile creates a resource from the placement for each of the selected consumers that has no resource of the
+// placement, updates the resources whose manifest bundle differs from the placement, and marks the resources on the
+// consumers that are no longer selected as deleting. If the placement is being deleted, all of its resources are
+// marked as deleting and the placement is removed once it has no resources. The manifest bundle of a templated
+// placement is rendered for each of the consumers, so a resource is updated when its consumer labels change too.
+//
+// A resource that is being deleted is left as it is, it is created again by a later reconciliation after the agent
+// confirms its deletion if its consumer is still selected.
+func (s *sqlPlacementService) Reconcile(ctx context.Context, id string) *errors.ServiceError {
+	// The placement may be reconciled by multiple instances at the same time, the advisory lock is used here to
+	// reconcile a placement by one instance at a time.
+	lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, id, db.Placements)
+	// Ensure that the transaction related to this lock always end.
+	defer s.lockFactory.Unlock(ctx, lockOwnerID)
+	if err != nil {
+		return errors.DatabaseAdvisoryLock(err)
+	}
+
+	placement, err := s.placementDao.Get(ctx, id)
+	if err != nil {
+		if e.Is(err, gorm.ErrRecordNotFound) {
+			// the placement is already removed
+			return nil
+		}
+		return handleGetError("Placement", "id", id, err)
+	}
+
+	resources, err := s.resourceDao.FindByPlacementID(ctx, id)
+	if err != nil {
+		return errors.GeneralError("Unable to find resources of placement %s: %s", placement.Name, err)
+	}
+
+	if !placement.DeletedAt.Time.IsZero() {
+		return s.removePlacement(ctx, placement, resources)
+	}
+
+	selector, err := labels.Parse(placement.ConsumerSelector)
+	if err != nil {
+		return errors.Validation("the consumer selector of placement %s is invalid, %v", placement.Name, err)
+	}
+	consumers, serviceErr := s.consumerService.FindByLabelSelector(ctx, selector)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	manifestBundle, err := api.DecodeManifestBundle(placement.Payload)
+	if err != nil {
+		return errors.GeneralError("Unable to decode the manifest bundle of placement %s: %s", placement.Name, err)
+	}
+	template, err := newPlacementTemplate(placement)
+	if err != nil {
+		return errors.Validation("the template of placement %s is invalid, %v", placement.Name, err)
+	}
+
+	existing := map[string]*api.Resource{}
+	for _, resource := range resources {
+		existing[resource.ConsumerName] = resource
+	}
+
+	failures := []string{}
+	selected := map[string]bool{}
+	for _, consumer := range consumers {
+		selected[consumer.Name] = true
+
+		resource, found := existing[consumer.Name]
+		if found && !resource.DeletedAt.Time.IsZero() {
+			continue
+		}
+
+		consumerManifestBundle := manifestBundle
+		if template != nil {
+			if consumerManifestBundle, err = template.Render(consumer); err != nil {
+				failures = append(failures, err.Error())
+				continue
+			}
+		}
+
+		if serviceErr := s.applyResource(ctx, placement, consumerManifestBundle, consumer.Name, resource); serviceErr != nil {
+			failures = append(failures, serviceErr.Reason)
+		}
+	}
+
+	for _, resource := range resources {
+		if selected[resource.ConsumerName] || !resource.DeletedAt.Time.IsZero() {
+			continue
+		}
+		if serviceErr := s.resourceService.MarkAsDeleting(ctx, resource.ID); serviceErr != nil {
+			failures = append(failures, serviceErr.Reason)
+		}
+	}
+
+	if len(failures) > 0 {
+		return errors.GeneralError("Unable to reconcile placement %s: %s", placement.Name, strings.Join(failures, "; "))
+	}
+
+	klog.FromContext(ctx).V(4).Info("reconciled placement", "placement", placement.Name, "consumers", len(consumers))
+	return nil
+}
+
+// applyResource creates the resource of the placement on the consumer if the resource does not exist, or updates
+// it if its manifest bundle differs from the placement.
+func (s *sqlPlacementService) applyResource(ctx context.Context, placement *api.Placement, manifestBundle *api.ManifestBundleWrapper,
+	consumerName string, resource *api.Resource) *errors.ServiceError {
+	if resource != nil {
+		current, err := api.DecodeManifestBundle(resource.Payload)
+		if err != nil {
+			return errors.GeneralError("Unable to decode the manifest bundle of resource %s: %s", resource.ID, err)
+		}
+		if reflect.DeepEqual(current, manifestBundle) {
+			return nil
+		}
+	}
+
+	// the payload is encoded for each resource, so that each of the resources has its own cloudevent id
+	payload, err := api.EncodeManifestBundle(constants.DefaultSourceID, manifestBundle)
+	if err != nil {
+		return errors.GeneralError("Unable to encode the manifest bundle of placement %s: %s", placement.Name, err)
+	}
+
+	if resource == nil {
+		_, serviceErr := s.resourceService.Create(ctx, &api.Resource{
+			Meta:         api.Meta{ID: api.PlacementResourceID(placement.ID, consumerName)},
+			Source:       constants.DefaultSourceID,
+			ConsumerName: consumerName,
+			Payload:      payload,
+			PlacementID:  placement.ID,
+		})
+		return serviceErr
+	}
+
+	_, serviceErr := s.resourceService.Update(ctx, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: resource.Version,
+		Payload: payload,
+	})
+	return serviceErr
+}
+
+// validatePlacementPayload validates the manifest bundle of the placement. The placeholders of a templated placement
+// are compiled instead, since its manifest bundle is only complete after it is rendered for a consumer, the rendered
+// manifest bundles are validated when their resources are created or updated.
+func validatePlacementPayload(placement *api.Placement) *errors.ServiceError {
+	if placement.TemplateEngine != "" {
+		if _, err := newPlacementTemplate(placement); err != nil {
+			return errors.Validation("the template of the placement is invalid, %v", err)
+		}
+		return nil
+	}
+	if err := ValidateManifestBundle(placement.Payload); err != nil {
+		return errors.Validation("the manifest bundle in the placement is invalid, %v", err)
+	}
+	return nil
+}
+
+// removePlacement marks the resources of the deleting placement as deleting, and removes the placement if it has
+// no resources.
+func (s *sqlPlacementService) removePlacement(ctx context.Context, placement *api.Placement, resources api.ResourceList) *errors.ServiceError {
+	if len(resources) == 0 {
+		if err := s.placementDao.Delete(ctx, placement.ID, true); err != nil {
+			return handleDeleteError("Placement", err)
+		}
+		klog.FromContext(ctx).Info("removed the deleting placement after its last resource was deleted", "placement", placement.Name)
+		return nil
+	}
+
+	for _, resource := range resources {
+		if !resource.DeletedAt.Time.IsZero() {
+			// the resource is already being deleted
+			continue
+		}
+		if serviceErr := s.resourceService.MarkAsDeleting(ctx, resource.ID); serviceErr != nil {
+			return serviceErr
+		}
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/openshift-online/maestro/pkg/api"
+)
+
+// placementTemplateCostLimit limits the cost of evaluating a placeholder for a consumer, it prevents an expensive
+// expression from blocking the reconciliation of the placement.
+const placementTemplateCostLimit = 1000000
+
+// placeholderPattern matches the `{{ expression }}` placeholders in the string values of a placement.
+var placeholderPattern = regexp.MustCompile(`\{\{(.*?)\}\}`)
+
+// placementTemplate renders the manifest bundle of a placement for each of its consumers.
+type placementTemplate struct {
+	manifestBundle *api.ManifestBundleWrapper
+	values         map[string]interface{}
+	programs       map[string]cel.Program
+}
+
+// newPlacementTemplate compiles the placeholders in the manifest bundle of the placement. It returns nil if the
+// placement has no template engine, the manifest bundle of such placement is delivered as it is.
+func newPlacementTemplate(placement *api.Placement) (*placementTemplate, error) {
+	if placement.TemplateEngine == "" {
+		return nil, nil
+	}
+	if placement.TemplateEngine != api.PlacementTemplateEngineCEL {
+		return nil, fmt.Errorf("unsupported template engine %q, only %q is supported",
+			placement.TemplateEngine, api.PlacementTemplateEngineCEL)
+	}
+	for consumerName, values := range placement.Values {
+		if _, ok := values.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("the values of consumer %s must be an object", consumerName)
+		}
+	}
+
+	manifestBundle, err := api.DecodeManifestBundle(placement.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode manifest bundle: %v", err)
+	}
+	if manifestBundle == nil {
+		return nil, fmt.Errorf("manifest bundle is empty")
+	}
+
+	env, err := cel.NewEnv(
+		cel.Variable("consumer", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("values", cel.MapType(cel.StringType, cel.DynType)),
+		cel.CrossTypeNumericComparisons(true),
+		ext.Strings(),
+		ext.Lists(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %v", err)
+	}
+
+	template := &placementTemplate{
+		manifestBundle: manifestBundle,
+		values:         placement.Values,
+		programs:       map[string]cel.Program{},
+	}
+	compile := func(value string) error {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(value, -1) {
+			expression := strings.TrimSpace(match[1])
+			if _, found := template.programs[expression]; found {
+				continue
+			}
+			ast, issues := env.Compile(expression)
+			if issues != nil && issues.Err() != nil {
+				return fmt.Errorf("the placeholder %q is invalid, %v", match[0], issues.Err())
+			}
+			program, err := env.Program(ast, cel.CostLimit(placementTemplateCostLimit))
+			if err != nil {
+				return fmt.Errorf("the placeholder %q is invalid, %v", match[0], err)
+			}
+			template.programs[expression] = program
+		}
+		return nil
+	}
+	for _, value := range []interface{}{manifestBundle.Meta, manifestBundle.Manifests, manifestBundle.ManifestConfigs, manifestBundle.DeleteOption} {
+		if err := walkTemplateStrings(value, compile); err != nil {
+			return nil, err
+		}
+	}
+	return template, nil
+}
+
+// Render returns the manifest bundle of the consumer. A string that is one placeholder is replaced with the value
+// of its expression, so the placeholder can produce a number, a boolean, a list or an object. The placeholders in
+// the other strings are replaced with the values of their expressions as text.
+func (t *placementTemplate) Render(consumer *api.Consumer) (*api.ManifestBundleWrapper, error) {
+	labels := map[string]string{}
+	if consumer.Labels != nil {
+		labels = *consumer.Labels
+	}
+	values, _ := t.values[consumer.Name].(map[string]interface{})
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	activation := map[string]interface{}{
+		"consumer": map[string]interface{}{"name": consumer.Name, "labels": labels},
+		"values":   values,
+	}
+
+	var renderErr error
+	render := func(value string) interface{} {
+		if renderErr != nil {
+			return value
+		}
+		if match := placeholderPattern.FindStringSubmatch(value); match != nil && match[0] == value {
+			result, err := t.evaluate(match, activation)
+			if err != nil {
+				renderErr = err
+			}
+			return result
+		}
+		return placeholderPattern.ReplaceAllStringFunc(value, func(placeholder string) string {
+			result, err := t.evaluate(placeholderPattern.FindStringSubmatch(placeholder), activation)
+			if err != nil {
+				renderErr = err
+				return placeholder
+			}
+			if text, ok := result.(string); ok {
+				return text
+			}
+			text, err := json.Marshal(result)
+			if err != nil {
+				renderErr = err
+			}
+			return string(text)
+		})
+	}
+
+	// the slices are never nil, so the rendered manifest bundle equals the decoded manifest bundle of the resource
+	manifestBundle := &api.ManifestBundleWrapper{
+		Manifests:       make([]map[string]interface{}, 0, len(t.manifestBundle.Manifests)),
+		ManifestConfigs: make([]map[string]interface{}, 0, len(t.manifestBundle.ManifestConfigs)),
+	}
+	if t.manifestBundle.Meta != nil {
+		manifestBundle.Meta = renderTemplateValue(t.manifestBundle.Meta, render).(map[string]interface{})
+	}
+	for _, manifest := range t.manifestBundle.Manifests {
+		manifestBundle.Manifests = append(manifestBundle.Manifests, renderTemplateValue(manifest, render).(map[string]interface{}))
+	}
+	for _, manifestConfig := range t.manifestBundle.ManifestConfigs {
+		manifestBundle.ManifestConfigs = append(manifestBundle.ManifestConfigs, renderTemplateValue(manifestConfig, render).(map[string]interface{}))
+	}
+	if t.manifestBundle.DeleteOption != nil {
+		manifestBundle.DeleteOption = renderTemplateValue(t.manifestBundle.DeleteOption, render).(map[string]interface{})
+	}
+	if renderErr != nil {
+		return nil, fmt.Errorf("failed to render the manifest bundle for consumer %s: %v", consumer.Name, renderErr)
+	}
+	return manifestBundle, nil
+}
+
+// evaluate evaluates the expression of the placeholder and converts its value to the JSON representation.
+func (t *placementTemplate) evaluate(match []string, activation map[string]interface{}) (interface{}, error) {
+	result, _, err := t.programs[strings.TrimSpace(match[1])].Eval(activation)
+	if err != nil {
+		return nil, fmt.Errorf("the placeholder %q failed, %v", match[0], err)
+	}
+	value, err := result.ConvertToNative(reflect.TypeOf(&structpb.Value{}))
+	if err != nil {
+		return nil, fmt.Errorf("the placeholder %q returns a value that is not JSON, %v", match[0], err)
+	}
+	return value.(*structpb.Value).AsInterface(), nil
+}
+
+// walkTemplateStrings calls the visit function with each of the string values in the value, the map keys are not
+// visited.
+func walkTemplateStrings(value interface{}, visit func(string) error) error {
+	switch v := value.(type) {
+	case string:
+		return visit(v)
+	case map[string]interface{}:
+		for _, item := range v {
+			if err := walkTemplateStrings(item, visit); err != nil {
+				return err
+			}
+		}
+	case []map[string]interface{}:
+		for _, item := range v {
+			if err := walkTemplateStrings(item, visit); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if err := walkTemplateStrings(item, visit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// renderTemplateValue returns a copy of the value whose string values are replaced by the render function.
+func renderTemplateValue(value interface{}, render func(string) interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return render(v)
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			rendered[key] = renderTemplateValue(item, render)
+		}
+		return rendered
+	case []interface{}:
+		rendered := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			rendered = append(rendered, renderTemplateValue(item, render))
+		}
+		return rendered
+	default:
+		return v
+	}
+}
@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/constants"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	"github.com/openshift-online/maestro/pkg/db"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+)
+
+func TestPlacementReconcile(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	consumerDAO := mocks.NewConsumerDao()
+	resourceDAO := mocks.NewResourceDao()
+	placementDAO := mocks.NewPlacementDao()
+	eventDAO := mocks.NewEventDao()
+	lockFactory := dbmocks.NewMockAdvisoryLockFactory()
+	resourceService := NewResourceService(lockFactory, resourceDAO, consumerDAO, NewEventService(eventDAO), nil, ResourceServiceOptions{})
+	consumerService := NewConsumerService(consumerDAO, resourceDAO, placementDAO, resourceService, nil)
+	placementService := NewPlacementService(lockFactory, placementDAO, resourceDAO, consumerService, resourceService)
+
+	for name, labels := range map[string]db.StringMap{
+		"eu-prod": {"env": "prod", "region": "eu"},
+		"us-prod": {"env": "prod", "region": "us"},
+		"eu-dev":  {"env": "dev", "region": "eu"},
+	} {
+		_, svcErr := consumerService.Create(ctx, &api.Consumer{Meta: api.Meta{ID: name}, Name: name, Labels: &labels})
+		gm.Expect(svcErr).To(gm.BeNil())
+	}
+	// the placements are notified when the consumers are changed
+	gm.Expect(placementDAO.Notifications()).To(gm.Equal([]string{"", "", ""}))
+
+	placement, svcErr := placementService.Create(ctx, &api.Placement{
+		Meta:             api.Meta{ID: "nginx"},
+		Name:             "nginx",
+		ConsumerSelector: "env=prod",
+		Payload:          newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(placementDAO.Notifications()).To(gm.ContainElement(placement.ID))
+
+	// a resource is created for each of the selected consumers
+	gm.Expect(placementService.Reconcile(ctx, placement.ID)).To(gm.BeNil())
+	resources, err := resourceDAO.FindByPlacementID(ctx, placement.ID)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(resources)).To(gm.Equal(2))
+	for _, resource := range resources {
+		gm.Expect(resource.ID).To(gm.Equal(api.PlacementResourceID(placement.ID, resource.ConsumerName)))
+		gm.Expect(resource.ConsumerName).To(gm.BeElementOf("eu-prod", "us-prod"))
+	}
+
+	// the resources are not changed if the placement is not changed
+	gm.Expect(placementService.Reconcile(ctx, placement.ID)).To(gm.BeNil())
+	events, err := eventDAO.All(ctx)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(events)).To(gm.Equal(2))
+
+	// the resources are updated with the placement
+	placement.Payload = newPlacementPayload(t, "v2")
+	_, svcErr = placementService.Replace(ctx, placement)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(placementService.Reconcile(ctx, placement.ID)).To(gm.BeNil())
+	resources, err = resourceDAO.FindByPlacementID(ctx, placement.ID)
+	gm.Expect(err).To(gm.BeNil())
+	for _, resource := range resources {
+		gm.Expect(resource.Version).To(gm.Equal(int32(2)))
+	}
+
+	// the resource is deleted from the consumer that is no longer selected
+	usProd, err := consumerDAO.GetByName(ctx, "us-prod")
+	gm.Expect(err).To(gm.BeNil())
+	usProd.Labels = &db.StringMap{"env": "dev", "region": "us"}
+	_, svcErr = consumerService.Replace(ctx, usProd)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(placementService.Reconcile(ctx, placement.ID)).To(gm.BeNil())
+	resource, err := resourceDAO.Get(ctx, api.PlacementResourceID(placement.ID, "us-prod"))
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(resource.DeletedAt.Time.IsZero()).To(gm.BeFalse())
+	gm.Expect(resourceDAO.Delete(ctx, resource.ID, true)).To(gm.Succeed())
+
+	// the deleting placement is removed after its resources are deleted
+	gm.Expect(placementService.Delete(ctx, placement.ID)).To(gm.BeNil())
+	gm.Expect(placementService.Reconcile(ctx, placement.ID)).To(gm.BeNil())
+	resource, err = resourceDAO.Get(ctx, api.PlacementResourceID(placement.ID, "eu-prod"))
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(resource.DeletedAt.Time.IsZero()).To(gm.BeFalse())
+	_, svcErr = placementService.Get(ctx, placement.ID)
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	gm.Expect(resourceDAO.Delete(ctx, resource.ID, true)).To(gm.Succeed())
+	gm.Expect(placementService.Reconcile(ctx, placement.ID)).To(gm.BeNil())
+	_, svcErr = placementService.Get(ctx, placement.ID)
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+}
+
+func TestCreateInvalidPlacement(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	placementDAO := mocks.NewPlacementDao()
+	placementService := NewPlacementService(dbmocks.NewMockAdvisoryLockFactory(), placementDAO, mocks.NewResourceDao(), nil, nil)
+
+	_, svcErr := placementService.Create(context.Background(), &api.Placement{
+		Name:             "nginx",
+		ConsumerSelector: "env in (prod",
+		Payload:          newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Reason).To(gm.ContainSubstring("consumer_selector"))
+
+	_, svcErr = placementService.Create(context.Background(), &api.Placement{
+		Name:             "nginx",
+		ConsumerSelector: "env=prod",
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+
+	placements, err := placementDAO.All(context.Background())
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(placements)).To(gm.Equal(0))
+}
+
+func TestPlacementTemplate(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	consumerDAO := mocks.NewConsumerDao()
+	resourceDAO := mocks.NewResourceDao()
+	placementDAO := mocks.NewPlacementDao()
+	lockFactory := dbmocks.NewMockAdvisoryLockFactory()
+	resourceService := NewResourceService(lockFactory, resourceDAO, consumerDAO, NewEventService(mocks.NewEventDao()), nil, ResourceServiceOptions{})
+	consumerService := NewConsumerService(consumerDAO, resourceDAO, placementDAO, resourceService, nil)
+	placementService := NewPlacementService(lockFactory, placementDAO, resourceDAO, consumerService, resourceService)
+
+	for name, labels := range map[string]db.StringMap{
+		"eu-prod": {"env": "prod", "region": "eu"},
+		"us-prod": {"env": "prod", "region": "us"},
+	} {
+		_, svcErr := consumerService.Create(ctx, &api.Consumer{Meta: api.Meta{ID: name}, Name: name, Labels: &labels})
+		gm.Expect(svcErr).To(gm.BeNil())
+	}
+
+	placement, svcErr := placementService.Create(ctx, &api.Placement{
+		Name:             "nginx",
+		ConsumerSelector: "env=prod",
+		Payload: newTemplatePayload(t, map[string]interface{}{
+			"cluster":  "{{ consumer.name }}",
+			"region":   "region-{{ consumer.labels.region }}",
+			"replicas": "{{ values.replicas }}",
+			"image":    "{{ 'image' in values ? values.image : 'nginx' }}",
+		}),
+		TemplateEngine: api.PlacementTemplateEngineCEL,
+		Values:         map[string]interface{}{"eu-prod": map[string]interface{}{"replicas": 3, "image": "nginx:1.27"}},
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	// the manifest bundle is rendered with the labels and the values of each of the consumers
+	gm.Expect(placementService.Reconcile(ctx, placement.ID)).NotTo(gm.BeNil())
+	resource, err := resourceDAO.Get(ctx, api.PlacementResourceID(placement.ID, "eu-prod"))
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(configMapData(t, resource)).To(gm.Equal(map[string]interface{}{
+		"cluster": "eu-prod", "region": "region-eu", "replicas": float64(3), "image": "nginx:1.27"}))
+
+	// the consumer without the required values is reported
+	_, err = resourceDAO.Get(ctx, api.PlacementResourceID(placement.ID, "us-prod"))
+	gm.Expect(err).NotTo(gm.BeNil())
+
+	placement.Values = map[string]interface{}{
+		"eu-prod": map[string]interface{}{"replicas": 3, "image": "nginx:1.27"},
+		"us-prod": map[string]interface{}{"replicas": 1},
+	}
+	_, svcErr = placementService.Replace(ctx, placement)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(placementService.Reconcile(ctx, placement.ID)).To(gm.BeNil())
+	resource, err = resourceDAO.Get(ctx, api.PlacementResourceID(placement.ID, "us-prod"))
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(configMapData(t, resource)).To(gm.Equal(map[string]interface{}{
+		"cluster": "us-prod", "region": "region-us", "replicas": float64(1), "image": "nginx"}))
+
+	// the placeholders are compiled when the placement is created
+	_, svcErr = placementService.Create(ctx, &api.Placement{
+		Name:           "invalid",
+		Payload:        newTemplatePayload(t, map[string]interface{}{"cluster": "{{ consumer.name + }}"}),
+		TemplateEngine: api.PlacementTemplateEngineCEL,
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Reason).To(gm.ContainSubstring("the placeholder"))
+
+	// the values require a template engine
+	_, svcErr = placementService.Create(ctx, &api.Placement{
+		Name:    "invalid",
+		Payload: newPlacementPayload(t, "v1"),
+		Values:  map[string]interface{}{"eu-prod": map[string]interface{}{"replicas": 3}},
+	})
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Reason).To(gm.ContainSubstring("values"))
+}
+
+func newTemplatePayload(t *testing.T, data map[string]interface{}) map[string]interface{} {
+	payload, err := api.EncodeManifestBundle(constants.DefaultSourceID, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{newConfigMap(data)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+func newPlacementPayload(t *testing.T, version string) map[string]interface{} {
+	payload, err := api.EncodeManifestBundle(constants.DefaultSourceID, &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{
+			{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+				"data":       map[string]interface{}{"version": version},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/config"
+)
+
+// PolicyAdmissionPluginName is the name of the admission plugin that validates the manifests against the
+// CEL policies.
+const PolicyAdmissionPluginName = "policies"
+
+// policyCostLimit limits the cost of evaluating an expression on a manifest, it prevents an expensive
+// expression from blocking the writes.
+const policyCostLimit = 1000000
+
+type policyAdmissionPlugin struct {
+	policies []*manifestPolicy
+}
+
+var _ ValidatingAdmissionPlugin = &policyAdmissionPlugin{}
+
+type manifestPolicy struct {
+	name             string
+	consumerSelector labels.Selector
+	sources          sets.Set[string]
+	rules            []config.PolicyResourceRule
+	validations      []*policyValidation
+	failurePolicy    config.AdmissionFailurePolicy
+}
+
+type policyValidation struct {
+	expression        string
+	message           string
+	program           cel.Program
+	messageExpression cel.Program
+}
+
+// NewPolicyAdmissionPlugin compiles the CEL expressions of the policies and returns the admission plugin that
+// validates the manifests against them.
+func NewPolicyAdmissionPlugin(policies []config.ManifestPolicy) (ValidatingAdmissionPlugin, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("oldObject", cel.DynType),
+		cel.Variable("request", cel.MapType(cel.StringType, cel.DynType)),
+		cel.CrossTypeNumericComparisons(true),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Sets(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %v", err)
+	}
+
+	plugin := &policyAdmissionPlugin{}
+	for _, policy := range policies {
+		selector, err := labels.Parse(policy.ConsumerSelector)
+		if err != nil {
+			return nil, fmt.Errorf("the consumer selector of policy %s is invalid, %v", policy.Name, err)
+		}
+
+		compiled := &manifestPolicy{
+			name:             policy.Name,
+			consumerSelector: selector,
+			sources:          sets.New(policy.Sources...),
+			rules:            policy.MatchResources,
+			failurePolicy:    policy.FailurePolicy,
+		}
+		for i, validation := range policy.Validations {
+			program, err := compilePolicyExpression(env, validation.Expression, cel.BoolType)
+			if err != nil {
+				return nil, fmt.Errorf("the expression of validation %d in policy %s is invalid, %v", i, policy.Name, err)
+			}
+			compiledValidation := &policyValidation{
+				expression: validation.Expression,
+				message:    validation.Message,
+				program:    program,
+			}
+			if validation.MessageExpression != "" {
+				compiledValidation.messageExpression, err = compilePolicyExpression(env, validation.MessageExpression, cel.StringType)
+				if err != nil {
+					return nil, fmt.Errorf("the message expression of validation %d in policy %s is invalid, %v", i, policy.Name, err)
+				}
+			}
+			compiled.validations = append(compiled.validations, compiledValidation)
+		}
+		plugin.policies = append(plugin.policies, compiled)
+	}
+	return plugin, nil
+}
+
+func compilePolicyExpression(env *cel.Env, expression string, outputType *cel.Type) (cel.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if !ast.OutputType().IsExactType(outputType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("the expression must return %s, but it returns %s", outputType, ast.OutputType())
+	}
+	return env.Program(ast, cel.CostLimit(policyCostLimit))
+}
+
+func (p *policyAdmissionPlugin) Name() string {
+	return PolicyAdmissionPluginName
+}
+
+// Validate evaluates the policies that match the request on each manifest, all the violations are returned in
+// one rejection.
+func (p *policyAdmissionPlugin) Validate(ctx context.Context, request *AdmissionRequest) error {
+	oldManifests := map[string]map[string]interface{}{}
+	if request.OldObject != nil {
+		for _, manifest := range request.OldObject.Manifests {
+			info, _ := extractManifestInfo(manifest)
+			oldManifests[info.key] = manifest
+		}
+	}
+	requestVariable := map[string]interface{}{
+		"operation":      string(request.Operation),
+		"consumerName":   request.ConsumerName,
+		"consumerLabels": request.ConsumerLabels,
+		"source":         request.Source,
+		"username":       request.Username,
+	}
+
+	violations := []string{}
+	for _, manifest := range request.Object.Manifests {
+		obj := unstructured.Unstructured{Object: manifest}
+		for _, policy := range p.policies {
+			if !policy.matches(request, obj) {
+				continue
+			}
+
+			var oldObject interface{}
+			info, _ := extractManifestInfo(manifest)
+			if oldManifest, ok := oldManifests[info.key]; ok {
+				oldObject = oldManifest
+			}
+			activation := map[string]interface{}{
+				"object":    manifest,
+				"oldObject": oldObject,
+				"request":   requestVariable,
+			}
+			for _, validation := range policy.validations {
+				message, err := validation.evaluate(activation)
+				if err != nil {
+					if policy.failurePolicy == config.AdmissionFailurePolicyIgnore {
+						klog.FromContext(ctx).Error(err, "Failed to evaluate policy, ignore it", "policy", policy.name)
+						continue
+					}
+					message = fmt.Sprintf("failed to evaluate the expression %q, %v", validation.expression, err)
+				}
+				if message != "" {
+					violations = append(violations, fmt.Sprintf("the manifest %s %s violates the policy %s: %s",
+						obj.GetKind(), policyManifestName(obj), policy.name, message))
+				}
+			}
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return NewAdmissionDeniedError("%s", strings.Join(violations, "; "))
+}
+
+func (p *manifestPolicy) matches(request *AdmissionRequest, obj unstructured.Unstructured) bool {
+	if !p.consumerSelector.Matches(labels.Set(request.ConsumerLabels)) {
+		return false
+	}
+	if p.sources.Len() > 0 && !p.sources.Has(request.Source) {
+		return false
+	}
+	if len(p.rules) == 0 {
+		return true
+	}
+
+	gvk := schema.FromAPIVersionAndKind(obj.GetAPIVersion(), obj.GetKind())
+	for _, rule := range p.rules {
+		if matchesPolicyRule(rule.APIGroups, gvk.Group) && matchesPolicyRule(rule.Kinds, gvk.Kind) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPolicyRule(values []string, value string) bool {
+	for _, v := range values {
+		if v == "*" || v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate returns the message of the validation if the manifest does not pass it.
+func (v *policyValidation) evaluate(activation map[string]interface{}) (string, error) {
+	result, _, err := v.program.Eval(activation)
+	if err != nil {
+		return "", err
+	}
+	passed, ok := result.Value().(bool)
+	if !ok {
+		return "", fmt.Errorf("the expression returns %v instead of a bool", result.Type())
+	}
+	if passed {
+		return "", nil
+	}
+
+	if v.messageExpression != nil {
+		// fall back to the static message if the message expression fails
+		if message, _, err := v.messageExpression.Eval(activation); err == nil {
+			if s, ok := message.Value().(string); ok && s != "" {
+				return s, nil
+			}
+		}
+	}
+	if v.message != "" {
+		return v.message, nil
+	}
+	return fmt.Sprintf("failed expression: %s", v.expression), nil
+}
+
+func policyManifestName(obj unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName())
+}
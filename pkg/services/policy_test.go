@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/config"
+)
+
+func TestPolicyAdmissionPlugin(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	plugin, err := NewPolicyAdmissionPlugin([]config.ManifestPolicy{
+		{
+			Name:             "no-privileged-pods",
+			ConsumerSelector: "env=prod",
+			MatchResources:   []config.PolicyResourceRule{{APIGroups: []string{""}, Kinds: []string{"Pod"}}},
+			Validations: []config.PolicyValidation{
+				{
+					Expression: "!object.spec.containers.exists(c, has(c.securityContext) && has(c.securityContext.privileged) && c.securityContext.privileged)",
+					Message:    "privileged containers are not allowed",
+				},
+			},
+		},
+		{
+			Name:    "replicas",
+			Sources: []string{"maestro"},
+			MatchResources: []config.PolicyResourceRule{
+				{APIGroups: []string{"apps"}, Kinds: []string{"*"}},
+			},
+			Validations: []config.PolicyValidation{
+				{
+					Expression:        "object.spec.replicas <= 3",
+					MessageExpression: "'replicas ' + string(object.spec.replicas) + ' is more than 3'",
+				},
+				{
+					Expression: "oldObject == null || object.spec.replicas >= oldObject.spec.replicas",
+				},
+			},
+		},
+	})
+	gm.Expect(err).To(gm.BeNil())
+
+	privilegedPod := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "nginx", "securityContext": map[string]interface{}{"privileged": true}},
+			},
+		},
+	}
+	deployment := func(replicas float64) map[string]interface{} {
+		return map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+			"spec":       map[string]interface{}{"replicas": replicas},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		request *AdmissionRequest
+		err     string
+	}{
+		{
+			name: "privileged pod on prod consumer",
+			request: &AdmissionRequest{
+				Operation:      AdmissionCreate,
+				ConsumerLabels: map[string]string{"env": "prod"},
+				Object:         &AdmissionManifestBundle{Manifests: []map[string]interface{}{privilegedPod}},
+			},
+			err: "the request is denied by the admission plugin policies, the manifest Pod default/nginx violates the policy " +
+				"no-privileged-pods: privileged containers are not allowed",
+		},
+		{
+			name: "privileged pod on dev consumer",
+			request: &AdmissionRequest{
+				Operation:      AdmissionCreate,
+				ConsumerLabels: map[string]string{"env": "dev"},
+				Object:         &AdmissionManifestBundle{Manifests: []map[string]interface{}{privilegedPod}},
+			},
+		},
+		{
+			name: "too many replicas",
+			request: &AdmissionRequest{
+				Operation: AdmissionCreate,
+				Source:    "maestro",
+				Object:    &AdmissionManifestBundle{Manifests: []map[string]interface{}{deployment(5)}},
+			},
+			err: "the request is denied by the admission plugin policies, the manifest Deployment default/nginx violates the policy " +
+				"replicas: replicas 5 is more than 3",
+		},
+		{
+			name: "too many replicas from other source",
+			request: &AdmissionRequest{
+				Operation: AdmissionCreate,
+				Source:    "grpc",
+				Object:    &AdmissionManifestBundle{Manifests: []map[string]interface{}{deployment(5)}},
+			},
+		},
+		{
+			name: "scale down",
+			request: &AdmissionRequest{
+				Operation: AdmissionUpdate,
+				Source:    "maestro",
+				Object:    &AdmissionManifestBundle{Manifests: []map[string]interface{}{deployment(1)}},
+				OldObject: &AdmissionManifestBundle{Manifests: []map[string]interface{}{deployment(2)}},
+			},
+			err: "the request is denied by the admission plugin policies, the manifest Deployment default/nginx violates the policy " +
+				"replicas: failed expression: oldObject == null || object.spec.replicas >= oldObject.spec.replicas",
+		},
+		{
+			name: "scale up",
+			request: &AdmissionRequest{
+				Operation: AdmissionUpdate,
+				Source:    "maestro",
+				Object:    &AdmissionManifestBundle{Manifests: []map[string]interface{}{deployment(3)}},
+				OldObject: &AdmissionManifestBundle{Manifests: []map[string]interface{}{deployment(2)}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := NewAdmissionChain(plugin).Admit(ctx, c.request)
+			if c.err == "" {
+				gm.Expect(err).To(gm.BeNil())
+				return
+			}
+			gm.Expect(err).To(gm.MatchError(c.err))
+		})
+	}
+
+	_, err = NewPolicyAdmissionPlugin([]config.ManifestPolicy{
+		{Name: "invalid", Validations: []config.PolicyValidation{{Expression: "1 + 1"}}},
+	})
+	gm.Expect(err).NotTo(gm.BeNil())
+}
@@ -2,11 +2,14 @@ package services
 
 import (
 	"context"
+	e "errors"
 	"reflect"
 	"time"
 
 	cloudeventstypes "github.com/cloudevents/sdk-go/v2/types"
 	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
 	"k8s.io/klog/v2"
 	cegeneric "open-cluster-management.io/sdk-go/pkg/cloudevents/generic"
 	cetypes "open-cluster-management.io/sdk-go/pkg/cloudevents/generic/types"
@@ -17,6 +20,10 @@ import (
 	"github.com/openshift-online/maestro/pkg/errors"
 )
 
+// maxUpdateAttempts is the number of times that an update of a resource is prepared again from the latest version
+// when the resource is changed by other requests during its admission.
+const maxUpdateAttempts = 3
+
 func init() {
 	// Register the metrics for resource service
 	RegisterResourceMetrics()
@@ -28,21 +35,48 @@ type ResourceService interface {
 	Update(ctx context.Context, resource *api.Resource) (*api.Resource, *errors.ServiceError)
 	UpdateStatus(ctx context.Context, resource *api.Resource) (*api.Resource, bool, *errors.ServiceError)
 	MarkAsDeleting(ctx context.Context, id string) *errors.ServiceError
+	Batch(ctx context.Context, operations []ResourceOperation, atomic bool) ([]ResourceOperationResult, *errors.ServiceError)
 	Delete(ctx context.Context, id string) *errors.ServiceError
 	All(ctx context.Context) (api.ResourceList, *errors.ServiceError)
 
+	// Revisions returns the revision history of the resource, the latest revision first.
+	Revisions(ctx context.Context, id string) (api.ResourceRevisionList, *errors.ServiceError)
+	// Rollback re-applies the manifest bundle of the given revision as a new version of the resource.
+	Rollback(ctx context.Context, id string, version int32) (*api.Resource, *errors.ServiceError)
+	// Apply merges the manifest bundle of the resource into the stored one as the field manager, the resource is
+	// created if it does not exist.
+	Apply(ctx context.Context, manager string, resource *api.Resource, force bool) (*api.Resource, *errors.ServiceError)
+	// DryRun returns the changes that the create or update of the resource would apply without applying them.
+	DryRun(ctx context.Context, action api.EventType, resource *api.Resource) (*api.ResourceDiff, *errors.ServiceError)
+
 	FindByIDs(ctx context.Context, ids []string) (api.ResourceList, *errors.ServiceError)
 	FindBySource(ctx context.Context, source string) (api.ResourceList, *errors.ServiceError)
 	List(ctx context.Context, listOpts cetypes.ListOptions) ([]*api.Resource, error)
 	ListWithArgs(ctx context.Context, username string, args *ListArguments, resources *[]api.Resource) (*api.PagingMeta, *errors.ServiceError)
 }
 
-func NewResourceService(lockFactory db.LockFactory, resourceDao dao.ResourceDao, events EventService, generic GenericService) ResourceService {
+// ResourceServiceOptions are the optional collaborators of the resource service, the features of the nil
+// collaborators are disabled.
+type ResourceServiceOptions struct {
+	// Revisions saves the revision history of the resources, no revisions are saved if it is nil.
+	Revisions dao.ResourceRevisionDao
+	// Admission runs on the manifest bundle of the resource creates and updates.
+	Admission *AdmissionChain
+	// AuditEvents records the creates, updates and deletes of the resources.
+	AuditEvents AuditEventService
+}
+
+func NewResourceService(lockFactory db.LockFactory, resourceDao dao.ResourceDao, consumerDao dao.ConsumerDao,
+	events EventService, generic GenericService, opts ResourceServiceOptions) ResourceService {
 	return &sqlResourceService{
 		lockFactory: lockFactory,
 		resourceDao: resourceDao,
+		revisionDao: opts.Revisions,
+		consumerDao: consumerDao,
 		events:      events,
 		generic:     generic,
+		admission:   opts.Admission,
+		auditEvents: opts.AuditEvents,
 	}
 }
 
@@ -51,8 +85,16 @@ var _ ResourceService = &sqlResourceService{}
 type sqlResourceService struct {
 	lockFactory db.LockFactory
 	resourceDao dao.ResourceDao
+	// revisionDao saves the revisions of the resources, no revisions are saved if it is nil.
+	revisionDao dao.ResourceRevisionDao
+	consumerDao dao.ConsumerDao
 	events      EventService
 	generic     GenericService
+	// admission runs on the manifest bundle of the resource creates and updates, it is nil if no admission
+	// plugin is configured.
+	admission *AdmissionChain
+	// auditEvents records the creates, updates and deletes of the resources, nothing is recorded if it is nil.
+	auditEvents AuditEventService
 }
 
 func (s *sqlResourceService) Get(ctx context.Context, id string) (*api.Resource, *errors.ServiceError) {
@@ -68,83 +110,225 @@ func (s *sqlResourceService) Get(ctx context.Context, id string) (*api.Resource,
 }
 
 func (s *sqlResourceService) Create(ctx context.Context, resource *api.Resource) (*api.Resource, *errors.ServiceError) {
+	resource, event, serviceErr := s.create(ctx, resource)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if _, eErr := s.events.Create(ctx, event); eErr != nil {
+		return nil, handleCreateError("Resource", eErr)
+	}
+
+	return resource, nil
+}
+
+// create creates the resource and returns the event of the creation, the event is not saved.
+func (s *sqlResourceService) create(ctx context.Context, resource *api.Resource) (*api.Resource, *api.Event, *errors.ServiceError) {
 	if resource.Name != "" {
 		if err := ValidateResourceName(resource); err != nil {
-			return nil, errors.Validation("the name in the resource is invalid, %v", err)
+			return nil, nil, errors.Validation("the name in the resource is invalid, %v", err)
 		}
 	}
 	if err := ValidateManifestBundle(resource.Payload); err != nil {
-		return nil, errors.Validation("the manifest bundle in the resource is invalid, %v", err)
+		return nil, nil, errors.Validation("the manifest bundle in the resource is invalid, %v", err)
+	}
+
+	// The resources cannot be created on a consumer that is being deleted. The consumer existence is
+	// guaranteed by the foreign key, so a missing consumer is left to the database to reject.
+	consumer, err := s.consumerDao.GetByName(ctx, resource.ConsumerName)
+	if err != nil && !e.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, errors.GeneralError("Unable to get consumer %s: %s", resource.ConsumerName, err)
+	}
+	if consumer != nil && !consumer.DeletedAt.Time.IsZero() {
+		return nil, nil, errors.Conflict("the consumer %s is under deletion", resource.ConsumerName)
 	}
 
-	resource, err := s.resourceDao.Create(ctx, resource)
+	payload, serviceErr := s.admit(ctx, AdmissionCreate, resource, nil, false)
+	if serviceErr != nil {
+		return nil, nil, serviceErr
+	}
+	resource.Payload = payload
+
+	resource, err = s.resourceDao.Create(ctx, resource)
 	if err != nil {
-		return nil, handleCreateError("Resource", err)
+		return nil, nil, handleCreateError("Resource", err)
+	}
+
+	if serviceErr := s.saveRevision(ctx, resource); serviceErr != nil {
+		return nil, nil, serviceErr
 	}
 
-	_, eErr := s.events.Create(ctx, &api.Event{
+	if serviceErr := s.recordAudit(ctx, api.AuditActionCreate, resource, 0, resource.Version); serviceErr != nil {
+		return nil, nil, serviceErr
+	}
+
+	return resource, &api.Event{
 		Source:    "Resources",
 		SourceID:  resource.ID,
 		EventType: api.CreateEventType,
-	})
-	if eErr != nil {
-		return nil, handleCreateError("Resource", eErr)
+	}, nil
+}
+
+func (s *sqlResourceService) Update(ctx context.Context, resource *api.Resource) (*api.Resource, *errors.ServiceError) {
+	updated, event, serviceErr := s.update(ctx, resource)
+	if serviceErr != nil {
+		return nil, serviceErr
 	}
 
-	return resource, nil
+	if event == nil {
+		return updated, nil
+	}
+
+	if _, err := s.events.Create(ctx, event); err != nil {
+		return nil, handleUpdateError("Resource", err)
+	}
+
+	return updated, nil
 }
 
-func (s *sqlResourceService) Update(ctx context.Context, resource *api.Resource) (*api.Resource, *errors.ServiceError) {
-	// Updates the resource manifest only when its manifest changes.
+// update updates the resource manifest and returns the event of the update, the event is not saved and it is
+// nil if the manifest is not changed.
+func (s *sqlResourceService) update(ctx context.Context, resource *api.Resource) (*api.Resource, *api.Event, *errors.ServiceError) {
+	for attempt := 1; ; attempt++ {
+		found, err := s.resourceDao.Get(ctx, resource.ID)
+		if err != nil {
+			return nil, nil, handleGetError("Resource", "id", resource.ID, err)
+		}
+
+		if !found.DeletedAt.Time.IsZero() {
+			return nil, nil, errors.Conflict("the resource is under deletion, id: %s", resource.ID)
+		}
+
+		// Make sure the requested resource version is consistent with its database version. The version of a patch
+		// is checked only if it is set, since the patch is applied to the latest manifest bundle.
+		if found.Version != resource.Version && (resource.Patch == nil || resource.Version != 0) {
+			return nil, nil, errors.Conflict("the resource version is not the latest, the latest version: %d", found.Version)
+		}
+
+		// The patch is applied to the found manifest bundle, a nil payload means it changes nothing.
+		desired := resource
+		if resource.Patch != nil {
+			payload, serviceErr := patchManifestBundle(found, resource.PatchType, resource.Patch)
+			if serviceErr != nil {
+				return nil, nil, serviceErr
+			}
+			patched := *resource
+			patched.Version = found.Version
+			patched.Payload = payload
+			desired = &patched
+		}
+
+		// New manifest is not changed, the update action is not needed. A nil manifest keeps the current one.
+		if desired.Payload == nil || reflect.DeepEqual(desired.Payload, found.Payload) {
+			return found, nil, nil
+		}
+
+		// the fields whose values are replaced by the update are no longer owned by their managers.
+		managedFields := found.ManagedFields
+		if len(managedFields) > 0 {
+			live, liveErr := api.DecodeManifestBundle(found.Payload)
+			wanted, wantedErr := api.DecodeManifestBundle(desired.Payload)
+			if liveErr == nil && wantedErr == nil {
+				managedFields = encodeManagedFields(retainManagedFields(decodeManagedFields(managedFields), live, wanted))
+			}
+		}
+
+		update, serviceErr := s.admitUpdate(ctx, desired, found, managedFields)
+		if serviceErr != nil {
+			return nil, nil, serviceErr
+		}
+
+		updated, event, stale, serviceErr := s.saveUpdate(ctx, update)
+		if !stale {
+			return updated, event, serviceErr
+		}
+		if attempt == maxUpdateAttempts {
+			return nil, nil, errors.Conflict("the resource %s is changed by other requests during the update", resource.ID)
+		}
+	}
+}
+
+// resourceUpdate is a new version of a resource that is validated and admitted without holding the lock of the
+// resource, so the admission webhooks do not block the other writers of the resource. It is saved only if the
+// resource is still at the version that it is based on.
+type resourceUpdate struct {
+	// found is the resource that the update is based on.
+	found *api.Resource
+	// payload is the admitted manifest bundle, it is nil if only the managed fields are changed.
+	payload       datatypes.JSONMap
+	managedFields datatypes.JSONMap
+}
+
+// admitUpdate validates and admits the new manifest bundle of the found resource.
+func (s *sqlResourceService) admitUpdate(ctx context.Context, resource, found *api.Resource,
+	managedFields datatypes.JSONMap) (*resourceUpdate, *errors.ServiceError) {
+	if err := ValidateManifestBundle(resource.Payload); err != nil {
+		return nil, errors.Validation("the new manifest bundle in the resource is invalid, %v", err)
+	}
+
+	payload, serviceErr := s.admit(ctx, AdmissionUpdate, resource, found, false)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	return &resourceUpdate{found: found, payload: payload, managedFields: managedFields}, nil
+}
+
+// saveUpdate saves the update as a new version of the resource under the lock of the resource. If the resource was
+// changed since the update is prepared, nothing is saved and stale is true, so the caller prepares the update again
+// from the latest version. The event of the update is returned and it is not saved.
+func (s *sqlResourceService) saveUpdate(ctx context.Context,
+	update *resourceUpdate) (updated *api.Resource, event *api.Event, stale bool, serviceErr *errors.ServiceError) {
 	// If there are multiple requests at the same time, it will cause the race conditions among these
 	// requests (read–modify–write), the advisory lock is used here to prevent the race conditions.
-	lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, resource.ID, db.Resources)
+	lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, update.found.ID, db.Resources)
 	// Ensure that the transaction related to this lock always end.
 	defer s.lockFactory.Unlock(ctx, lockOwnerID)
 	if err != nil {
-		return nil, errors.DatabaseAdvisoryLock(err)
+		return nil, nil, false, errors.DatabaseAdvisoryLock(err)
 	}
 
-	found, err := s.resourceDao.Get(ctx, resource.ID)
+	found, err := s.resourceDao.Get(ctx, update.found.ID)
 	if err != nil {
-		return nil, handleGetError("Resource", "id", resource.ID, err)
+		return nil, nil, false, handleGetError("Resource", "id", update.found.ID, err)
 	}
-
 	if !found.DeletedAt.Time.IsZero() {
-		return nil, errors.Conflict("the resource is under deletion, id: %s", resource.ID)
+		return nil, nil, false, errors.Conflict("the resource is under deletion, id: %s", found.ID)
 	}
-
-	// Make sure the requested resource version is consistent with its database version.
-	if found.Version != resource.Version {
-		return nil, errors.Conflict("the resource version is not the latest, the latest version: %d", found.Version)
-	}
-
-	// New manifest is not changed, the update action is not needed.
-	if reflect.DeepEqual(resource.Payload, found.Payload) {
-		return found, nil
+	if found.Version != update.found.Version {
+		return nil, nil, true, nil
 	}
 
-	if err := ValidateManifestBundle(resource.Payload); err != nil {
-		return nil, errors.Validation("the new manifest bundle in the resource is invalid, %v", err)
+	if update.payload == nil {
+		// only the ownership of the fields is changed, the resource version is kept and no event is emitted since
+		// the agent has nothing to apply.
+		found.ManagedFields = update.managedFields
+		updated, err := s.resourceDao.Update(ctx, found)
+		if err != nil {
+			return nil, nil, false, handleUpdateError("Resource", err)
+		}
+		return updated, nil, false, nil
 	}
 
 	// Increase the current resource version and update its manifest.
 	// Note: Maestro agent sets work metadata generation from the current resource version,
 	// ignoring the `generation` and `resourceVersion` from the CloudEvents metadata extension.
+	versionBefore := found.Version
 	found.Version = found.Version + 1
-	found.Payload = resource.Payload
+	found.Payload = update.payload
+	found.ManagedFields = update.managedFields
 
-	updated, err := s.resourceDao.Update(ctx, found)
+	updated, err = s.resourceDao.Update(ctx, found)
 	if err != nil {
-		return nil, handleUpdateError("Resource", err)
+		return nil, nil, false, handleUpdateError("Resource", err)
 	}
 
-	if _, err := s.events.Create(ctx, &api.Event{
-		Source:    "Resources",
-		SourceID:  updated.ID,
-		EventType: api.UpdateEventType,
-	}); err != nil {
-		return nil, handleUpdateError("Resource", err)
+	if serviceErr := s.saveRevision(ctx, updated); serviceErr != nil {
+		return nil, nil, false, serviceErr
+	}
+
+	if serviceErr := s.recordAudit(ctx, api.AuditActionUpdate, updated, versionBefore, updated.Version); serviceErr != nil {
+		return nil, nil, false, serviceErr
 	}
 
 	// Create the set of labels that we will add to all the resource process:
@@ -156,7 +340,11 @@ func (s *sqlResourceService) Update(ctx context.Context, resource *api.Resource)
 	// Update the metric containing the number of processed resources:
 	resourceProcessedCountMetric.With(labels).Inc()
 
-	return updated, nil
+	return updated, &api.Event{
+		Source:    "Resources",
+		SourceID:  updated.ID,
+		EventType: api.UpdateEventType,
+	}, false, nil
 }
 
 func (s *sqlResourceService) UpdateStatus(ctx context.Context, resource *api.Resource) (*api.Resource, bool, *errors.ServiceError) {
@@ -263,35 +451,110 @@ func (s *sqlResourceService) UpdateStatus(ctx context.Context, resource *api.Res
 // 4. Work-agent deletes resource, sends CloudEvent back to Maestro
 // 5. Maestro hard deletes resource from DB
 func (s *sqlResourceService) MarkAsDeleting(ctx context.Context, id string) *errors.ServiceError {
+	event, serviceErr := s.markAsDeleting(ctx, id)
+	if serviceErr != nil {
+		return serviceErr
+	}
+
+	if _, err := s.events.Create(ctx, event); err != nil {
+		return handleDeleteError("Resource", err)
+	}
+
+	return nil
+}
+
+// markAsDeleting marks the resource as deleting and returns the event of the deletion, the event is not saved.
+func (s *sqlResourceService) markAsDeleting(ctx context.Context, id string) (*api.Event, *errors.ServiceError) {
 	// If there are multiple requests to write the resource at the same time, it will cause the race conditions among these
 	// requests (read–modify–write), the advisory lock is used here to prevent the race conditions.
 	lockOwnerID, err := s.lockFactory.NewAdvisoryLock(ctx, id, db.Resources)
 	// Ensure that the transaction related to this lock always end.
 	defer s.lockFactory.Unlock(ctx, lockOwnerID)
 	if err != nil {
-		return errors.DatabaseAdvisoryLock(err)
+		return nil, errors.DatabaseAdvisoryLock(err)
 	}
 
+	// the resource is read for its audit event, the deletion of a missing or deleting resource is not recorded
+	found, err := s.resourceDao.Get(ctx, id)
+	if err != nil && !e.Is(err, gorm.ErrRecordNotFound) {
+		return nil, handleGetError("Resource", "id", id, err)
+	}
+	audited := found != nil && found.DeletedAt.Time.IsZero()
+
 	if err := s.resourceDao.Delete(ctx, id, false); err != nil {
-		return handleDeleteError("Resource", errors.GeneralError("Unable to delete resource: %s", err))
+		return nil, handleDeleteError("Resource", errors.GeneralError("Unable to delete resource: %s", err))
 	}
 
-	if _, err := s.events.Create(ctx, &api.Event{
+	if audited {
+		if serviceErr := s.recordAudit(ctx, api.AuditActionDelete, found, found.Version, 0); serviceErr != nil {
+			return nil, serviceErr
+		}
+	}
+
+	return &api.Event{
 		Source:    "Resources",
 		SourceID:  id,
 		EventType: api.DeleteEventType,
-	}); err != nil {
-		return handleDeleteError("Resource", err)
-	}
+	}, nil
+}
 
-	return nil
+// recordAudit records the audit event of the operation on the resource.
+func (s *sqlResourceService) recordAudit(ctx context.Context, action api.AuditAction, resource *api.Resource,
+	versionBefore, versionAfter int32) *errors.ServiceError {
+	return recordAudit(ctx, s.auditEvents, &api.AuditEvent{
+		Source:        resource.Source,
+		Action:        action,
+		TargetType:    api.AuditTargetResourceBundle,
+		TargetID:      resource.ID,
+		VersionBefore: versionBefore,
+		VersionAfter:  versionAfter,
+	})
 }
 
+// Delete permanently deletes the resource from the storage. If the consumer of the resource is being deleted
+// with the cascade policy and this is its last resource, the consumer is removed too.
 func (s *sqlResourceService) Delete(ctx context.Context, id string) *errors.ServiceError {
+	resource, err := s.resourceDao.Get(ctx, id)
+	if err != nil {
+		if e.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return handleGetError("Resource", "id", id, err)
+	}
+
 	if err := s.resourceDao.Delete(ctx, id, true); err != nil {
 		return handleDeleteError("Resource", errors.GeneralError("Unable to delete resource: %s", err))
 	}
 
+	return s.removeDeletingConsumer(ctx, resource.ConsumerName)
+}
+
+// removeDeletingConsumer removes the consumer if it is being deleted and it has no resources.
+func (s *sqlResourceService) removeDeletingConsumer(ctx context.Context, consumerName string) *errors.ServiceError {
+	consumer, err := s.consumerDao.GetByName(ctx, consumerName)
+	if err != nil {
+		if e.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return handleGetError("Consumer", "name", consumerName, err)
+	}
+
+	if consumer.DeletedAt.Time.IsZero() {
+		return nil
+	}
+
+	if _, err := s.resourceDao.FirstByConsumerName(ctx, consumerName, true); err == nil {
+		// the consumer still has resources
+		return nil
+	} else if !e.Is(err, gorm.ErrRecordNotFound) {
+		return errors.GeneralError("Unable to find resources of consumer %s: %s", consumerName, err)
+	}
+
+	if err := s.consumerDao.Delete(ctx, consumer.ID, true); err != nil {
+		return handleDeleteError("Consumer", err)
+	}
+
+	klog.FromContext(ctx).Info("removed the deleting consumer after its last resource was deleted", "consumer", consumerName)
 	return nil
 }
 
@@ -0,0 +1,517 @@
+package services
+
+import (
+	"context"
+	e "errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// Apply merges the manifest bundle of the resource into the stored one with the server-side apply semantics. The
+// fields of the manifest bundle are owned by the field manager, the fields that the manager applied before but
+// no longer applies are removed unless they are owned by another manager, and the fields of the other managers
+// are kept. The resource is created if it does not exist.
+//
+// Changing a field that is owned by another manager is a conflict, the conflicts are rejected unless force is
+// true, in which case the manager takes over the ownership of the conflicting fields.
+func (s *sqlResourceService) Apply(ctx context.Context, manager string, resource *api.Resource, force bool) (*api.Resource, *errors.ServiceError) {
+	applied, event, serviceErr := s.serverSideApply(ctx, manager, resource, force)
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	if event == nil {
+		return applied, nil
+	}
+
+	if _, err := s.events.Create(ctx, event); err != nil {
+		return nil, handleUpdateError("Resource", err)
+	}
+
+	return applied, nil
+}
+
+// serverSideApply applies the resource and returns the event of the create or update, the event is not saved and it
+// is nil if the manifest bundle is not changed.
+func (s *sqlResourceService) serverSideApply(ctx context.Context, manager string, resource *api.Resource, force bool) (*api.Resource, *api.Event, *errors.ServiceError) {
+	if manager == "" {
+		return nil, nil, errors.Validation("the field manager is required to apply the resource")
+	}
+	if err := ValidateManifestBundle(resource.Payload); err != nil {
+		return nil, nil, errors.Validation("the manifest bundle in the resource is invalid, %v", err)
+	}
+	applied, err := api.DecodeManifestBundle(resource.Payload)
+	if err != nil {
+		return nil, nil, errors.Validation("the manifest bundle in the resource is invalid, %v", err)
+	}
+
+	// the apply is a read–modify–write of the manifest bundle, it is merged and admitted without the lock of the
+	// resource and saved under the lock only if the resource is not changed meanwhile, the same as the update.
+	for attempt := 1; ; attempt++ {
+		found, err := s.resourceDao.Get(ctx, resource.ID)
+		if err != nil {
+			if !e.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, handleGetError("Resource", "id", resource.ID, err)
+			}
+			_, managed, _ := mergeManifestBundle(nil, applied, nil, manager, false)
+			resource.ManagedFields = encodeManagedFields(managed)
+			return s.create(ctx, resource)
+		}
+
+		if !found.DeletedAt.Time.IsZero() {
+			return nil, nil, errors.Conflict("the resource is under deletion, id: %s", resource.ID)
+		}
+
+		// the apply does not require the resource version, it is checked only if it is set.
+		if resource.Version != 0 && found.Version != resource.Version {
+			return nil, nil, errors.Conflict("the resource version is not the latest, the latest version: %d", found.Version)
+		}
+
+		live, err := api.DecodeManifestBundle(found.Payload)
+		if err != nil {
+			return nil, nil, errors.GeneralError("Unable to decode the manifest bundle of resource %s: %s", resource.ID, err)
+		}
+
+		merged, managed, conflicts := mergeManifestBundle(live, applied, decodeManagedFields(found.ManagedFields), manager, force)
+		if len(conflicts) > 0 && !force {
+			return nil, nil, errors.Conflict("%s", formatFieldConflicts(conflicts))
+		}
+
+		managedFields := encodeManagedFields(managed)
+		var update *resourceUpdate
+		if reflect.DeepEqual(normalize(merged), normalize(live)) {
+			if reflect.DeepEqual(normalize(managedFields), normalize(found.ManagedFields)) {
+				return found, nil, nil
+			}
+			update = &resourceUpdate{found: found, managedFields: managedFields}
+		} else {
+			// the merged manifest bundle is encoded with the source of the resource, so that it is published with a
+			// new cloudevent id as an update.
+			payload, err := api.EncodeManifestBundle(found.Source, merged)
+			if err != nil {
+				return nil, nil, errors.GeneralError("Unable to encode the manifest bundle of resource %s: %s", resource.ID, err)
+			}
+
+			var serviceErr *errors.ServiceError
+			update, serviceErr = s.admitUpdate(ctx, &api.Resource{
+				Meta:         api.Meta{ID: found.ID},
+				Version:      found.Version,
+				Source:       found.Source,
+				ConsumerName: found.ConsumerName,
+				Payload:      payload,
+			}, found, managedFields)
+			if serviceErr != nil {
+				return nil, nil, serviceErr
+			}
+		}
+
+		updated, event, stale, serviceErr := s.saveUpdate(ctx, update)
+		if !stale {
+			return updated, event, serviceErr
+		}
+		if attempt == maxUpdateAttempts {
+			return nil, nil, errors.Conflict("the resource %s is changed by other requests during the apply", resource.ID)
+		}
+	}
+}
+
+// fieldConflict is a field that the applied manifest bundle changes but is owned by another manager.
+type fieldConflict struct {
+	manager string
+	path    string
+}
+
+// formatFieldConflicts builds the error message of the conflicts in the same format as the Kubernetes
+// server-side apply, so that the owning managers and their fields are reported.
+func formatFieldConflicts(conflicts []fieldConflict) string {
+	noun := "conflict"
+	if len(conflicts) > 1 {
+		noun = "conflicts"
+	}
+	messages := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		messages = append(messages, fmt.Sprintf("conflict with %q: %s", conflict.manager, conflict.path))
+	}
+	return fmt.Sprintf("Apply failed with %d %s: %s", len(conflicts), noun, strings.Join(messages, "; "))
+}
+
+// mergeManifestBundle merges the applied manifest bundle of the manager into the live one and returns the merged
+// manifest bundle and the managed fields, the live manifest bundle is nil if the resource does not exist. The
+// conflicts are returned without merging unless force is true.
+//
+// The fields are addressed by JSON pointers into the manifest bundle, where the manifests are keyed by their
+// apiVersion, kind, namespace and name instead of their index, e.g.
+// /manifests/apps~1v1~1Deployment~1default~1nginx/spec/replicas. The maps are merged by their keys, the other
+// values, including the lists, the manifest configs and the delete option, are owned as a whole.
+func mergeManifestBundle(live, applied *api.ManifestBundleWrapper, managed map[string][]string, manager string,
+	force bool) (*api.ManifestBundleWrapper, map[string][]string, []fieldConflict) {
+	tree, order := manifestBundleTree(live)
+	appliedTree, appliedOrder := manifestBundleTree(applied)
+	appliedFields := map[string]interface{}{}
+	collectFields(nil, appliedTree, appliedFields)
+
+	// the fields of the other managers that the applied values change
+	conflicts := []fieldConflict{}
+	for _, other := range sortedManagers(managed) {
+		if other == manager {
+			continue
+		}
+		for _, path := range managed[other] {
+			for appliedPath, value := range appliedFields {
+				if changesField(tree, appliedPath, value, path) {
+					conflicts = append(conflicts, fieldConflict{manager: other, path: path})
+					break
+				}
+			}
+		}
+	}
+	if len(conflicts) > 0 && !force {
+		return nil, nil, conflicts
+	}
+
+	result := map[string][]string{}
+	for other, paths := range managed {
+		if other == manager {
+			continue
+		}
+		kept := []string{}
+		for _, path := range paths {
+			if !containsFieldConflict(conflicts, other, path) {
+				kept = append(kept, path)
+			}
+		}
+		result[other] = kept
+	}
+
+	// the fields that the manager no longer applies are removed if no one else owns them
+	for _, path := range managed[manager] {
+		if coveredByFields(path, appliedFields) || ownedByOthers(tree, path, result) {
+			continue
+		}
+		removeField(tree, path, func(parent string) bool { return ownedByOthers(tree, parent, result) })
+	}
+
+	for path, value := range appliedFields {
+		// an empty map only claims the existence of the map, the fields in the live map are kept
+		if current, _ := fieldValue(tree, path); isEmptyMap(value) && isMap(current) {
+			continue
+		}
+		setField(tree, splitFieldPath(path), value)
+	}
+
+	// the new manifests are appended after the live ones in the applied order
+	seen := map[string]bool{}
+	for _, key := range order {
+		seen[key] = true
+	}
+	for _, key := range appliedOrder {
+		if !seen[key] {
+			order = append(order, key)
+		}
+	}
+
+	appliedPaths := make([]string, 0, len(appliedFields))
+	for path := range appliedFields {
+		appliedPaths = append(appliedPaths, path)
+	}
+	result[manager] = appliedPaths
+
+	// the fields that no longer exist, e.g. they are overwritten by a forced apply, are not owned by anyone
+	for owner, paths := range result {
+		existing := []string{}
+		for _, path := range paths {
+			if _, ok := fieldValue(tree, path); ok {
+				existing = append(existing, path)
+			}
+		}
+		if len(existing) == 0 {
+			delete(result, owner)
+			continue
+		}
+		sort.Strings(existing)
+		result[owner] = existing
+	}
+
+	return manifestBundleFromTree(tree, order), result, conflicts
+}
+
+// retainManagedFields keeps the managed fields whose values are not changed by an update that replaces the whole
+// manifest bundle, the changed fields are no longer owned by their managers.
+func retainManagedFields(managed map[string][]string, live, desired *api.ManifestBundleWrapper) map[string][]string {
+	liveTree, _ := manifestBundleTree(live)
+	desiredTree, _ := manifestBundleTree(desired)
+
+	result := map[string][]string{}
+	for manager, paths := range managed {
+		kept := []string{}
+		for _, path := range paths {
+			liveValue, _ := fieldValue(liveTree, path)
+			desiredValue, ok := fieldValue(desiredTree, path)
+			if ok && reflect.DeepEqual(liveValue, desiredValue) {
+				kept = append(kept, path)
+			}
+		}
+		if len(kept) > 0 {
+			result[manager] = kept
+		}
+	}
+	return result
+}
+
+// manifestBundleTree converts the manifest bundle to a tree of JSON values that the field paths address, and
+// returns the keys of the manifests in their order.
+func manifestBundleTree(manifestBundle *api.ManifestBundleWrapper) (map[string]interface{}, []string) {
+	tree := map[string]interface{}{}
+	order := []string{}
+	if manifestBundle == nil {
+		return tree, order
+	}
+
+	if len(manifestBundle.Meta) > 0 {
+		tree["metadata"] = normalize(manifestBundle.Meta)
+	}
+	manifests := map[string]interface{}{}
+	for _, manifest := range manifestBundle.Manifests {
+		key := manifestKey(manifest)
+		if _, ok := manifests[key]; !ok {
+			order = append(order, key)
+		}
+		manifests[key] = normalize(manifest)
+	}
+	if len(manifests) > 0 {
+		tree["manifests"] = manifests
+	}
+	if len(manifestBundle.ManifestConfigs) > 0 {
+		tree["manifestConfigs"] = normalize(manifestBundle.ManifestConfigs)
+	}
+	if len(manifestBundle.DeleteOption) > 0 {
+		tree["deleteOption"] = normalize(manifestBundle.DeleteOption)
+	}
+	return tree, order
+}
+
+// manifestBundleFromTree converts the tree back to the manifest bundle, the manifests are in the given order.
+func manifestBundleFromTree(tree map[string]interface{}, order []string) *api.ManifestBundleWrapper {
+	manifestBundle := &api.ManifestBundleWrapper{Meta: map[string]interface{}{}}
+	if meta, ok := tree["metadata"].(map[string]interface{}); ok {
+		manifestBundle.Meta = meta
+	}
+	manifests, _ := tree["manifests"].(map[string]interface{})
+	for _, key := range order {
+		if manifest, ok := manifests[key].(map[string]interface{}); ok {
+			manifestBundle.Manifests = append(manifestBundle.Manifests, manifest)
+		}
+	}
+	if configs, ok := tree["manifestConfigs"].([]interface{}); ok {
+		for _, config := range configs {
+			if config, ok := config.(map[string]interface{}); ok {
+				manifestBundle.ManifestConfigs = append(manifestBundle.ManifestConfigs, config)
+			}
+		}
+	}
+	if deleteOption, ok := tree["deleteOption"].(map[string]interface{}); ok {
+		manifestBundle.DeleteOption = deleteOption
+	}
+	return manifestBundle
+}
+
+// collectFields collects the paths of the leaf fields of the value and their values. The manifest configs and the
+// delete option are leaves, the timestamps of the work metadata are set by the server and are not collected.
+func collectFields(segments []string, value interface{}, fields map[string]interface{}) {
+	node, ok := value.(map[string]interface{})
+	atomic := len(segments) == 1 && (segments[0] == "manifestConfigs" || segments[0] == "deleteOption")
+	if !ok || atomic || (len(node) == 0 && len(segments) > 1) {
+		fields[fieldPath(segments)] = value
+		return
+	}
+
+	for key, child := range node {
+		if len(segments) == 1 && segments[0] == "metadata" && (key == "creationTimestamp" || key == "deletionTimestamp") {
+			continue
+		}
+		collectFields(append(segments[:len(segments):len(segments)], key), child, fields)
+	}
+}
+
+// fieldValue returns the value of the field at the path, false is returned if the field does not exist.
+func fieldValue(tree map[string]interface{}, path string) (interface{}, bool) {
+	return lookupField(tree, splitFieldPath(path))
+}
+
+func lookupField(tree map[string]interface{}, segments []string) (interface{}, bool) {
+	var value interface{} = tree
+	for _, segment := range segments {
+		node, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if value, ok = node[segment]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// setField sets the value of the field at the path, the missing or non-map parents are replaced with maps.
+func setField(node map[string]interface{}, segments []string, value interface{}) {
+	for _, segment := range segments[:len(segments)-1] {
+		child, ok := node[segment].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			node[segment] = child
+		}
+		node = child
+	}
+	node[segments[len(segments)-1]] = value
+}
+
+// removeField removes the field at the path, the parents that become empty are removed too unless they are owned.
+func removeField(tree map[string]interface{}, path string, owned func(path string) bool) {
+	segments := splitFieldPath(path)
+	for i := len(segments); i > 0; i-- {
+		parent, ok := lookupField(tree, segments[:i-1])
+		node, isMap := parent.(map[string]interface{})
+		if !ok || !isMap {
+			return
+		}
+		delete(node, segments[i-1])
+		if len(node) > 0 || i == 1 || owned(fieldPath(segments[:i-1])) {
+			return
+		}
+	}
+}
+
+// fieldPath builds the JSON pointer of the path segments.
+func fieldPath(segments []string) string {
+	escaped := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		escaped = append(escaped, strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1"))
+	}
+	return "/" + strings.Join(escaped, "/")
+}
+
+// splitFieldPath splits the JSON pointer into the path segments.
+func splitFieldPath(path string) []string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+	return segments
+}
+
+// overlapFields returns true if the fields are the same or one of them contains the other.
+func overlapFields(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+// changesField returns true if setting the applied value at the applied path changes the field that is owned at
+// the owned path. An owned map is only its existence, so the fields set into it or the empty map applied to it do
+// not change it.
+func changesField(tree map[string]interface{}, appliedPath string, value interface{}, ownedPath string) bool {
+	current, _ := fieldValue(tree, appliedPath)
+	switch {
+	case !overlapFields(appliedPath, ownedPath):
+		return false
+	case strings.HasPrefix(appliedPath, ownedPath+"/"):
+		owned, ok := fieldValue(tree, ownedPath)
+		return ok && !isMap(owned)
+	case isEmptyMap(value):
+		return current != nil && !isMap(current)
+	default:
+		return !reflect.DeepEqual(current, value)
+	}
+}
+
+// coveredByFields returns true if the field is set by one of the fields, which is the field itself or one of its
+// parents that is not an empty map.
+func coveredByFields(path string, fields map[string]interface{}) bool {
+	for field, value := range fields {
+		if field == path || (strings.HasPrefix(path, field+"/") && !isEmptyMap(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isMap(value interface{}) bool {
+	_, ok := value.(map[string]interface{})
+	return ok
+}
+
+func isEmptyMap(value interface{}) bool {
+	node, ok := value.(map[string]interface{})
+	return ok && len(node) == 0
+}
+
+// ownedByOthers returns true if the field is owned by the other managers, which own the field itself, one of its
+// fields or one of its parents that is not a map.
+func ownedByOthers(tree map[string]interface{}, path string, managed map[string][]string) bool {
+	for _, paths := range managed {
+		for _, owned := range paths {
+			if !overlapFields(path, owned) {
+				continue
+			}
+			if value, _ := fieldValue(tree, owned); !strings.HasPrefix(path, owned+"/") || !isMap(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func containsFieldConflict(conflicts []fieldConflict, manager, path string) bool {
+	for _, conflict := range conflicts {
+		if conflict.manager == manager && conflict.path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedManagers(managed map[string][]string) []string {
+	managers := make([]string, 0, len(managed))
+	for manager := range managed {
+		managers = append(managers, manager)
+	}
+	sort.Strings(managers)
+	return managers
+}
+
+// decodeManagedFields converts the stored managed fields to the field paths of each manager.
+func decodeManagedFields(managedFields datatypes.JSONMap) map[string][]string {
+	managed := map[string][]string{}
+	for manager, value := range managedFields {
+		switch paths := value.(type) {
+		case []string:
+			managed[manager] = append(managed[manager], paths...)
+		case []interface{}:
+			for _, path := range paths {
+				if path, ok := path.(string); ok {
+					managed[manager] = append(managed[manager], path)
+				}
+			}
+		}
+	}
+	return managed
+}
+
+// encodeManagedFields converts the field paths of each manager to the stored managed fields, it is nil if no
+// field is managed.
+func encodeManagedFields(managed map[string][]string) datatypes.JSONMap {
+	if len(managed) == 0 {
+		return nil
+	}
+	managedFields := datatypes.JSONMap{}
+	for manager, paths := range managed {
+		managedFields[manager] = paths
+	}
+	return managedFields
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+	"gorm.io/datatypes"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+func TestResourceApply(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	eventDAO := mocks.NewEventDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), mocks.NewResourceDao(), mocks.NewConsumerDao(), NewEventService(eventDAO), nil, ResourceServiceOptions{})
+
+	// the apply creates the resource that does not exist
+	resource, svcErr := resourceService.Apply(ctx, "manager-a", &api.Resource{
+		Meta:         api.Meta{ID: Breviceratops},
+		Source:       "maestro",
+		ConsumerName: Fukuisaurus,
+		Payload:      newApplyPayload(t, map[string]interface{}{"version": "v1", "a": "a"}),
+	}, false)
+	gm.Expect(svcErr).To(gm.BeNil())
+	created := resource.Version
+	gm.Expect(decodeManagedFields(resource.ManagedFields)).To(gm.HaveKeyWithValue("manager-a", gm.ContainElements(
+		configMapField("data", "a"), configMapField("data", "version"))))
+
+	// the fields of another manager are merged
+	resource, svcErr = resourceService.Apply(ctx, "manager-b", &api.Resource{
+		Meta:    api.Meta{ID: Breviceratops},
+		Payload: newApplyPayload(t, map[string]interface{}{"b": "b"}),
+	}, false)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(created + 1))
+	gm.Expect(configMapData(t, resource)).To(gm.Equal(map[string]interface{}{"version": "v1", "a": "a", "b": "b"}))
+
+	// changing a field of another manager is a conflict that names the owner
+	_, svcErr = resourceService.Apply(ctx, "manager-b", &api.Resource{
+		Meta:    api.Meta{ID: Breviceratops},
+		Payload: newApplyPayload(t, map[string]interface{}{"b": "b", "version": "v2"}),
+	}, false)
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorConflict))
+	gm.Expect(svcErr.Reason).To(gm.Equal(`Apply failed with 1 conflict: conflict with "manager-a": ` + configMapField("data", "version")))
+
+	// setting a field of another manager to the same value shares its ownership
+	resource, svcErr = resourceService.Apply(ctx, "manager-b", &api.Resource{
+		Meta:    api.Meta{ID: Breviceratops},
+		Payload: newApplyPayload(t, map[string]interface{}{"b": "b", "version": "v1"}),
+	}, false)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(created + 1))
+
+	// the forced apply takes over the conflicting field
+	resource, svcErr = resourceService.Apply(ctx, "manager-b", &api.Resource{
+		Meta:    api.Meta{ID: Breviceratops},
+		Payload: newApplyPayload(t, map[string]interface{}{"b": "b", "version": "v2"}),
+	}, true)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(created + 2))
+	gm.Expect(configMapData(t, resource)).To(gm.Equal(map[string]interface{}{"version": "v2", "a": "a", "b": "b"}))
+	gm.Expect(decodeManagedFields(resource.ManagedFields)["manager-a"]).NotTo(gm.ContainElement(configMapField("data", "version")))
+
+	// the fields that the manager no longer applies are removed
+	resource, svcErr = resourceService.Apply(ctx, "manager-a", &api.Resource{
+		Meta:    api.Meta{ID: Breviceratops},
+		Payload: newApplyPayload(t, map[string]interface{}{}),
+	}, false)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(created + 3))
+	gm.Expect(configMapData(t, resource)).To(gm.Equal(map[string]interface{}{"version": "v2", "b": "b"}))
+
+	// the create and each of the updates emit an event
+	events, err := eventDAO.All(ctx)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(events)).To(gm.Equal(4))
+
+	// the apply requires a field manager
+	_, svcErr = resourceService.Apply(ctx, "", &api.Resource{
+		Meta:    api.Meta{ID: Breviceratops},
+		Payload: newApplyPayload(t, map[string]interface{}{}),
+	}, false)
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorValidation))
+}
+
+func TestRetainManagedFields(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	live := &api.ManifestBundleWrapper{Manifests: []map[string]interface{}{newConfigMap(map[string]interface{}{"a": "a", "b": "b"})}}
+	desired := &api.ManifestBundleWrapper{Manifests: []map[string]interface{}{newConfigMap(map[string]interface{}{"a": "a", "b": "c"})}}
+
+	// the fields whose values are replaced are no longer owned
+	managed := retainManagedFields(map[string][]string{
+		"manager-a": {configMapField("data", "a")},
+		"manager-b": {configMapField("data", "b")},
+	}, live, desired)
+	gm.Expect(managed).To(gm.Equal(map[string][]string{"manager-a": {configMapField("data", "a")}}))
+}
+
+func TestFieldPath(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	segments := []string{"manifests", "apps/v1/Deployment/default/nginx", "metadata", "annotations", "a~b"}
+	path := fieldPath(segments)
+	gm.Expect(path).To(gm.Equal("/manifests/apps~1v1~1Deployment~1default~1nginx/metadata/annotations/a~0b"))
+	gm.Expect(splitFieldPath(path)).To(gm.Equal(segments))
+
+	gm.Expect(overlapFields("/manifests/a/spec", "/manifests/a/spec/replicas")).To(gm.BeTrue())
+	gm.Expect(overlapFields("/manifests/a/spec", "/manifests/a/specs")).To(gm.BeFalse())
+}
+
+func newConfigMap(data map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+		"data":       data,
+	}
+}
+
+func newApplyPayload(t *testing.T, data map[string]interface{}) datatypes.JSONMap {
+	payload, err := api.EncodeManifestBundle("maestro", &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{newConfigMap(data)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+func configMapField(segments ...string) string {
+	return fieldPath(append([]string{"manifests", "v1/ConfigMap/default/nginx"}, segments...))
+}
+
+func configMapData(t *testing.T, resource *api.Resource) map[string]interface{} {
+	manifestBundle, err := api.DecodeManifestBundle(resource.Payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := manifestBundle.Manifests[0]["data"].(map[string]interface{})
+	return data
+}
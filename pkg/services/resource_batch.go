@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/db"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// MaxBatchOperations is the maximum number of operations in one resource batch.
+const MaxBatchOperations = 500
+
+// batchOperationSavepoint is the savepoint that a failed operation of a non-atomic batch is rolled back to.
+const batchOperationSavepoint = "batch_operation"
+
+// ResourceOperation is an operation on a resource in a batch, the type of the operation is one of the
+// create, update and delete event types.
+type ResourceOperation struct {
+	Type api.EventType
+	// Resource is the resource to create or update, only its ID is required to delete it.
+	Resource *api.Resource
+	// Error is set if the caller failed to prepare the operation, the operation is not applied and the
+	// error is reported as its result.
+	Error *errors.ServiceError
+}
+
+// ResourceOperationResult is the result of an operation in a batch, the Error is nil if the operation succeeded.
+type ResourceOperationResult struct {
+	Resource *api.Resource
+	Error    *errors.ServiceError
+}
+
+// Batch applies the operations in order and returns their results in the same order. The events of the applied
+// operations are saved with a single insert after all of the operations are applied.
+//
+// The operations join the transaction stored in the context, which is started by the TransactionMiddleware for REST
+// requests, so the events are committed together with the resources of the operations.
+// In the atomic mode, the batch stops on the first failed operation and the transaction is marked for rollback, so
+// either all of the operations are applied or none of them. The transaction is required in this mode.
+// In the non-atomic mode, each operation is applied after a savepoint and a failed operation is rolled back to its
+// savepoint, so it does not affect the others.
+func (s *sqlResourceService) Batch(ctx context.Context, operations []ResourceOperation, atomic bool) ([]ResourceOperationResult, *errors.ServiceError) {
+	if len(operations) > MaxBatchOperations {
+		return nil, errors.Validation("the batch has %d operations, the maximum is %d", len(operations), MaxBatchOperations)
+	}
+
+	if atomic {
+		var err error
+		if ctx, err = db.JoinTransaction(ctx); err != nil {
+			return nil, errors.GeneralError("Unable to start the atomic batch: %s", err)
+		}
+	} else if txCtx, err := db.JoinTransaction(ctx); err == nil {
+		ctx = txCtx
+	}
+
+	results := make([]ResourceOperationResult, len(operations))
+	events := api.EventList{}
+	for i, operation := range operations {
+		rollback := func() error { return nil }
+		if !atomic {
+			var err error
+			if rollback, err = db.Savepoint(ctx, batchOperationSavepoint); err != nil {
+				return nil, errors.GeneralError("Unable to start the operation %d of the batch: %s", i, err)
+			}
+		}
+
+		resource, event, serviceErr := s.apply(ctx, operation)
+		if serviceErr != nil {
+			results[i].Error = serviceErr
+			if atomic {
+				db.MarkForRollback(ctx, serviceErr)
+				return abortBatch(results, i), nil
+			}
+			if err := rollback(); err != nil {
+				db.MarkForRollback(ctx, err)
+				return nil, errors.GeneralError("Unable to roll back the operation %d of the batch: %s", i, err)
+			}
+			continue
+		}
+
+		results[i].Resource = resource
+		if event != nil {
+			events = append(events, event)
+		}
+	}
+
+	if _, serviceErr := s.events.CreateBatch(ctx, events); serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	klog.FromContext(ctx).Info("Applied resource batch", "operations", len(operations), "events", len(events), "atomic", atomic)
+	return results, nil
+}
+
+// apply applies one operation of a batch and returns the event of the operation, the event is not saved.
+func (s *sqlResourceService) apply(ctx context.Context, operation ResourceOperation) (*api.Resource, *api.Event, *errors.ServiceError) {
+	if operation.Error != nil {
+		return nil, nil, operation.Error
+	}
+	if operation.Resource == nil {
+		return nil, nil, errors.Validation("the resource of the %s operation is required", operation.Type)
+	}
+
+	switch operation.Type {
+	case api.CreateEventType:
+		return s.create(ctx, operation.Resource)
+	case api.UpdateEventType:
+		return s.update(ctx, operation.Resource)
+	case api.DeleteEventType:
+		event, serviceErr := s.markAsDeleting(ctx, operation.Resource.ID)
+		if serviceErr != nil {
+			return nil, nil, serviceErr
+		}
+		return operation.Resource, event, nil
+	default:
+		return nil, nil, errors.Validation("unsupported operation type %s", operation.Type)
+	}
+}
+
+// abortBatch reports the operations other than the failed one as not applied, the resources of the operations
+// applied before the failure are cleared since they are rolled back.
+func abortBatch(results []ResourceOperationResult, failed int) []ResourceOperationResult {
+	for i := range results {
+		if i == failed {
+			continue
+		}
+		results[i].Resource = nil
+		results[i].Error = errors.Conflict("the operation is not applied since the operation %d of the atomic batch failed", failed)
+	}
+	return results
+}
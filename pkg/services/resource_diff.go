@@ -0,0 +1,303 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	e "errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// DryRun compares the manifest bundle of the resource with the stored one and validates it as the create or update
+// would do, nothing is saved and no event is emitted. The requests that would be rejected for other reasons than an
+// invalid manifest bundle, e.g. a version conflict, are rejected with the same errors as the create or update.
+func (s *sqlResourceService) DryRun(ctx context.Context, action api.EventType, resource *api.Resource) (*api.ResourceDiff, *errors.ServiceError) {
+	diff := &api.ResourceDiff{
+		ResourceID:   resource.ID,
+		ConsumerName: resource.ConsumerName,
+		Action:       action,
+	}
+
+	var found *api.Resource
+	var current *api.ManifestBundleWrapper
+	desired := resource.Payload
+	operation := AdmissionCreate
+	switch action {
+	case api.CreateEventType:
+		if resource.ID != "" {
+			if _, err := s.resourceDao.Get(ctx, resource.ID); err == nil {
+				return nil, errors.Conflict("the resource already exists, id: %s", resource.ID)
+			} else if !e.Is(err, gorm.ErrRecordNotFound) {
+				return nil, handleGetError("Resource", "id", resource.ID, err)
+			}
+		}
+		if resource.Name != "" {
+			if err := ValidateResourceName(resource); err != nil {
+				diff.ValidationErrors = append(diff.ValidationErrors, fmt.Sprintf("the name in the resource is invalid, %v", err))
+			}
+		}
+		consumer, err := s.consumerDao.GetByName(ctx, resource.ConsumerName)
+		if err != nil {
+			if !e.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.GeneralError("Unable to get consumer %s: %s", resource.ConsumerName, err)
+			}
+			diff.ValidationErrors = append(diff.ValidationErrors, fmt.Sprintf("the consumer %s does not exist", resource.ConsumerName))
+		}
+		if consumer != nil && !consumer.DeletedAt.Time.IsZero() {
+			return nil, errors.Conflict("the consumer %s is under deletion", resource.ConsumerName)
+		}
+	case api.UpdateEventType:
+		var err error
+		found, err = s.resourceDao.Get(ctx, resource.ID)
+		if err != nil {
+			return nil, handleGetError("Resource", "id", resource.ID, err)
+		}
+		if !found.DeletedAt.Time.IsZero() {
+			return nil, errors.Conflict("the resource is under deletion, id: %s", resource.ID)
+		}
+		if found.Version != resource.Version && (resource.Patch == nil || resource.Version != 0) {
+			return nil, errors.Conflict("the resource version is not the latest, the latest version: %d", found.Version)
+		}
+		diff.ConsumerName = found.ConsumerName
+		diff.CurrentVersion = found.Version
+
+		current, err = api.DecodeManifestBundle(found.Payload)
+		if err != nil {
+			return nil, errors.GeneralError("Unable to decode the manifest bundle of resource %s: %s", resource.ID, err)
+		}
+		if resource.Patch != nil {
+			var serviceErr *errors.ServiceError
+			if desired, serviceErr = patchManifestBundle(found, resource.PatchType, resource.Patch); serviceErr != nil {
+				if serviceErr.Code != errors.ErrorValidation {
+					return nil, serviceErr
+				}
+				diff.ValidationErrors = append(diff.ValidationErrors, serviceErr.Reason)
+				return diff, nil
+			}
+		}
+		// A nil manifest keeps the current one.
+		if desired == nil {
+			desired = found.Payload
+		}
+		operation = AdmissionUpdate
+	default:
+		return nil, errors.Validation("dry run is not supported for the %s action", action)
+	}
+
+	diff.ValidationErrors = append(diff.ValidationErrors, validateManifestBundleForDryRun(desired)...)
+
+	// the admission plugins run only on a valid resource as the create or update does, the rejection is
+	// reported as a validation error and the mutation is included in the diff.
+	if len(diff.ValidationErrors) == 0 && (found == nil || !reflect.DeepEqual(desired, found.Payload)) {
+		admitted := *resource
+		admitted.Payload = desired
+		payload, serviceErr := s.admit(ctx, operation, &admitted, found, true)
+		if serviceErr != nil {
+			if serviceErr.Code != errors.ErrorValidation {
+				return nil, serviceErr
+			}
+			diff.ValidationErrors = append(diff.ValidationErrors, serviceErr.Reason)
+		} else {
+			desired = payload
+		}
+	}
+
+	manifestBundle, err := api.DecodeManifestBundle(desired)
+	if err != nil {
+		// the decoding error is reported as a validation error, there is nothing to compare.
+		return diff, nil
+	}
+	diffManifestBundle(diff, current, manifestBundle)
+	return diff, nil
+}
+
+// validateManifestBundleForDryRun collects the validation errors of each manifest, ValidateManifestBundle only
+// returns the first one.
+func validateManifestBundleForDryRun(payload datatypes.JSONMap) []string {
+	manifestBundle, err := api.DecodeManifestBundle(payload)
+	if err != nil || manifestBundle == nil {
+		return []string{ValidateManifestBundle(payload).Error()}
+	}
+
+	var validationErrors []string
+	for i, manifest := range manifestBundle.Manifests {
+		if err := ValidateObject(manifest); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("manifests[%d]: %v", i, err))
+		}
+	}
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+	if err := ValidateManifestBundle(payload); err != nil {
+		validationErrors = append(validationErrors, err.Error())
+	}
+	return validationErrors
+}
+
+// diffManifestBundle compares the desired manifest bundle with the current one, the current manifest bundle is
+// nil for a create. The manifests are matched by their apiVersion, kind, namespace and name.
+func diffManifestBundle(diff *api.ResourceDiff, current, desired *api.ManifestBundleWrapper) {
+	if current == nil {
+		current = &api.ManifestBundleWrapper{}
+	}
+	if desired == nil {
+		desired = &api.ManifestBundleWrapper{}
+	}
+
+	currentManifests := map[string]map[string]interface{}{}
+	for _, manifest := range current.Manifests {
+		currentManifests[manifestKey(manifest)] = manifest
+	}
+	desiredKeys := map[string]bool{}
+	for _, manifest := range desired.Manifests {
+		key := manifestKey(manifest)
+		desiredKeys[key] = true
+		manifestDiff := newManifestDiff(manifest)
+		if found, ok := currentManifests[key]; !ok {
+			manifestDiff.Change = api.ManifestAdded
+		} else if manifestDiff.Fields = diffValues("", normalize(found), normalize(manifest), nil); len(manifestDiff.Fields) > 0 {
+			manifestDiff.Change = api.ManifestChanged
+		} else {
+			manifestDiff.Change = api.ManifestUnchanged
+		}
+		diff.Manifests = append(diff.Manifests, manifestDiff)
+	}
+	for _, manifest := range current.Manifests {
+		if desiredKeys[manifestKey(manifest)] {
+			continue
+		}
+		manifestDiff := newManifestDiff(manifest)
+		manifestDiff.Change = api.ManifestRemoved
+		diff.Manifests = append(diff.Manifests, manifestDiff)
+	}
+
+	// the creationTimestamp and deletionTimestamp of the work metadata are synced from the resource meta,
+	// they are not compared.
+	currentMeta, desiredMeta := normalize(current.Meta), normalize(desired.Meta)
+	for _, meta := range []interface{}{currentMeta, desiredMeta} {
+		if m, ok := meta.(map[string]interface{}); ok {
+			delete(m, "creationTimestamp")
+			delete(m, "deletionTimestamp")
+		}
+	}
+	diff.Fields = diffValues("metadata", currentMeta, desiredMeta, diff.Fields)
+	diff.Fields = diffValues("manifestConfigs", normalize(current.ManifestConfigs), normalize(desired.ManifestConfigs), diff.Fields)
+	diff.Fields = diffValues("deleteOption", normalize(current.DeleteOption), normalize(desired.DeleteOption), diff.Fields)
+
+	for _, manifestDiff := range diff.Manifests {
+		if manifestDiff.Change != api.ManifestUnchanged {
+			diff.Changed = true
+		}
+	}
+	if len(diff.Fields) > 0 {
+		diff.Changed = true
+	}
+}
+
+func manifestKey(manifest map[string]interface{}) string {
+	info, _ := extractManifestInfo(manifest)
+	return info.key
+}
+
+func newManifestDiff(manifest map[string]interface{}) api.ManifestDiff {
+	obj := unstructured.Unstructured{Object: manifest}
+	return api.ManifestDiff{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// diffValues appends the changes from the old value to the new value to the diffs, the maps and the slices are
+// compared by their items, the other values are compared as a whole.
+func diffValues(path string, oldValue, newValue interface{}, diffs []api.FieldDiff) []api.FieldDiff {
+	// a null value is treated as missing
+	if oldValue == nil && newValue == nil {
+		return diffs
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	if oldIsMap && newIsMap {
+		keys := []string{}
+		for key := range oldMap {
+			keys = append(keys, key)
+		}
+		for key := range newMap {
+			if _, ok := oldMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			diffs = diffValues(childPath, oldMap[key], newMap[key], diffs)
+		}
+		return diffs
+	}
+
+	oldSlice, oldIsSlice := oldValue.([]interface{})
+	newSlice, newIsSlice := newValue.([]interface{})
+	if oldIsSlice && newIsSlice {
+		for i := 0; i < len(oldSlice) || i < len(newSlice); i++ {
+			childPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(oldSlice):
+				diffs = diffValues(childPath, nil, newSlice[i], diffs)
+			case i >= len(newSlice):
+				diffs = diffValues(childPath, oldSlice[i], nil, diffs)
+			default:
+				diffs = diffValues(childPath, oldSlice[i], newSlice[i], diffs)
+			}
+		}
+		return diffs
+	}
+
+	if reflect.DeepEqual(oldValue, newValue) {
+		return diffs
+	}
+	return append(diffs, api.FieldDiff{
+		Path:     path,
+		OldValue: encodeValue(oldValue),
+		NewValue: encodeValue(newValue),
+	})
+}
+
+// normalize converts the value to its JSON representation, so the values from the database and the request
+// are compared in the same types, e.g. the numbers are float64. A nil map or slice is converted to nil.
+func normalize(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
+
+func encodeValue(value interface{}) *string {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		encoded := fmt.Sprintf("%v", value)
+		return &encoded
+	}
+	encoded := string(data)
+	return &encoded
+}
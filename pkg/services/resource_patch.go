@@ -0,0 +1,107 @@
+package services
+
+import (
+	"encoding/json"
+	"reflect"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"gorm.io/datatypes"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// manifestBundleDocument is the JSON document of the manifest bundle that the patches are applied to, it has the
+// same fields as the manifest bundle of a resource bundle in the REST API, e.g. /manifests/0/spec/replicas is the
+// replicas of the first manifest.
+type manifestBundleDocument struct {
+	Metadata        map[string]interface{}   `json:"metadata,omitempty"`
+	Manifests       []map[string]interface{} `json:"manifests"`
+	ManifestConfigs []map[string]interface{} `json:"manifestConfigs,omitempty"`
+	DeleteOption    map[string]interface{}   `json:"deleteOption,omitempty"`
+}
+
+// patchManifestBundle applies the patch to the manifest bundle of the found resource and returns the patched
+// payload, the payload is nil if the patch does not change the manifest bundle.
+func patchManifestBundle(found *api.Resource, patchType api.PatchType, patch []byte) (datatypes.JSONMap, *errors.ServiceError) {
+	current, err := api.DecodeManifestBundle(found.Payload)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to decode the manifest bundle of resource %s: %s", found.ID, err)
+	}
+	if current == nil {
+		current = &api.ManifestBundleWrapper{}
+	}
+
+	document, err := json.Marshal(manifestBundleDocument{
+		Metadata:        withoutReadOnlyWorkMeta(current.Meta),
+		Manifests:       current.Manifests,
+		ManifestConfigs: current.ManifestConfigs,
+		DeleteOption:    current.DeleteOption,
+	})
+	if err != nil {
+		return nil, errors.GeneralError("Unable to marshal the manifest bundle of resource %s: %s", found.ID, err)
+	}
+
+	var patched []byte
+	switch patchType {
+	case api.JSONPatchType:
+		jsonPatch, err := jsonpatch.DecodePatch(patch)
+		if err != nil {
+			return nil, errors.BadRequest("invalid JSON patch: %v", err)
+		}
+		if patched, err = jsonPatch.Apply(document); err != nil {
+			return nil, errors.Validation("failed to apply the JSON patch: %v", err)
+		}
+	case api.MergePatchType:
+		if patched, err = jsonpatch.MergePatch(document, patch); err != nil {
+			return nil, errors.BadRequest("invalid merge patch: %v", err)
+		}
+	default:
+		return nil, errors.BadRequest("unsupported patch type %q, the supported types are %s and %s",
+			patchType, api.JSONPatchType, api.MergePatchType)
+	}
+
+	result := manifestBundleDocument{}
+	if err := json.Unmarshal(patched, &result); err != nil {
+		return nil, errors.Validation("the patched manifest bundle is invalid, %v", err)
+	}
+	if len(result.Manifests) == 0 {
+		return nil, errors.Validation("manifests must specify at least one item")
+	}
+
+	manifestBundle := &api.ManifestBundleWrapper{
+		Meta:            withoutReadOnlyWorkMeta(result.Metadata),
+		Manifests:       result.Manifests,
+		ManifestConfigs: result.ManifestConfigs,
+		DeleteOption:    result.DeleteOption,
+	}
+	if reflect.DeepEqual(normalize(manifestBundle), normalize(&api.ManifestBundleWrapper{
+		Meta:            withoutReadOnlyWorkMeta(current.Meta),
+		Manifests:       current.Manifests,
+		ManifestConfigs: current.ManifestConfigs,
+		DeleteOption:    current.DeleteOption,
+	})) {
+		return nil, nil
+	}
+
+	// the patched manifest bundle is encoded with the source of the resource, so that it is published with a new
+	// cloudevent id as an update.
+	payload, err := api.EncodeManifestBundle(found.Source, manifestBundle)
+	if err != nil {
+		return nil, errors.Validation("the patched manifest bundle is invalid, %v", err)
+	}
+	return payload, nil
+}
+
+// withoutReadOnlyWorkMeta returns a copy of the work metadata without the creationTimestamp and deletionTimestamp,
+// they are synced from the resource meta and are not saved in the manifest bundle.
+func withoutReadOnlyWorkMeta(meta map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range meta {
+		if key == "creationTimestamp" || key == "deletionTimestamp" {
+			continue
+		}
+		result[key] = value
+	}
+	return result
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	gm "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao/mocks"
+	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+func TestResourcePatch(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	eventDAO := mocks.NewEventDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), mocks.NewResourceDao(), mocks.NewConsumerDao(), NewEventService(eventDAO), nil, ResourceServiceOptions{})
+
+	resource, svcErr := resourceService.Create(ctx, &api.Resource{
+		Meta:         api.Meta{ID: Breviceratops},
+		Version:      1,
+		Source:       "maestro",
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	// the JSON patch is applied to the latest version if the version is not set
+	resource, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:      api.Meta{ID: resource.ID},
+		Patch:     []byte(`[{"op":"replace","path":"/manifests/0/data/version","value":"v2"}]`),
+		PatchType: api.JSONPatchType,
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(int32(2)))
+	gm.Expect(configMapData(t, resource)).To(gm.Equal(map[string]interface{}{"version": "v2"}))
+
+	// the merge patch merges the maps
+	resource, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:      api.Meta{ID: resource.ID},
+		Version:   resource.Version,
+		Patch:     []byte(`{"metadata":{"labels":{"app":"nginx"}}}`),
+		PatchType: api.MergePatchType,
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(int32(3)))
+	manifestBundle, err := api.DecodeManifestBundle(resource.Payload)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(manifestBundle.Meta).To(gm.HaveKeyWithValue("labels", map[string]interface{}{"app": "nginx"}))
+	gm.Expect(configMapData(t, resource)).To(gm.Equal(map[string]interface{}{"version": "v2"}))
+
+	// the patch that changes nothing does not create a new version
+	resource, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:      api.Meta{ID: resource.ID},
+		Patch:     []byte(`[{"op":"test","path":"/manifests/0/data/version","value":"v2"}]`),
+		PatchType: api.JSONPatchType,
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(int32(3)))
+	events, err := eventDAO.All(ctx)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(events)).To(gm.Equal(3))
+
+	// the failed test operation, the stale version and the invalid patches are rejected
+	_, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:      api.Meta{ID: resource.ID},
+		Patch:     []byte(`[{"op":"test","path":"/manifests/0/data/version","value":"v1"}]`),
+		PatchType: api.JSONPatchType,
+	})
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorValidation))
+	_, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:      api.Meta{ID: resource.ID},
+		Version:   1,
+		Patch:     []byte(`{"metadata":{"labels":null}}`),
+		PatchType: api.MergePatchType,
+	})
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorConflict))
+	_, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:      api.Meta{ID: resource.ID},
+		Patch:     []byte(`[{"op":"remove","path":"/manifests/0"}]`),
+		PatchType: api.JSONPatchType,
+	})
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorValidation))
+	_, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:      api.Meta{ID: resource.ID},
+		Patch:     []byte(`{}`),
+		PatchType: "application/yaml",
+	})
+	gm.Expect(svcErr.Code).To(gm.Equal(errors.ErrorBadRequest))
+}
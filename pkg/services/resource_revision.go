@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"reflect"
+
+	"k8s.io/klog/v2"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/auth"
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// saveRevision saves the current spec of the resource as a revision, the author of the revision is the user of
+// the REST request in the context.
+func (s *sqlResourceService) saveRevision(ctx context.Context, resource *api.Resource) *errors.ServiceError {
+	if s.revisionDao == nil {
+		return nil
+	}
+	if _, err := s.revisionDao.Create(ctx, &api.ResourceRevision{
+		ResourceID: resource.ID,
+		Version:    resource.Version,
+		Source:     resource.Source,
+		Author:     auth.UsernameFromContext(ctx),
+		Payload:    resource.Payload,
+	}); err != nil {
+		return handleCreateError("ResourceRevision", err)
+	}
+	return nil
+}
+
+func (s *sqlResourceService) Revisions(ctx context.Context, id string) (api.ResourceRevisionList, *errors.ServiceError) {
+	if s.revisionDao == nil {
+		return api.ResourceRevisionList{}, nil
+	}
+	revisions, err := s.revisionDao.FindByResourceID(ctx, id)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to get revisions of resource %s: %s", id, err)
+	}
+	return revisions, nil
+}
+
+// Rollback re-applies the manifest bundle of the revision as a new version of the resource, so the rollback is
+// recorded in the revision history too. The resource is not changed if its manifest bundle is the same as the
+// revision.
+func (s *sqlResourceService) Rollback(ctx context.Context, id string, version int32) (*api.Resource, *errors.ServiceError) {
+	found, err := s.resourceDao.Get(ctx, id)
+	if err != nil {
+		return nil, handleGetError("Resource", "id", id, err)
+	}
+	if !found.DeletedAt.Time.IsZero() {
+		return nil, errors.Conflict("the resource is under deletion, id: %s", id)
+	}
+
+	if s.revisionDao == nil {
+		return nil, errors.NotFound("ResourceRevision with version='%d' not found", version)
+	}
+	revision, err := s.revisionDao.GetByVersion(ctx, id, version)
+	if err != nil {
+		return nil, handleGetError("ResourceRevision", "version", version, err)
+	}
+
+	manifestBundle, err := api.DecodeManifestBundle(revision.Payload)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to decode the manifest bundle of revision %d: %s", version, err)
+	}
+	current, err := api.DecodeManifestBundle(found.Payload)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to decode the manifest bundle of resource %s: %s", id, err)
+	}
+	if reflect.DeepEqual(manifestBundle, current) {
+		return found, nil
+	}
+
+	// the manifest bundle is encoded again, so that the rollback is published with a new cloudevent id
+	payload, err := api.EncodeManifestBundle(found.Source, manifestBundle)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to encode the manifest bundle of revision %d: %s", version, err)
+	}
+
+	updated, serviceErr := s.Update(ctx, &api.Resource{
+		Meta:    api.Meta{ID: id},
+		Version: found.Version,
+		Payload: payload,
+	})
+	if serviceErr != nil {
+		return nil, serviceErr
+	}
+
+	klog.FromContext(ctx).Info("Rolled back resource", "resourceID", id, "revision", version, "version", updated.Version)
+	return updated, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/dao/mocks"
 	dbmocks "github.com/openshift-online/maestro/pkg/db/mocks"
+	"github.com/openshift-online/maestro/pkg/errors"
 )
 
 const (
@@ -25,7 +26,7 @@ func TestResourceFindByConsumerID(t *testing.T) {
 	resourceDAO := mocks.NewResourceDao()
 	events := NewEventService(mocks.NewEventDao())
 
-	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, events, nil)
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewConsumerDao(), events, nil, ResourceServiceOptions{})
 
 	resources := api.ResourceList{
 		&api.Resource{ConsumerName: Fukuisaurus, Payload: newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}},{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"spec\":{\"replicas\":1,\"selector\":{\"matchLabels\":{\"app\":\"nginx\"}},\"template\":{\"spec\":{\"containers\":[{\"name\":\"nginx\",\"image\":\"quay.io/nginx/nginx-unprivileged:latest\"}]},\"metadata\":{\"labels\":{\"app\":\"nginx\"}}}}}],\"deleteOption\":{\"propagationPolicy\":\"Foreground\"},\"manifestConfigs\":[{\"updateStrategy\":{\"type\":\"ServerSideApply\"},\"resourceIdentifier\":{\"name\":\"nginx\",\"group\":\"apps\",\"resource\":\"deployments\",\"namespace\":\"default\"}}]}}")},
@@ -57,7 +58,7 @@ func TestCreateInvalidResource(t *testing.T) {
 
 	resourceDAO := mocks.NewResourceDao()
 	events := NewEventService(mocks.NewEventDao())
-	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, events, nil)
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewConsumerDao(), events, nil, ResourceServiceOptions{})
 
 	resource := &api.Resource{ConsumerName: "invalidation", Payload: newPayload(t, "{}")}
 
@@ -69,13 +70,49 @@ func TestCreateInvalidResource(t *testing.T) {
 	gm.Expect(len(invalidations)).To(gm.Equal(0))
 }
 
+func TestResourceBatch(t *testing.T) {
+	gm.RegisterTestingT(t)
+
+	resourceDAO := mocks.NewResourceDao()
+	eventDAO := mocks.NewEventDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewConsumerDao(), NewEventService(eventDAO), nil, ResourceServiceOptions{})
+
+	results, svcErr := resourceService.Batch(context.Background(), []ResourceOperation{
+		{Type: api.CreateEventType, Resource: &api.Resource{ConsumerName: Seismosaurus, Payload: newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}},{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"spec\":{\"replicas\":1,\"selector\":{\"matchLabels\":{\"app\":\"nginx\"}},\"template\":{\"spec\":{\"containers\":[{\"name\":\"nginx\",\"image\":\"quay.io/nginx/nginx-unprivileged:latest\"}]},\"metadata\":{\"labels\":{\"app\":\"nginx\"}}}}}],\"deleteOption\":{\"propagationPolicy\":\"Foreground\"},\"manifestConfigs\":[{\"updateStrategy\":{\"type\":\"ServerSideApply\"},\"resourceIdentifier\":{\"name\":\"nginx\",\"group\":\"apps\",\"resource\":\"deployments\",\"namespace\":\"default\"}}]}}")}},
+		{Type: api.CreateEventType, Resource: &api.Resource{ConsumerName: "invalidation", Payload: newPayload(t, "{}")}},
+		{Type: api.CreateEventType, Error: errors.Forbidden("not allowed")},
+	}, false)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(len(results)).To(gm.Equal(3))
+	gm.Expect(results[0].Error).To(gm.BeNil())
+	gm.Expect(results[0].Resource).NotTo(gm.BeNil())
+	gm.Expect(results[1].Error).NotTo(gm.BeNil())
+	gm.Expect(results[2].Error.IsForbidden()).To(gm.BeTrue())
+
+	resources, err := resourceDAO.FindByConsumerName(context.Background(), Seismosaurus)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(resources)).To(gm.Equal(1))
+
+	// only the event of the applied operation is saved
+	events, err := eventDAO.All(context.Background())
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(events)).To(gm.Equal(1))
+	gm.Expect(events[0].SourceID).To(gm.Equal(results[0].Resource.ID))
+
+	// the atomic batch requires a transaction in the context
+	_, svcErr = resourceService.Batch(context.Background(), []ResourceOperation{
+		{Type: api.DeleteEventType, Resource: &api.Resource{Meta: api.Meta{ID: results[0].Resource.ID}}},
+	}, true)
+	gm.Expect(svcErr).NotTo(gm.BeNil())
+}
+
 func TestResourceList(t *testing.T) {
 	gm.RegisterTestingT(t)
 
 	resourceDAO := mocks.NewResourceDao()
 	events := NewEventService(mocks.NewEventDao())
 
-	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, events, nil)
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), resourceDAO, mocks.NewConsumerDao(), events, nil, ResourceServiceOptions{})
 	resources := api.ResourceList{
 		&api.Resource{ConsumerName: Fukuisaurus, Payload: newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}},{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"spec\":{\"replicas\":1,\"selector\":{\"matchLabels\":{\"app\":\"nginx\"}},\"template\":{\"spec\":{\"containers\":[{\"name\":\"nginx\",\"image\":\"quay.io/nginx/nginx-unprivileged:latest\"}]},\"metadata\":{\"labels\":{\"app\":\"nginx\"}}}}}],\"deleteOption\":{\"propagationPolicy\":\"Foreground\"},\"manifestConfigs\":[{\"updateStrategy\":{\"type\":\"ServerSideApply\"},\"resourceIdentifier\":{\"name\":\"nginx\",\"group\":\"apps\",\"resource\":\"deployments\",\"namespace\":\"default\"}}]}}")},
 		&api.Resource{ConsumerName: Fukuisaurus, Payload: newPayload(t, "{\"id\":\"266a8cd2-2fab-4e89-9bf0-a56425ebcdf8\",\"time\":\"2024-02-05T17:31:05Z\",\"type\":\"io.open-cluster-management.works.v1alpha1.manifestbundles.spec.create_request\",\"source\":\"grpc\",\"specversion\":\"1.0\",\"datacontenttype\":\"application/json\",\"resourceid\":\"c4df9ff0-bfeb-5bc6-a0ab-4c9128d698b4\",\"clustername\":\"b288a9da-8bfe-4c82-94cc-2b48e773fc46\",\"resourceversion\":1,\"data\":{\"manifests\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"}},{\"apiVersion\":\"apps/v1\",\"kind\":\"Deployment\",\"metadata\":{\"name\":\"nginx\",\"namespace\":\"default\"},\"spec\":{\"replicas\":1,\"selector\":{\"matchLabels\":{\"app\":\"nginx\"}},\"template\":{\"spec\":{\"containers\":[{\"name\":\"nginx\",\"image\":\"quay.io/nginx/nginx-unprivileged:latest\"}]},\"metadata\":{\"labels\":{\"app\":\"nginx\"}}}}}],\"deleteOption\":{\"propagationPolicy\":\"Foreground\"},\"manifestConfigs\":[{\"updateStrategy\":{\"type\":\"ServerSideApply\"},\"resourceIdentifier\":{\"name\":\"nginx\",\"group\":\"apps\",\"resource\":\"deployments\",\"namespace\":\"default\"}}]}}")},
@@ -106,3 +143,162 @@ func TestResourceList(t *testing.T) {
 	gm.Expect(err).To(gm.BeNil())
 	gm.Expect(len(resources)).To(gm.Equal(1))
 }
+
+func TestResourceRollback(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	revisionDAO := mocks.NewResourceRevisionDao()
+	eventDAO := mocks.NewEventDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), mocks.NewResourceDao(), mocks.NewConsumerDao(), NewEventService(eventDAO), nil,
+		ResourceServiceOptions{Revisions: revisionDAO})
+
+	resource, svcErr := resourceService.Create(ctx, &api.Resource{
+		Meta:         api.Meta{ID: Breviceratops},
+		Version:      1,
+		Source:       "maestro",
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	resource, svcErr = resourceService.Update(ctx, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: resource.Version,
+		Payload: newPlacementPayload(t, "v2"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(int32(2)))
+
+	// a revision is saved for each version, the latest revision first
+	revisions, svcErr := resourceService.Revisions(ctx, resource.ID)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(len(revisions)).To(gm.Equal(2))
+	gm.Expect(revisions[0].Version).To(gm.Equal(int32(2)))
+	gm.Expect(revisions[1].Version).To(gm.Equal(int32(1)))
+	gm.Expect(revisions[1].Source).To(gm.Equal("maestro"))
+
+	// the rollback re-applies the revision as a new version
+	resource, svcErr = resourceService.Rollback(ctx, resource.ID, 1)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(int32(3)))
+	rolledBack, err := api.DecodeManifestBundle(resource.Payload)
+	gm.Expect(err).To(gm.BeNil())
+	original, err := api.DecodeManifestBundle(revisions[1].Payload)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(rolledBack).To(gm.Equal(original))
+
+	revisions, svcErr = resourceService.Revisions(ctx, resource.ID)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(len(revisions)).To(gm.Equal(3))
+
+	// rolling back to the current spec does not create a new version
+	resource, svcErr = resourceService.Rollback(ctx, resource.ID, 1)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(resource.Version).To(gm.Equal(int32(3)))
+	events, err := eventDAO.All(ctx)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(events)).To(gm.Equal(3))
+
+	_, svcErr = resourceService.Rollback(ctx, resource.ID, 10)
+	gm.Expect(svcErr.Is404()).To(gm.BeTrue())
+}
+
+func TestResourceDryRun(t *testing.T) {
+	gm.RegisterTestingT(t)
+	ctx := context.Background()
+
+	consumerDAO := mocks.NewConsumerDao()
+	eventDAO := mocks.NewEventDao()
+	resourceService := NewResourceService(dbmocks.NewMockAdvisoryLockFactory(), mocks.NewResourceDao(), consumerDAO, NewEventService(eventDAO), nil, ResourceServiceOptions{})
+	_, err := consumerDAO.Create(ctx, &api.Consumer{Name: Fukuisaurus})
+	gm.Expect(err).To(gm.BeNil())
+
+	// the manifests of a create are added
+	diff, svcErr := resourceService.DryRun(ctx, api.CreateEventType, &api.Resource{
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(diff.Changed).To(gm.BeTrue())
+	gm.Expect(diff.ValidationErrors).To(gm.BeEmpty())
+	gm.Expect(len(diff.Manifests)).To(gm.Equal(1))
+	gm.Expect(diff.Manifests[0].Change).To(gm.Equal(api.ManifestAdded))
+	gm.Expect(diff.Manifests[0].Name).To(gm.Equal("nginx"))
+
+	// a create on a missing consumer is reported as a validation error
+	diff, svcErr = resourceService.DryRun(ctx, api.CreateEventType, &api.Resource{
+		ConsumerName: Seismosaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(diff.ValidationErrors).To(gm.ConsistOf("the consumer " + Seismosaurus + " does not exist"))
+
+	resource, svcErr := resourceService.Create(ctx, &api.Resource{
+		Meta:         api.Meta{ID: Breviceratops},
+		Version:      1,
+		Source:       "maestro",
+		ConsumerName: Fukuisaurus,
+		Payload:      newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+
+	// the changed fields of an update are listed
+	diff, svcErr = resourceService.DryRun(ctx, api.UpdateEventType, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: resource.Version,
+		Payload: newPlacementPayload(t, "v2"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(diff.Changed).To(gm.BeTrue())
+	gm.Expect(diff.CurrentVersion).To(gm.Equal(int32(1)))
+	gm.Expect(diff.ConsumerName).To(gm.Equal(Fukuisaurus))
+	gm.Expect(len(diff.Manifests)).To(gm.Equal(1))
+	gm.Expect(diff.Manifests[0].Change).To(gm.Equal(api.ManifestChanged))
+	gm.Expect(len(diff.Manifests[0].Fields)).To(gm.Equal(1))
+	gm.Expect(diff.Manifests[0].Fields[0].Path).To(gm.Equal("data.version"))
+	gm.Expect(*diff.Manifests[0].Fields[0].OldValue).To(gm.Equal(`"v1"`))
+	gm.Expect(*diff.Manifests[0].Fields[0].NewValue).To(gm.Equal(`"v2"`))
+
+	// the same manifest bundle has no changes
+	diff, svcErr = resourceService.DryRun(ctx, api.UpdateEventType, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: resource.Version,
+		Payload: newPlacementPayload(t, "v1"),
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(diff.Changed).To(gm.BeFalse())
+	gm.Expect(diff.Manifests[0].Change).To(gm.Equal(api.ManifestUnchanged))
+
+	// the invalid manifests are reported, the resource is not changed
+	invalid, err := api.EncodeManifestBundle("maestro", &api.ManifestBundleWrapper{
+		Manifests: []map[string]interface{}{{"apiVersion": "v1", "metadata": map[string]interface{}{"name": "nginx"}}},
+	})
+	gm.Expect(err).To(gm.BeNil())
+	diff, svcErr = resourceService.DryRun(ctx, api.UpdateEventType, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: resource.Version,
+		Payload: invalid,
+	})
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(len(diff.ValidationErrors)).To(gm.Equal(1))
+	gm.Expect(diff.ValidationErrors[0]).To(gm.HavePrefix("manifests[0]:"))
+	gm.Expect(diff.Manifests).To(gm.ConsistOf(
+		gm.HaveField("Change", api.ManifestAdded),
+		gm.HaveField("Change", api.ManifestRemoved),
+	))
+
+	found, svcErr := resourceService.Get(ctx, resource.ID)
+	gm.Expect(svcErr).To(gm.BeNil())
+	gm.Expect(found.Version).To(gm.Equal(int32(1)))
+	events, err := eventDAO.All(ctx)
+	gm.Expect(err).To(gm.BeNil())
+	gm.Expect(len(events)).To(gm.Equal(1))
+
+	// 409 for a stale version
+	_, svcErr = resourceService.DryRun(ctx, api.UpdateEventType, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: 5,
+		Payload: newPlacementPayload(t, "v2"),
+	})
+	gm.Expect(svcErr.IsConflict()).To(gm.BeTrue())
+}
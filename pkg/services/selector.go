@@ -0,0 +1,156 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+// conditionFieldPrefix is the prefix of the field selectors of the status conditions, e.g.
+// status.conditions.Available=True selects the resources whose Available condition is True.
+const conditionFieldPrefix = "status.conditions."
+
+// sqlCondition is a parameterised SQL WHERE condition.
+type sqlCondition struct {
+	sql    string
+	values []interface{}
+}
+
+// labelSelectorToSQL translates a Kubernetes label selector into the conditions on the JSONB labels column.
+// The keys and values of the selector are always passed as parameters.
+func labelSelectorToSQL(column, selector string) ([]sqlCondition, *errors.ServiceError) {
+	labelSelector, err := labels.Parse(selector)
+	if err != nil {
+		return nil, errors.BadRequest("invalid labelSelector %q: %v", selector, err)
+	}
+
+	requirements, selectable := labelSelector.Requirements()
+	if !selectable {
+		return nil, nil
+	}
+
+	// refer to below links to find how to use the label selector in kubernetes
+	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#equality-based-requirement
+	// https://kubernetes.io/docs/concepts/overview/working-with-objects/labels/#set-based-requirement
+	conditions := []sqlCondition{}
+	for _, requirement := range requirements {
+		key, values := requirement.Key(), requirement.Values().List()
+		value := column + " ->> ?::text"
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals:
+			// use the containment operator, so that the condition can be served by a GIN index
+			contained, err := json.Marshal(map[string]string{key: values[0]})
+			if err != nil {
+				return nil, errors.GeneralError("Unable to marshal label %s: %s", key, err)
+			}
+			conditions = append(conditions, sqlCondition{
+				sql:    column + " @> ?::jsonb",
+				values: []interface{}{string(contained)},
+			})
+		case selection.NotEquals:
+			// the objects without the label match the not equals requirement
+			conditions = append(conditions, sqlCondition{
+				sql:    fmt.Sprintf("(%s) IS DISTINCT FROM ?", value),
+				values: []interface{}{key, values[0]},
+			})
+		case selection.In:
+			conditions = append(conditions, sqlCondition{
+				sql:    fmt.Sprintf("%s IN (?)", value),
+				values: []interface{}{key, values},
+			})
+		case selection.NotIn:
+			// the objects without the label match the not in requirement
+			conditions = append(conditions, sqlCondition{
+				sql:    fmt.Sprintf("(%s IS NULL OR %s NOT IN (?))", value, value),
+				values: []interface{}{key, key, values},
+			})
+		case selection.Exists:
+			conditions = append(conditions, sqlCondition{
+				sql:    fmt.Sprintf("%s IS NOT NULL", value),
+				values: []interface{}{key},
+			})
+		case selection.DoesNotExist:
+			conditions = append(conditions, sqlCondition{
+				sql:    fmt.Sprintf("%s IS NULL", value),
+				values: []interface{}{key},
+			})
+		case selection.GreaterThan, selection.LessThan:
+			// the label values are compared as integers, the objects whose label is not an integer do not match.
+			// NOTE: the pattern must not contain "?", which is the placeholder of the parameters.
+			bound, err := strconv.ParseInt(values[0], 10, 64)
+			if err != nil {
+				return nil, errors.BadRequest("invalid labelSelector %q: %v", selector, err)
+			}
+			operator := ">"
+			if requirement.Operator() == selection.LessThan {
+				operator = "<"
+			}
+			conditions = append(conditions, sqlCondition{
+				sql: fmt.Sprintf("CASE WHEN (%s) ~ '^-{0,1}[0-9]{1,18}$' THEN (%s)::bigint %s ? ELSE false END",
+					value, value, operator),
+				values: []interface{}{key, key, bound},
+			})
+		default:
+			return nil, errors.BadRequest("unsupported operator %s in labelSelector %q", requirement.Operator(), selector)
+		}
+	}
+	return conditions, nil
+}
+
+// resourceFieldSelectorToSQL translates a Kubernetes field selector of the resources into the conditions on the
+// resources table. The supported fields are metadata.name, metadata.namespace and status.conditions.<type>.
+func resourceFieldSelectorToSQL(tableName, selector string) ([]sqlCondition, *errors.ServiceError) {
+	fieldSelector, err := fields.ParseSelector(selector)
+	if err != nil {
+		return nil, errors.BadRequest("invalid fieldSelector %q: %v", selector, err)
+	}
+
+	conditions := []sqlCondition{}
+	for _, requirement := range fieldSelector.Requirements() {
+		equals := requirement.Operator == selection.Equals || requirement.Operator == selection.DoubleEquals
+		if !equals && requirement.Operator != selection.NotEquals {
+			return nil, errors.BadRequest("unsupported operator %s in fieldSelector %q", requirement.Operator, selector)
+		}
+
+		var condition sqlCondition
+		switch {
+		case requirement.Field == "metadata.name":
+			condition = fieldCondition(tableName+".payload -> 'metadata' ->> 'name'", requirement.Value, equals)
+		case requirement.Field == "metadata.namespace":
+			condition = fieldCondition(tableName+".consumer_name", requirement.Value, equals)
+		case strings.HasPrefix(requirement.Field, conditionFieldPrefix):
+			conditionType := strings.TrimPrefix(requirement.Field, conditionFieldPrefix)
+			if conditionType == "" {
+				return nil, errors.BadRequest("the condition type is required in fieldSelector %q", selector)
+			}
+			contained, err := json.Marshal([]map[string]string{{"type": conditionType, "status": requirement.Value}})
+			if err != nil {
+				return nil, errors.GeneralError("Unable to marshal condition %s: %s", conditionType, err)
+			}
+			// the resources without the condition match the not equals requirement
+			sql := fmt.Sprintf("COALESCE(%s.status -> 'data' -> 'conditions' @> ?::jsonb, false)", tableName)
+			if !equals {
+				sql = "NOT " + sql
+			}
+			condition = sqlCondition{sql: sql, values: []interface{}{string(contained)}}
+		default:
+			return nil, errors.BadRequest("unsupported field %s in fieldSelector %q", requirement.Field, selector)
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+func fieldCondition(column, value string, equals bool) sqlCondition {
+	if equals {
+		return sqlCondition{sql: column + " = ?", values: []interface{}{value}}
+	}
+	return sqlCondition{sql: column + " IS DISTINCT FROM ?", values: []interface{}{value}}
+}
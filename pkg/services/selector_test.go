@@ -0,0 +1,98 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-online/maestro/pkg/errors"
+)
+
+func TestLabelSelectorToSQL(t *testing.T) {
+	RegisterTestingT(t)
+
+	column := "resources.payload -> 'metadata' -> 'labels'"
+	cases := []struct {
+		selector       string
+		expectedSQL    []string
+		expectedValues [][]interface{}
+	}{
+		{
+			selector: "",
+		},
+		{
+			selector:       "a=b,c==d",
+			expectedSQL:    []string{column + " @> ?::jsonb", column + " @> ?::jsonb"},
+			expectedValues: [][]interface{}{{`{"a":"b"}`}, {`{"c":"d"}`}},
+		},
+		{
+			selector:       "a!=b",
+			expectedSQL:    []string{"(" + column + " ->> ?::text) IS DISTINCT FROM ?"},
+			expectedValues: [][]interface{}{{"a", "b"}},
+		},
+		{
+			selector:       "env in (prod,stage)",
+			expectedSQL:    []string{column + " ->> ?::text IN (?)"},
+			expectedValues: [][]interface{}{{"env", []string{"prod", "stage"}}},
+		},
+		{
+			selector:       "env notin (prod)",
+			expectedSQL:    []string{"(" + column + " ->> ?::text IS NULL OR " + column + " ->> ?::text NOT IN (?))"},
+			expectedValues: [][]interface{}{{"env", "env", []string{"prod"}}},
+		},
+		{
+			selector:       "a,!b",
+			expectedSQL:    []string{column + " ->> ?::text IS NOT NULL", column + " ->> ?::text IS NULL"},
+			expectedValues: [][]interface{}{{"a"}, {"b"}},
+		},
+		{
+			selector: "replicas>2",
+			expectedSQL: []string{"CASE WHEN (" + column + " ->> ?::text) ~ '^-{0,1}[0-9]{1,18}$' THEN (" + column +
+				" ->> ?::text)::bigint > ? ELSE false END"},
+			expectedValues: [][]interface{}{{"replicas", "replicas", int64(2)}},
+		},
+	}
+
+	for _, c := range cases {
+		conditions, serviceErr := labelSelectorToSQL(column, c.selector)
+		Expect(serviceErr).ToNot(HaveOccurred())
+		Expect(conditions).To(HaveLen(len(c.expectedSQL)), c.selector)
+		for i, condition := range conditions {
+			Expect(condition.sql).To(Equal(c.expectedSQL[i]))
+			Expect(condition.values).To(Equal(c.expectedValues[i]))
+			// the keys and values are never built into the SQL
+			Expect(strings.Count(condition.sql, "?")).To(Equal(len(condition.values)))
+		}
+	}
+
+	_, serviceErr := labelSelectorToSQL(column, "a in (b")
+	Expect(serviceErr).To(HaveOccurred())
+	Expect(serviceErr.Code).To(Equal(errors.ErrorBadRequest))
+}
+
+func TestResourceFieldSelectorToSQL(t *testing.T) {
+	RegisterTestingT(t)
+
+	conditions, serviceErr := resourceFieldSelectorToSQL("resources",
+		"metadata.name=web,metadata.namespace!=cluster1,status.conditions.Available=True,status.conditions.Degraded!=True")
+	Expect(serviceErr).ToNot(HaveOccurred())
+	Expect(conditions).To(ConsistOf(
+		sqlCondition{sql: "resources.payload -> 'metadata' ->> 'name' = ?", values: []interface{}{"web"}},
+		sqlCondition{sql: "resources.consumer_name IS DISTINCT FROM ?", values: []interface{}{"cluster1"}},
+		sqlCondition{
+			sql:    "COALESCE(resources.status -> 'data' -> 'conditions' @> ?::jsonb, false)",
+			values: []interface{}{`[{"status":"True","type":"Available"}]`},
+		},
+		sqlCondition{
+			sql:    "NOT COALESCE(resources.status -> 'data' -> 'conditions' @> ?::jsonb, false)",
+			values: []interface{}{`[{"status":"True","type":"Degraded"}]`},
+		},
+	))
+
+	for _, invalid := range []string{"spec.replicas=1", "status.conditions.=True", "metadata.name"} {
+		_, serviceErr = resourceFieldSelectorToSQL("resources", invalid)
+		Expect(serviceErr).To(HaveOccurred(), invalid)
+		Expect(serviceErr.Code).To(Equal(errors.ErrorBadRequest))
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/dao"
@@ -17,8 +18,11 @@ type StatusEventService interface {
 	FindByIDs(ctx context.Context, ids []string) (api.StatusEventList, *errors.ServiceError)
 
 	FindAllUnreconciledEvents(ctx context.Context) (api.StatusEventList, *errors.ServiceError)
-	DeleteAllReconciledEvents(ctx context.Context) *errors.ServiceError
-	DeleteAllEvents(ctx context.Context, eventIDs []string) *errors.ServiceError
+
+	FindRevisionsBySource(ctx context.Context, source string, sinceRevision int64, limit int) (api.StatusRevisionList, *errors.ServiceError)
+	FirstRevision(ctx context.Context) (int64, *errors.ServiceError)
+	FindBookmarkRevision(ctx context.Context, instanceID string) (int64, *errors.ServiceError)
+	DeleteRevisionsBefore(ctx context.Context, before time.Time) *errors.ServiceError
 }
 
 func NewStatusEventService(statusEventDao dao.StatusEventDao) StatusEventService {
@@ -88,16 +92,33 @@ func (s *sqlStatusEventService) FindAllUnreconciledEvents(ctx context.Context) (
 	return statusEvents, nil
 }
 
-func (s *sqlStatusEventService) DeleteAllReconciledEvents(ctx context.Context) *errors.ServiceError {
-	if err := s.statusEventDao.DeleteAllReconciledEvents(ctx); err != nil {
-		return handleDeleteError("StatusEvent", errors.GeneralError("Unable to delete reconciled status events: %s", err))
+func (s *sqlStatusEventService) FindRevisionsBySource(ctx context.Context, source string, sinceRevision int64, limit int) (api.StatusRevisionList, *errors.ServiceError) {
+	revisions, err := s.statusEventDao.FindRevisionsBySource(ctx, source, sinceRevision, limit)
+	if err != nil {
+		return nil, errors.GeneralError("Unable to get status revisions of source %s: %s", source, err)
 	}
-	return nil
+	return revisions, nil
+}
+
+func (s *sqlStatusEventService) FirstRevision(ctx context.Context) (int64, *errors.ServiceError) {
+	revision, err := s.statusEventDao.FirstRevision(ctx)
+	if err != nil {
+		return 0, errors.GeneralError("Unable to get the first status revision: %s", err)
+	}
+	return revision, nil
+}
+
+func (s *sqlStatusEventService) FindBookmarkRevision(ctx context.Context, instanceID string) (int64, *errors.ServiceError) {
+	revision, err := s.statusEventDao.FindBookmarkRevision(ctx, instanceID)
+	if err != nil {
+		return 0, errors.GeneralError("Unable to get the bookmark revision of instance %s: %s", instanceID, err)
+	}
+	return revision, nil
 }
 
-func (s *sqlStatusEventService) DeleteAllEvents(ctx context.Context, eventIDs []string) *errors.ServiceError {
-	if err := s.statusEventDao.DeleteAllEvents(ctx, eventIDs); err != nil {
-		return handleDeleteError("StatusEvent", errors.GeneralError("Unable to delete events %s: %s", eventIDs, err))
+func (s *sqlStatusEventService) DeleteRevisionsBefore(ctx context.Context, before time.Time) *errors.ServiceError {
+	if err := s.statusEventDao.DeleteRevisionsBefore(ctx, before); err != nil {
+		return handleDeleteError("StatusRevision", errors.GeneralError("Unable to delete status revisions: %s", err))
 	}
 	return nil
 }
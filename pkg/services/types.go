@@ -4,6 +4,8 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/openshift-online/maestro/pkg/auth"
 )
 
 // ListArguments are arguments relevant for listing objects.
@@ -15,6 +17,20 @@ type ListArguments struct {
 	Search   string
 	OrderBy  []string
 	Fields   []string
+	// Continue is the continue token of the previous page, the list continues after the last object of the
+	// previous page instead of skipping the objects of the previous pages. Page is ignored if it is set.
+	Continue string
+	// SkipTotal skips counting the total number of the objects, the total is reported as -1.
+	SkipTotal bool
+	// LabelSelector and FieldSelector are the Kubernetes label and field selectors of the resources, they are
+	// translated into parameterised queries instead of search fragments.
+	LabelSelector string
+	FieldSelector string
+	// IDs restricts the list to the objects with the given ids, it is not set from url query parameters
+	IDs []string
+	// Scope restricts the list to the objects that the caller is allowed to access, it is not set from
+	// url query parameters
+	Scope *auth.Scope
 }
 
 // ~65500 is the maximum number of parameters that can be provided to a postgres WHERE IN clause
@@ -42,6 +58,19 @@ func NewListArguments(params url.Values) *ListArguments {
 	if v := strings.Trim(params.Get("search"), " "); v != "" {
 		listArgs.Search = v
 	}
+	if v := strings.Trim(params.Get("continue"), " "); v != "" {
+		listArgs.Continue = v
+	}
+	if v := strings.Trim(params.Get("total"), " "); v != "" {
+		total, err := strconv.ParseBool(v)
+		listArgs.SkipTotal = err == nil && !total
+	}
+	if v := strings.Trim(params.Get("labelSelector"), " "); v != "" {
+		listArgs.LabelSelector = v
+	}
+	if v := strings.Trim(params.Get("fieldSelector"), " "); v != "" {
+		listArgs.FieldSelector = v
+	}
 	if v := strings.Trim(params.Get("orderBy"), " "); v != "" {
 		listArgs.OrderBy = strings.Split(v, ",")
 	}
@@ -8,6 +8,7 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	v1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -41,6 +42,31 @@ func ValidateConsumer(consumer *api.Consumer) error {
 	return fmt.Errorf("%s", errs.ToAggregate().Error())
 }
 
+func ValidatePlacement(placement *api.Placement) error {
+	errs := field.ErrorList{}
+	if placement.Name != "" {
+		for _, msg := range apivalidation.ValidateNamespaceName(placement.Name, false) {
+			errs = append(errs, field.Invalid(field.NewPath("placement").Child("name"), placement.Name, msg))
+		}
+	}
+	if _, err := labels.Parse(placement.ConsumerSelector); err != nil {
+		errs = append(errs, field.Invalid(field.NewPath("placement").Child("consumer_selector"), placement.ConsumerSelector, err.Error()))
+	}
+	if placement.TemplateEngine != "" && placement.TemplateEngine != api.PlacementTemplateEngineCEL {
+		errs = append(errs, field.NotSupported(field.NewPath("placement").Child("template_engine"), placement.TemplateEngine,
+			[]string{api.PlacementTemplateEngineCEL}))
+	}
+	if placement.TemplateEngine == "" && len(placement.Values) > 0 {
+		errs = append(errs, field.Forbidden(field.NewPath("placement").Child("values"), "values require a template_engine"))
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%s", errs.ToAggregate().Error())
+}
+
 func ValidateManifestBundle(manifestBundle datatypes.JSONMap) error {
 	manifestBundleWrapper, err := api.DecodeManifestBundle(manifestBundle)
 	if err != nil {
@@ -625,13 +625,35 @@ var _ = Describe("SourceWorkClient", Ordered, Label("e2e-tests-source-work-clien
 				Expect(AssertWorks(expectedWorks, testWorkAName, testWorkBName, testWorkCName)).ShouldNot(HaveOccurred())
 			})
 
-			// TODO support does not exist
-			// By("list works without val label")
-			// works, err = sourceWorkClient.ManifestWorks(agentTestOpts.consumerName).List(ctx, metav1.ListOptions{
-			// 	LabelSelector: "!val",
-			// })
-			// Expect(err).ShouldNot(HaveOccurred())
-			// Expect(AssertWorks(works.Items, workName, prodWorkName)).ShouldNot(HaveOccurred())
+			By("list works without val label", func() {
+				works, err := sourceWorkClient.ManifestWorks(agentTestOpts.consumerName).List(ctx, metav1.ListOptions{
+					LabelSelector: "!val",
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				var expectedWorks []workv1.ManifestWork
+				for _, work := range works.Items {
+					if work.DeletionTimestamp != nil {
+						continue
+					}
+					expectedWorks = append(expectedWorks, work)
+				}
+				Expect(AssertWorks(expectedWorks, workName, prodWorkName)).ShouldNot(HaveOccurred())
+			})
+
+			By("list works by name", func() {
+				works, err := sourceWorkClient.ManifestWorks(agentTestOpts.consumerName).List(ctx, metav1.ListOptions{
+					FieldSelector: fmt.Sprintf("metadata.name=%s", prodWorkName),
+				})
+				Expect(err).ShouldNot(HaveOccurred())
+				var expectedWorks []workv1.ManifestWork
+				for _, work := range works.Items {
+					if work.DeletionTimestamp != nil {
+						continue
+					}
+					expectedWorks = append(expectedWorks, work)
+				}
+				Expect(AssertWorks(expectedWorks, prodWorkName)).ShouldNot(HaveOccurred())
+			})
 		})
 	})
 
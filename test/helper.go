@@ -105,10 +105,14 @@ func NewHelper(t *testing.T) *Helper {
 
 		ctx, cancel := context.WithCancel(context.Background())
 		helper = &Helper{
-			Ctx:                     ctx,
-			ContextCancelFunc:       cancel,
-			Broker:                  env.Config.MessageBroker.MessageBrokerType,
-			EventBroadcaster:        event.NewEventBroadcaster(),
+			Ctx:               ctx,
+			ContextCancelFunc: cancel,
+			Broker:            env.Config.MessageBroker.MessageBrokerType,
+			EventBroadcaster: event.NewEventBroadcaster(event.QueueOptions{
+				Size:     env.Config.GRPCServer.SubscriberQueueSize,
+				Overflow: event.OverflowPolicy(env.Config.GRPCServer.SubscriberOverflowPolicy),
+				Coalesce: env.Config.GRPCServer.SubscriberCoalesce,
+			}),
 			AppConfig:               env.Config,
 			DBFactory:               env.Database.SessionFactory,
 			consumerPubSubConfigMap: make(map[string]string),
@@ -159,6 +163,8 @@ func NewHelper(t *testing.T) *Helper {
 		helper.startMetricsServer()
 		helper.startHealthCheckServer()
 		helper.startEventServer()
+		helper.startConsumerHeartbeats()
+		helper.startAuditEvents()
 	})
 	helper.T = t
 	return helper
@@ -250,16 +256,37 @@ func (helper *Helper) startEventBroadcaster() {
 	}()
 }
 
+func (helper *Helper) startConsumerHeartbeats() {
+	logger := klog.FromContext(helper.Ctx)
+	go func() {
+		logger.V(4).Info("Test consumer heartbeats started")
+		helper.Env().Services.ConsumerHeartbeats().Start(helper.Ctx)
+		logger.V(4).Info("Test consumer heartbeats stopped")
+	}()
+}
+
+func (helper *Helper) startAuditEvents() {
+	logger := klog.FromContext(helper.Ctx)
+	go func() {
+		logger.V(4).Info("Test audit events started")
+		helper.Env().Services.AuditEvents().Start(helper.Ctx)
+		logger.V(4).Info("Test audit events stopped")
+	}()
+}
+
 func (helper *Helper) StartControllerManager(ctx context.Context) {
 	helper.ControllerManager = &server.ControllersServer{
 		KindControllerManager: controllers.NewKindControllerManager(
 			helper.EventFilter,
 			helper.Env().Services.Events(),
 		),
-		StatusController: controllers.NewStatusController(
-			helper.Env().Services.StatusEvents(),
-			dao.NewInstanceDao(&helper.Env().Database.SessionFactory),
-			dao.NewEventInstanceDao(&helper.Env().Database.SessionFactory),
+		StatusController:    controllers.NewStatusController(helper.Env().Services.StatusEvents()),
+		PlacementController: controllers.NewPlacementController(helper.Env().Services.Placements()),
+		EventRetentionController: controllers.NewEventRetentionController(
+			db.NewAdvisoryLockFactory(helper.Env().Database.SessionFactory),
+			dao.NewEventPartitionDao(&helper.Env().Database.SessionFactory),
+			helper.Env().Config.EventController.EventRetention,
+			helper.Env().Config.EventController.StatusEventRetention,
 		),
 	}
 
@@ -513,6 +540,7 @@ func (helper *Helper) CleanDB() error {
 
 	// TODO: this list should not be static or otherwise not hard-coded here.
 	for _, table := range []string{
+		"event_instances",
 		"events",
 		"status_events",
 		"resources",
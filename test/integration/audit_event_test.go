@@ -0,0 +1,78 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestAuditEvents(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	rb, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	manifest := rb.Manifests[0]
+	Expect(unstructured.SetNestedField(manifest, int64(2), "spec", "replicas")).NotTo(HaveOccurred())
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{Version: 1, Manifests: []map[string]interface{}{manifest}}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error patching resource bundle: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+	resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdDelete(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+	// an audit event is recorded for each write of the resource bundle
+	list, resp, err := client.DefaultAPI.ApiMaestroV1AuditEventsGet(ctx).
+		Search(fmt.Sprintf("target_id = '%s'", resource.ID)).OrderBy("created_at asc").Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error listing audit events: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(list.Kind).To(Equal("AuditEventList"))
+	Expect(len(list.Items)).To(Equal(3))
+
+	expected := []struct {
+		action        api.AuditAction
+		actor         string
+		before, after int32
+	}{
+		// the resource bundle is created by the service, not by an authenticated request
+		{api.AuditActionCreate, "", 0, 1},
+		{api.AuditActionUpdate, "mock", 1, 2},
+		{api.AuditActionDelete, "mock", 2, 0},
+	}
+	for i, auditEvent := range list.Items {
+		Expect(*auditEvent.Kind).To(Equal("AuditEvent"))
+		Expect(*auditEvent.Action).To(Equal(string(expected[i].action)))
+		Expect(*auditEvent.Actor).To(Equal(expected[i].actor))
+		Expect(*auditEvent.VersionBefore).To(Equal(expected[i].before))
+		Expect(*auditEvent.VersionAfter).To(Equal(expected[i].after))
+		Expect(*auditEvent.TargetType).To(Equal(api.AuditTargetResourceBundle))
+		Expect(*auditEvent.Source).To(Equal(resource.Source))
+	}
+	// the operation ID of the REST request is the request ID
+	Expect(*list.Items[1].RequestId).NotTo(BeEmpty())
+
+	// the consumer writes are recorded too
+	list, _, err = client.DefaultAPI.ApiMaestroV1AuditEventsGet(ctx).
+		Search(fmt.Sprintf("target_id = '%s'", consumer.ID)).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(len(list.Items)).To(Equal(1))
+	Expect(*list.Items[0].Action).To(Equal(string(api.AuditActionCreate)))
+	Expect(*list.Items[0].TargetType).To(Equal(api.AuditTargetConsumer))
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/gomega"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/openshift-online/maestro/pkg/api"
 	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/dao"
 	"github.com/openshift-online/maestro/test"
 )
 
@@ -207,6 +209,91 @@ func TestConsumerDeleteForbidden(t *testing.T) {
 	Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
 }
 
+func TestConsumerCascadeDelete(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	resources, err := h.CreateResourceList(consumer.Name, 3)
+	Expect(err).NotTo(HaveOccurred())
+
+	// 400 for the unsupported deletion policy
+	resp, err := client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, consumer.ID).DeletionPolicy("Unknown").Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// 202 the deletion is accepted
+	resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, consumer.ID).DeletionPolicy("Cascade").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+
+	// the deleting consumer is not found
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdGet(ctx, consumer.ID).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	// the resources are marked as deleting
+	resourceService := h.Env().Services.Resources()
+	for _, resource := range resources {
+		found, svcErr := resourceService.Get(ctx, resource.ID)
+		Expect(svcErr).NotTo(HaveOccurred())
+		Expect(found.DeletedAt.Time.IsZero()).To(BeFalse())
+	}
+
+	// the resources cannot be created on the deleting consumer
+	_, err = h.CreateResource(uuid.NewString(), consumer.Name, "nginx-"+rand.String(5), "default", 1)
+	Expect(err).To(HaveOccurred())
+	Expect(err.Error()).To(ContainSubstring("under deletion"))
+
+	consumerDao := dao.NewConsumerDao(&h.Env().Database.SessionFactory)
+
+	// the consumer is removed once the agent confirms the deletion of its last resource
+	for i, resource := range resources {
+		_, err := consumerDao.GetByName(ctx, consumer.Name)
+		Expect(err).NotTo(HaveOccurred(), "the consumer is removed before its resource %d is deleted", i)
+
+		svcErr := resourceService.Delete(ctx, resource.ID)
+		Expect(svcErr).NotTo(HaveOccurred())
+	}
+
+	_, err = consumerDao.GetByName(ctx, consumer.Name)
+	Expect(err).To(HaveOccurred())
+	Expect(err.Error()).To(ContainSubstring("record not found"))
+}
+
+func TestConsumerOrphanDelete(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	resources, err := h.CreateResourceList(consumer.Name, 3)
+	Expect(err).NotTo(HaveOccurred())
+
+	// mark one of the resources as deleting, it is removed too
+	err = h.DeleteResource(resources[0].ID)
+	Expect(err).NotTo(HaveOccurred())
+
+	// 204 the consumer and its resources are deleted
+	resp, err := client.DefaultAPI.ApiMaestroV1ConsumersIdDelete(ctx, consumer.ID).DeletionPolicy("Orphan").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdGet(ctx, consumer.ID).Execute()
+	Expect(err).To(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	resourceService := h.Env().Services.Resources()
+	for _, resource := range resources {
+		_, svcErr := resourceService.Get(ctx, resource.ID)
+		Expect(svcErr).To(HaveOccurred())
+		Expect(svcErr.Is404()).To(BeTrue())
+	}
+}
+
 func TestConsumerDeleting(t *testing.T) {
 	h, client := test.RegisterIntegration(t)
 	ctx := context.Background()
@@ -349,6 +436,63 @@ func TestConsumerPaging(t *testing.T) {
 	Expect(list.Page).To(Equal(int32(2)))
 }
 
+func TestConsumerAgentStatus(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	// the agent of the consumer has never been seen
+	found, _, err := client.DefaultAPI.ApiMaestroV1ConsumersIdGet(ctx, consumer.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	connected := findConsumerCondition(found, api.ConsumerConditionConnected)
+	Expect(connected).NotTo(BeNil())
+	Expect(*connected.Status).To(Equal("False"))
+	Expect(*connected.Reason).To(Equal("AgentNeverSeen"))
+	Expect(findConsumerCondition(found, api.ConsumerConditionLastStatusReceived)).NotTo(BeNil())
+	Expect(findConsumerCondition(found, api.ConsumerConditionAgentVersion)).NotTo(BeNil())
+
+	// the agent is connected once it subscribes and resyncs the resources
+	h.StartWorkAgent(ctx, consumer.Name)
+	Eventually(func() error {
+		if err := h.Env().Services.ConsumerHeartbeats().Flush(ctx); err != nil {
+			return err
+		}
+		found, _, err := client.DefaultAPI.ApiMaestroV1ConsumersIdGet(ctx, consumer.ID).Execute()
+		if err != nil {
+			return err
+		}
+		connected := findConsumerCondition(found, api.ConsumerConditionConnected)
+		if connected == nil || *connected.Status != "True" {
+			return fmt.Errorf("the agent of consumer %s is not connected", consumer.Name)
+		}
+		if connected.LastUpdateTime == nil {
+			return fmt.Errorf("the last seen time of the agent is not set")
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).ShouldNot(HaveOccurred())
+
+	// the status is presented in the consumer list as well
+	list, _, err := client.DefaultAPI.ApiMaestroV1ConsumersGet(ctx).Search(fmt.Sprintf("name = '%s'", consumer.Name)).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(len(list.Items)).To(Equal(1))
+	connected = findConsumerCondition(&list.Items[0], api.ConsumerConditionConnected)
+	Expect(connected).NotTo(BeNil())
+	Expect(*connected.Status).To(Equal("True"))
+}
+
+func findConsumerCondition(consumer *openapi.Consumer, conditionType string) *openapi.ConsumerCondition {
+	for _, condition := range consumer.GetStatus().Conditions {
+		if condition.GetType() == conditionType {
+			return &condition
+		}
+	}
+	return nil
+}
+
 type Result struct {
 	resource     *api.Resource
 	consumerName string
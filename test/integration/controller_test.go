@@ -2,15 +2,12 @@ package integration
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/gomega"
-	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 	"k8s.io/apimachinery/pkg/util/rand"
 
 	"github.com/openshift-online/maestro/cmd/maestro/server"
@@ -102,11 +99,7 @@ func TestControllerRacing(t *testing.T) {
 					eventFilter,
 					h.Env().Services.Events(),
 				),
-				StatusController: controllers.NewStatusController(
-					h.Env().Services.StatusEvents(),
-					dao.NewInstanceDao(&h.Env().Database.SessionFactory),
-					dao.NewEventInstanceDao(&h.Env().Database.SessionFactory),
-				),
+				StatusController: controllers.NewStatusController(h.Env().Services.StatusEvents()),
 			}
 
 			s.KindControllerManager.Add(&controllers.ControllerConfig{
@@ -203,11 +196,7 @@ func TestControllerReconcile(t *testing.T) {
 				h.EventFilter,
 				h.Env().Services.Events(),
 			),
-			StatusController: controllers.NewStatusController(
-				h.Env().Services.StatusEvents(),
-				dao.NewInstanceDao(&h.Env().Database.SessionFactory),
-				dao.NewEventInstanceDao(&h.Env().Database.SessionFactory),
-			),
+			StatusController: controllers.NewStatusController(h.Env().Services.StatusEvents()),
 		}
 
 		s.KindControllerManager.Add(&controllers.ControllerConfig{
@@ -353,20 +342,14 @@ func TestControllerSync(t *testing.T) {
 		return nil
 	}
 
-	// start the controller, once the controller started, it will sync the events:
-	// - clean up the reconciled events
-	// - requeue the unreconciled events
+	// start the controller, once the controller started, it will requeue the unreconciled events
 	go func() {
 		s := &server.ControllersServer{
 			KindControllerManager: controllers.NewKindControllerManager(
 				h.EventFilter,
 				h.Env().Services.Events(),
 			),
-			StatusController: controllers.NewStatusController(
-				h.Env().Services.StatusEvents(),
-				dao.NewInstanceDao(&h.Env().Database.SessionFactory),
-				dao.NewEventInstanceDao(&h.Env().Database.SessionFactory),
-			),
+			StatusController: controllers.NewStatusController(h.Env().Services.StatusEvents()),
 		}
 
 		s.KindControllerManager.Add(&controllers.ControllerConfig{
@@ -386,13 +369,23 @@ func TestControllerSync(t *testing.T) {
 			return fmt.Errorf("should have only two unreconciled events but got %d", len(proccessedEvents))
 		}
 
-		events, err := eventDao.All(ctx)
+		events, err := eventDao.FindAllUnreconciledEvents(ctx)
 		if err != nil {
 			return err
 		}
 
-		if len(events) != 2 {
-			return fmt.Errorf("should have only two events remained but got %d", len(events))
+		if len(events) != 0 {
+			return fmt.Errorf("should have all events reconciled but got %d unreconciled", len(events))
+		}
+
+		// the reconciled events are kept until their partitions are out of the retention
+		events, err = eventDao.All(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(events) != 5 {
+			return fmt.Errorf("should have all five events remained but got %d", len(events))
 		}
 
 		return nil
@@ -402,58 +395,36 @@ func TestControllerSync(t *testing.T) {
 	cancel()
 }
 
-func TestStatusControllerSync(t *testing.T) {
+func TestEventRetentionControllerSync(t *testing.T) {
 	h, _ := test.RegisterIntegration(t)
 	ctx, cancel := context.WithCancel(context.Background())
 
 	instanceDao := dao.NewInstanceDao(&h.Env().Database.SessionFactory)
+	eventDao := dao.NewEventDao(&h.Env().Database.SessionFactory)
 	statusEventDao := dao.NewStatusEventDao(&h.Env().Database.SessionFactory)
 	eventInstanceDao := dao.NewEventInstanceDao(&h.Env().Database.SessionFactory)
+	partitionDao := dao.NewEventPartitionDao(&h.Env().Database.SessionFactory)
 
-	// prepare instances
-	// Wrap the check and create in a transaction to avoid race conditions
-	err := h.Env().Database.SessionFactory.New(ctx).Transaction(func(tx *gorm.DB) error {
-		var instance api.ServerInstance
-		result := tx.Where("id = ?", "maestro").First(&instance)
-
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			// create a new instance if not found
-			newInstance := &api.ServerInstance{
-				Meta:          api.Meta{ID: "maestro"},
-				Ready:         true,
-				LastHeartbeat: time.Now(),
-			}
-			// best-effort insert; ignore if another tx inserts first
-			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(newInstance).Error; err != nil {
-				return err
-			}
-		} else if result.Error != nil {
-			return result.Error
-		}
-
-		return nil
-	})
-	if err != nil {
-		t.Fatal(err)
-	}
 	if _, err := instanceDao.Create(ctx, &api.ServerInstance{
 		Meta: api.Meta{ID: "i1"}, Ready: true, LastHeartbeat: time.Now()}); err != nil {
 		t.Fatal(err)
 	}
-	if _, err := instanceDao.Create(ctx, &api.ServerInstance{Meta: api.Meta{ID: "i2"}}); err != nil {
-		t.Fatal(err)
-	}
-	if _, err := instanceDao.Create(ctx, &api.ServerInstance{
-		Meta: api.Meta{ID: "i3"}, Ready: true, LastHeartbeat: time.Now()}); err != nil {
-		t.Fatal(err)
+
+	// prepare the partitions of the past hours
+	now := time.Now()
+	for _, table := range []string{dao.EventsTable, dao.StatusEventsTable} {
+		if err := partitionDao.CreatePartitions(ctx, table, now.Add(-3*time.Hour), now); err != nil {
+			t.Fatal(err)
+		}
 	}
 
-	// prepare events
-	evt1, err := statusEventDao.Create(ctx, &api.StatusEvent{})
+	// prepare events, the events that were created two hours ago are out of the one hour retention
+	expired := now.Add(-2 * time.Hour)
+	evt1, err := statusEventDao.Create(ctx, &api.StatusEvent{Meta: api.Meta{CreatedAt: expired}})
 	if err != nil {
 		t.Fatal(err)
 	}
-	evt2, err := statusEventDao.Create(ctx, &api.StatusEvent{})
+	evt2, err := statusEventDao.Create(ctx, &api.StatusEvent{Meta: api.Meta{CreatedAt: expired}})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -461,43 +432,20 @@ func TestStatusControllerSync(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	evt4, err := statusEventDao.Create(ctx, &api.StatusEvent{})
-	if err != nil {
-		t.Fatal(err)
-	}
-	evt5, err := statusEventDao.Create(ctx, &api.StatusEvent{})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	readyInstances, err := instanceDao.FindReadyIDs(ctx)
+	specEvt1, err := eventDao.Create(ctx, &api.Event{Meta: api.Meta{CreatedAt: expired},
+		Source:         "Resources",
+		SourceID:       "resource1",
+		EventType:      api.UpdateEventType,
+		ReconciledDate: &expired})
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// prepare event-instances
-	for _, id := range readyInstances {
-		if _, err := eventInstanceDao.Create(ctx, &api.EventInstance{InstanceID: id, EventID: evt1.ID}); err != nil {
+	for _, id := range []string{evt1.ID, evt2.ID, evt3.ID} {
+		if _, err := eventInstanceDao.Create(ctx, &api.EventInstance{InstanceID: "i1", EventID: id}); err != nil {
 			t.Fatal(err)
 		}
-		if _, err := eventInstanceDao.Create(ctx, &api.EventInstance{InstanceID: id, EventID: evt2.ID}); err != nil {
-			t.Fatal(err)
-		}
-	}
-	if _, err := eventInstanceDao.Create(ctx, &api.EventInstance{InstanceID: "i2", EventID: evt1.ID}); err != nil {
-		t.Fatal(err)
-	}
-	if _, err := eventInstanceDao.Create(ctx, &api.EventInstance{InstanceID: "i1", EventID: evt3.ID}); err != nil {
-		t.Fatal(err)
-	}
-	if _, err := eventInstanceDao.Create(ctx, &api.EventInstance{InstanceID: "i2", EventID: evt3.ID}); err != nil {
-		t.Fatal(err)
-	}
-	if _, err := eventInstanceDao.Create(ctx, &api.EventInstance{InstanceID: "i1", EventID: evt4.ID}); err != nil {
-		t.Fatal(err)
-	}
-	if _, err := eventInstanceDao.Create(ctx, &api.EventInstance{InstanceID: "i3", EventID: evt5.ID}); err != nil {
-		t.Fatal(err)
 	}
 
 	// start the controller
@@ -507,68 +455,66 @@ func TestStatusControllerSync(t *testing.T) {
 				h.EventFilter,
 				h.Env().Services.Events(),
 			),
-			StatusController: controllers.NewStatusController(
-				h.Env().Services.StatusEvents(),
-				dao.NewInstanceDao(&h.Env().Database.SessionFactory),
-				dao.NewEventInstanceDao(&h.Env().Database.SessionFactory),
+			StatusController: controllers.NewStatusController(h.Env().Services.StatusEvents()),
+			EventRetentionController: controllers.NewEventRetentionController(
+				db.NewAdvisoryLockFactory(h.Env().Database.SessionFactory),
+				partitionDao,
+				time.Hour,
+				time.Hour,
 			),
 		}
 
 		s.Start(ctx)
 	}()
 
-	purged := []string{evt1.ID, evt2.ID}
-	remained := []string{evt3.ID, evt4.ID, evt5.ID}
+	dropped := []string{evt1.ID, evt2.ID}
 	Eventually(func() error {
-		events, err := statusEventDao.FindByIDs(ctx, remained)
+		events, err := statusEventDao.FindByIDs(ctx, []string{evt3.ID})
 		if err != nil {
 			return err
 		}
 
-		if len(events) != 3 {
-			return fmt.Errorf("should have events %s remained, but got %v", remained, events)
+		if len(events) != 1 {
+			return fmt.Errorf("should have the event %s remained, but got %v", evt3.ID, events)
 		}
 
-		events, err = statusEventDao.FindByIDs(ctx, purged)
+		events, err = statusEventDao.FindByIDs(ctx, dropped)
 		if err != nil {
 			return err
 		}
 
 		if len(events) != 0 {
-			return fmt.Errorf("should purge the events %s, but got %+v", purged, events)
+			return fmt.Errorf("should drop the events %s, but got %+v", dropped, events)
 		}
 
-		eventInstances, err := eventInstanceDao.FindStatusEvents(ctx, purged)
+		eventInstances, err := eventInstanceDao.FindStatusEvents(ctx, dropped)
 		if err != nil {
 			return err
 		}
 		if len(eventInstances) != 0 {
-			return fmt.Errorf("should purge the event-instances %s, but got %+v", purged, eventInstances)
+			return fmt.Errorf("should drop the event-instances %s, but got %+v", dropped, eventInstances)
 		}
 
 		if _, err := eventInstanceDao.Get(ctx, evt3.ID, "i1"); err != nil {
 			return fmt.Errorf("%s-%s is not found", "e3", "i1")
 		}
-		if _, err := eventInstanceDao.Get(ctx, evt3.ID, "i2"); err != nil {
-			return fmt.Errorf("%s-%s is not found", "e3", "i2")
-		}
-		if _, err := eventInstanceDao.Get(ctx, evt4.ID, "i1"); err != nil {
-			return fmt.Errorf("%s-%s is not found", "e4", "i1")
+
+		specEvents, err := eventDao.FindByIDs(ctx, []string{specEvt1.ID})
+		if err != nil {
+			return err
 		}
-		if _, err := eventInstanceDao.Get(ctx, evt5.ID, "i3"); err != nil {
-			return fmt.Errorf("%s-%s is not found", "e5", "i3")
+		if len(specEvents) != 0 {
+			return fmt.Errorf("should drop the reconciled event %s, but got %+v", specEvt1.ID, specEvents)
 		}
 
 		return nil
 	}, 5*time.Second, 1*time.Second).Should(Succeed())
 
 	// cleanup
-	for _, evtID := range remained {
-		if err := statusEventDao.Delete(ctx, evtID); err != nil {
-			t.Fatal(err)
-		}
+	if err := statusEventDao.Delete(ctx, evt3.ID); err != nil {
+		t.Fatal(err)
 	}
-	if err := instanceDao.DeleteByIDs(ctx, []string{"i1", "i2", "i3"}); err != nil {
+	if err := instanceDao.DeleteByIDs(ctx, []string{"i1"}); err != nil {
 		t.Fatal(err)
 	}
 
@@ -586,11 +532,7 @@ func TestMultipleControllers(t *testing.T) {
 	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
 	Expect(err).NotTo(HaveOccurred())
 
-	statusCtrl := controllers.NewStatusController(
-		h.Env().Services.StatusEvents(),
-		dao.NewInstanceDao(&h.Env().Database.SessionFactory),
-		dao.NewEventInstanceDao(&h.Env().Database.SessionFactory),
-	)
+	statusCtrl := controllers.NewStatusController(h.Env().Services.StatusEvents())
 	statusCtrl.Add(map[api.StatusEventType][]controllers.StatusHandlerFunc{
 		api.StatusUpdateEventType: {func(ctx context.Context, eventID, sourceID string) error { return nil }},
 	})
@@ -0,0 +1,146 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/cmd/maestro/server"
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/controllers"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/event"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestGRPCBrokerMultipleInstances(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+	if h.Broker != "grpc" {
+		t.Skip("Multiple broker instances are only supported with gRPC broker.")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer func() {
+		cancel()
+		// give one second to terminate the work agents and the second broker
+		time.Sleep(1 * time.Second)
+	}()
+
+	consumerA, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	consumerB, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	// the agent of consumer A connects to the broker of the test helper
+	h.StartControllerManager(ctx)
+	h.StartWorkAgent(ctx, consumerA.Name)
+	agentA := h.WorkAgentHolder
+
+	// start the second broker instance with its own instance ID and port, the agent of consumer B connects to it
+	grpcConfig := h.Env().Config.GRPCServer
+	brokerConfig := h.Env().Config.MessageBroker
+	instanceID, bindPort := brokerConfig.ClientID, grpcConfig.BrokerBindPort
+	secondInstanceID := "maestro-" + rand.String(5)
+	brokerConfig.ClientID = secondInstanceID
+	grpcConfig.BrokerBindPort = freePort(t)
+	secondBroadcaster := event.NewEventBroadcaster(event.QueueOptions{})
+	go secondBroadcaster.Start(ctx)
+	secondBroker := server.NewGRPCBroker(ctx, secondBroadcaster)
+	go secondBroker.Start(ctx)
+	time.Sleep(1 * time.Second)
+	h.StartWorkAgent(ctx, consumerB.Name)
+	agentB := h.WorkAgentHolder
+	brokerConfig.ClientID, grpcConfig.BrokerBindPort = instanceID, bindPort
+	h.WorkAgentHolder = agentA
+
+	// the controller manager of the second instance handles the spec events with its own broker
+	secondControllers := &server.ControllersServer{
+		KindControllerManager: controllers.NewKindControllerManager(
+			controllers.NewPredicatedEventFilter(secondBroker.PredicateEvent),
+			h.Env().Services.Events(),
+		),
+		StatusController: controllers.NewStatusController(h.Env().Services.StatusEvents()),
+	}
+	secondControllers.KindControllerManager.Add(&controllers.ControllerConfig{
+		Source: "Resources",
+		Handlers: map[api.EventType][]controllers.ControllerHandlerFunc{
+			api.CreateEventType: {secondBroker.OnCreate},
+			api.UpdateEventType: {secondBroker.OnUpdate},
+			api.DeleteEventType: {secondBroker.OnDelete},
+		},
+	})
+	secondControllers.StatusController.Add(map[api.StatusEventType][]controllers.StatusHandlerFunc{
+		api.StatusUpdateEventType: {secondBroker.OnStatusUpdate},
+		api.StatusDeleteEventType: {secondBroker.OnStatusUpdate},
+	})
+	go secondControllers.Start(ctx)
+
+	// wait until the agents are subscribed
+	time.Sleep(3 * time.Second)
+
+	// each consumer is connected to the instance that owns the stream of its agent
+	connectionDao := dao.NewConsumerConnectionDao(&h.Env().Database.SessionFactory)
+	Eventually(func() error {
+		for consumerName, expected := range map[string]string{consumerA.Name: instanceID, consumerB.Name: secondInstanceID} {
+			connections, err := connectionDao.FindByConsumerName(ctx, consumerName)
+			if err != nil {
+				return err
+			}
+			if len(connections) != 1 || connections[0].InstanceID != expected {
+				return fmt.Errorf("consumer %s should be connected to %s, but got %v", consumerName, expected, connections)
+			}
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	resourceA, err := h.CreateResource(uuid.NewString(), consumerA.Name, "nginx-"+rand.String(5), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+	resourceB, err := h.CreateResource(uuid.NewString(), consumerB.Name, "nginx-"+rand.String(5), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	// the spec of each resource is routed to the instance that its agent is connected to
+	Eventually(func() error {
+		if _, err := agentA.ManifestWorks(consumerA.Name).Get(ctx, resourceA.ID, metav1.GetOptions{}); err != nil {
+			return err
+		}
+		if _, err := agentB.ManifestWorks(consumerB.Name).Get(ctx, resourceB.ID, metav1.GetOptions{}); err != nil {
+			return err
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	// the spec events are reconciled once by the instance that handled them
+	eventDao := dao.NewEventDao(&h.Env().Database.SessionFactory)
+	Eventually(func() error {
+		events, err := eventDao.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, evt := range events {
+			if evt.SourceID != resourceA.ID && evt.SourceID != resourceB.ID {
+				continue
+			}
+			if evt.ReconciledDate == nil {
+				return fmt.Errorf("the event %s of resource %s is not reconciled", evt.ID, evt.SourceID)
+			}
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+}
+
+// freePort returns a local port that is not in use.
+func freePort(t *testing.T) string {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to find a free port: %v", err)
+	}
+	defer ln.Close()
+	return fmt.Sprintf("%d", ln.Addr().(*net.TCPAddr).Port)
+}
@@ -42,6 +42,11 @@ func TestHealthCheckServer(t *testing.T) {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
+	// the consumers connected to the instances
+	connectionDao := dao.NewConsumerConnectionDao(&h.Env().Database.SessionFactory)
+	Expect(connectionDao.Create(ctx, &api.ConsumerConnection{ConsumerName: "cluster1", InstanceID: "instance1"})).NotTo(HaveOccurred())
+	Expect(connectionDao.Create(ctx, &api.ConsumerConnection{ConsumerName: "cluster2", InstanceID: "instance2"})).NotTo(HaveOccurred())
+
 	instanceID := &h.Env().Config.MessageBroker.ClientID
 	Eventually(func() error {
 		instances, err := instanceDao.All(ctx)
@@ -68,4 +73,19 @@ func TestHealthCheckServer(t *testing.T) {
 
 		return nil
 	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	// the connections of the instance that is marked as inactive are removed
+	Eventually(func() error {
+		connections, err := connectionDao.FindByConsumerName(ctx, "cluster1")
+		if err != nil {
+			return err
+		}
+		if len(connections) != 0 {
+			return fmt.Errorf("expected the connection of instance1 to be removed, got %v", connections)
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+	connections, err := connectionDao.FindByConsumerName(ctx, "cluster2")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(connections).To(HaveLen(1))
 }
@@ -80,3 +80,105 @@ func TestListSyncWorks(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 	Expect(len(works.Items)).To(Equal(3))
 }
+
+func TestPageListWithContinue(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	resourceService := h.Env().Services.Resources()
+	workIDs := []string{}
+	for i := 0; i < 3; i++ {
+		work, err := h.NewResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1, 1)
+		Expect(err).NotTo(HaveOccurred())
+		work.Source = "maestro-3"
+
+		created, svcErr := resourceService.Create(ctx, work)
+		Expect(svcErr).NotTo(HaveOccurred())
+		workIDs = append(workIDs, created.ID)
+	}
+
+	logger, err := logging.NewStdLoggerBuilder().Build()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	search := grpcsource.ToSyncSearch("maestro-3", []string{consumer.Name})
+	works, next, err := grpcsource.PageList(ctx, logger, client, search, metav1.ListOptions{Limit: 1})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(len(works.Items)).To(Equal(1))
+	Expect(works.Items[0].GetId()).To(Equal(workIDs[0]))
+	Expect(next).NotTo(BeEmpty())
+
+	// the listed work is deleted before the next page, the next page still starts from the second work
+	first, svcErr := resourceService.Get(ctx, workIDs[0])
+	Expect(svcErr).NotTo(HaveOccurred())
+	h.Delete(first)
+
+	listedIDs := []string{}
+	for len(next) != 0 {
+		works, next, err = grpcsource.PageList(ctx, logger, client, search, metav1.ListOptions{Limit: 1, Continue: next})
+		Expect(err).NotTo(HaveOccurred())
+		for _, work := range works.Items {
+			listedIDs = append(listedIDs, work.GetId())
+		}
+	}
+	Expect(listedIDs).To(Equal(workIDs[1:]))
+}
+
+func TestPageListWithSelectors(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	resourceService := h.Env().Services.Resources()
+	workLabels := []map[string]interface{}{{"env": "prod", "tier": "1"}, {"env": "stage"}, {}}
+	workNames := []string{}
+	for _, labels := range workLabels {
+		deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+		work, err := h.NewResource(uuid.NewString(), consumer.Name, deployName, "default", 1, 1)
+		Expect(err).NotTo(HaveOccurred())
+		work.Source = "maestro-4"
+		work.Payload["metadata"] = map[string]interface{}{"name": deployName, "labels": labels}
+
+		_, svcErr := resourceService.Create(ctx, work)
+		Expect(svcErr).NotTo(HaveOccurred())
+		workNames = append(workNames, deployName)
+	}
+
+	logger, err := logging.NewStdLoggerBuilder().Build()
+	Expect(err).ShouldNot(HaveOccurred())
+
+	search := grpcsource.ToSyncSearch("maestro-4", []string{consumer.Name})
+	cases := []struct {
+		opts          metav1.ListOptions
+		expectedNames []string
+	}{
+		{opts: metav1.ListOptions{LabelSelector: "env=prod"}, expectedNames: workNames[:1]},
+		{opts: metav1.ListOptions{LabelSelector: "env!=prod"}, expectedNames: workNames[1:]},
+		{opts: metav1.ListOptions{LabelSelector: "env notin (prod)"}, expectedNames: workNames[1:]},
+		{opts: metav1.ListOptions{LabelSelector: "env in (prod,stage)"}, expectedNames: workNames[:2]},
+		{opts: metav1.ListOptions{LabelSelector: "!env"}, expectedNames: workNames[2:]},
+		{opts: metav1.ListOptions{LabelSelector: "tier>0"}, expectedNames: workNames[:1]},
+		{opts: metav1.ListOptions{FieldSelector: "metadata.name=" + workNames[1]}, expectedNames: workNames[1:2]},
+		{opts: metav1.ListOptions{FieldSelector: "metadata.namespace=" + consumer.Name, LabelSelector: "env"}, expectedNames: workNames[:2]},
+		{opts: metav1.ListOptions{FieldSelector: "status.conditions.Available!=True"}, expectedNames: workNames},
+	}
+	for _, c := range cases {
+		works, _, err := grpcsource.PageList(ctx, logger, client, search, c.opts)
+		Expect(err).NotTo(HaveOccurred(), "%v", c.opts)
+		names := []string{}
+		for _, work := range works.Items {
+			names = append(names, work.Metadata["name"].(string))
+		}
+		Expect(names).To(ConsistOf(c.expectedNames), "%v", c.opts)
+	}
+
+	// the unsupported fields are rejected
+	_, _, err = grpcsource.PageList(ctx, logger, client, search, metav1.ListOptions{FieldSelector: "spec.replicas=1"})
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,140 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestPlacement(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h.StartControllerManager(ctx)
+	resourceDao := dao.NewResourceDao(&h.Env().Database.SessionFactory)
+
+	euProd, err := h.CreateConsumerWithLabels("cluster-"+rand.String(5), map[string]string{"env": "prod", "region": "eu"})
+	Expect(err).NotTo(HaveOccurred())
+	usProd, err := h.CreateConsumerWithLabels("cluster-"+rand.String(5), map[string]string{"env": "prod", "region": "us"})
+	Expect(err).NotTo(HaveOccurred())
+	_, err = h.CreateConsumerWithLabels("cluster-"+rand.String(5), map[string]string{"env": "dev", "region": "eu"})
+	Expect(err).NotTo(HaveOccurred())
+
+	manifest := map[string]interface{}{}
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)), &manifest)).NotTo(HaveOccurred())
+
+	// 400 with an invalid consumer selector
+	_, resp, err := client.DefaultAPI.ApiMaestroV1PlacementsPost(ctx).Placement(openapi.Placement{
+		ConsumerSelector: openapi.PtrString("env in (prod"),
+		Manifests:        []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	placement, resp, err := client.DefaultAPI.ApiMaestroV1PlacementsPost(ctx).Placement(openapi.Placement{
+		Name:             openapi.PtrString("nginx-" + rand.String(5)),
+		ConsumerSelector: openapi.PtrString("env=prod"),
+		Manifests:        []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error posting placement: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(*placement.Kind).To(Equal("Placement"))
+	Expect(*placement.Href).To(Equal(fmt.Sprintf("/api/maestro/v1/placements/%s", *placement.Id)))
+
+	// a resource bundle is created for each of the selected consumers
+	Eventually(func() error {
+		resources, err := resourceDao.FindByPlacementID(ctx, *placement.Id)
+		if err != nil {
+			return err
+		}
+		if len(resources) != 2 {
+			return fmt.Errorf("expected 2 resources, but got %d", len(resources))
+		}
+		for _, resource := range resources {
+			if resource.ID != api.PlacementResourceID(*placement.Id, resource.ConsumerName) {
+				return fmt.Errorf("unexpected resource %s on consumer %s", resource.ID, resource.ConsumerName)
+			}
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	// the resource bundles are updated with the placement
+	updatedManifest := map[string]interface{}{}
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(fmt.Sprintf("nginx-%s", rand.String(5)), "default", 2)), &updatedManifest)).NotTo(HaveOccurred())
+	_, resp, err = client.DefaultAPI.ApiMaestroV1PlacementsIdPatch(ctx, *placement.Id).PlacementPatchRequest(openapi.PlacementPatchRequest{
+		Manifests: []map[string]interface{}{updatedManifest},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error patching placement: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Eventually(func() error {
+		for _, consumer := range []*api.Consumer{euProd, usProd} {
+			resource, err := resourceDao.Get(ctx, api.PlacementResourceID(*placement.Id, consumer.Name))
+			if err != nil {
+				return err
+			}
+			if resource.Version != 2 {
+				return fmt.Errorf("expected version 2, but got %d", resource.Version)
+			}
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	// the resource bundle is deleted from the consumer that is relabeled out of the placement
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ConsumersIdPatch(ctx, usProd.ID).ConsumerPatchRequest(openapi.ConsumerPatchRequest{
+		Labels: &map[string]string{"env": "staging", "region": "us"},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error patching consumer: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Eventually(func() error {
+		resources, err := resourceDao.FindByPlacementID(ctx, *placement.Id)
+		if err != nil {
+			return err
+		}
+		for _, resource := range resources {
+			deleting := !resource.DeletedAt.Time.IsZero()
+			if deleting != (resource.ConsumerName == usProd.Name) {
+				return fmt.Errorf("unexpected deletion state %t of the resource on consumer %s", deleting, resource.ConsumerName)
+			}
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	// the resource bundles are deleted with the placement
+	resp, err = client.DefaultAPI.ApiMaestroV1PlacementsIdDelete(ctx, *placement.Id).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error deleting placement: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusAccepted))
+	Eventually(func() error {
+		resource, err := resourceDao.Get(ctx, api.PlacementResourceID(*placement.Id, euProd.Name))
+		if err != nil {
+			return err
+		}
+		if resource.DeletedAt.Time.IsZero() {
+			return fmt.Errorf("the resource on consumer %s is not deleting", euProd.Name)
+		}
+		return nil
+	}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+	deleting, resp, err := client.DefaultAPI.ApiMaestroV1PlacementsIdGet(ctx, *placement.Id).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(deleting.DeletedAt).NotTo(BeNil())
+
+	// 409 to update the deleting placement
+	_, resp, err = client.DefaultAPI.ApiMaestroV1PlacementsIdPatch(ctx, *placement.Id).PlacementPatchRequest(openapi.PlacementPatchRequest{
+		ConsumerSelector: openapi.PtrString("env=dev"),
+	}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 409")
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+}
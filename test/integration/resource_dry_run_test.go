@@ -0,0 +1,85 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestResourceBundleDryRun(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	rb, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	manifest := rb.Manifests[0]
+	Expect(unstructured.SetNestedField(manifest, int64(3), "spec", "replicas")).NotTo(HaveOccurred())
+
+	// the changes of the update are returned without applying them
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{Version: 1, Manifests: []map[string]interface{}{manifest}}).
+		DryRun(true).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error patching resource bundle in a dry run: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	diff := decodeResourceBundleDiff(resp)
+	Expect(*diff.Kind).To(Equal("ResourceBundleDiff"))
+	Expect(*diff.Action).To(Equal("update"))
+	Expect(*diff.Changed).To(BeTrue())
+	Expect(diff.ValidationErrors).To(BeEmpty())
+	Expect(len(diff.Manifests)).To(Equal(1))
+	Expect(*diff.Manifests[0].Change).To(Equal("changed"))
+	Expect(diff.Manifests[0].Fields).To(ContainElement(openapi.ResourceBundleFieldDiff{
+		Path:     openapi.PtrString("spec.replicas"),
+		OldValue: openapi.PtrString("1"),
+		NewValue: openapi.PtrString("3"),
+	}))
+
+	found, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(*found.Version).To(Equal(int32(1)))
+
+	// the validation errors of an invalid manifest are returned
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		ConsumerName: openapi.PtrString(consumer.Name),
+		Manifests:    []map[string]interface{}{{"apiVersion": "v1", "metadata": map[string]interface{}{"name": "test"}}},
+	}).DryRun(true).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error posting resource bundle in a dry run: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	diff = decodeResourceBundleDiff(resp)
+	Expect(*diff.Action).To(Equal("create"))
+	Expect(len(diff.ValidationErrors)).To(Equal(1))
+	Expect(*diff.Manifests[0].Change).To(Equal("added"))
+
+	// 409 for a stale version
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{Version: 5, Manifests: []map[string]interface{}{manifest}}).
+		DryRun(true).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 409")
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+}
+
+// decodeResourceBundleDiff decodes the diff of a dry run response, the generated client decodes the response as
+// a resource bundle.
+func decodeResourceBundleDiff(resp *http.Response) *openapi.ResourceBundleDiff {
+	body, err := io.ReadAll(resp.Body)
+	Expect(err).NotTo(HaveOccurred())
+	diff := &openapi.ResourceBundleDiff{}
+	Expect(json.Unmarshal(body, diff)).NotTo(HaveOccurred())
+	return diff
+}
@@ -0,0 +1,82 @@
+package integration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestResourceBundleRevisions(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx := context.Background()
+
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRevisionsGet(ctx, "foo").Execute()
+	Expect(err).To(HaveOccurred(), "Expected 404")
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	rb, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	manifest := rb.Manifests[0]
+	Expect(unstructured.SetNestedField(manifest, int64(2), "spec", "replicas")).NotTo(HaveOccurred())
+	rb, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{Version: 1, Manifests: []map[string]interface{}{manifest}}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error patching resource bundle: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*rb.Version).To(Equal(int32(2)))
+
+	// a revision is recorded for each version, the latest revision first
+	revisions, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRevisionsGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error listing revisions: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(revisions.Kind).To(Equal("ResourceBundleRevisionList"))
+	Expect(len(revisions.Items)).To(Equal(2))
+	Expect(*revisions.Items[0].Version).To(Equal(int32(2)))
+	Expect(*revisions.Items[0].Author).To(Equal("mock"))
+	Expect(*revisions.Items[1].Version).To(Equal(int32(1)))
+	replicas, _, err := unstructured.NestedInt64(revisions.Items[1].Manifests[0], "spec", "replicas")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(replicas).To(Equal(int64(1)))
+
+	// 404 for an unknown revision
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRollbackPost(ctx, resource.ID).
+		ResourceBundleRollbackRequest(*openapi.NewResourceBundleRollbackRequest(10)).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 404")
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	// the rollback re-applies the revision as a new version
+	rb, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRollbackPost(ctx, resource.ID).
+		ResourceBundleRollbackRequest(*openapi.NewResourceBundleRollbackRequest(1)).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error rolling back resource bundle: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*rb.Version).To(Equal(int32(3)))
+	replicas, _, err = unstructured.NestedInt64(rb.Manifests[0], "spec", "replicas")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(replicas).To(Equal(int64(1)))
+
+	revisions, _, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRevisionsGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(len(revisions.Items)).To(Equal(3))
+
+	// 409 to roll back a deleting resource bundle
+	resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdDelete(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusNoContent))
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdRollbackPost(ctx, resource.ID).
+		ResourceBundleRollbackRequest(*openapi.NewResourceBundleRollbackRequest(2)).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 409")
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+}
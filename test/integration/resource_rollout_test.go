@@ -0,0 +1,110 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"gorm.io/datatypes"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestResourceBundleWait(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	// the resource bundle is pending until the agent reports its status
+	rb, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*rb.Rollout.Phase).To(Equal("Pending"))
+	Expect(*rb.Rollout.UpToDate).To(BeFalse())
+
+	// 400 for a phase that cannot be waited for
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).WaitFor("Degraded").Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).WaitFor("Available").Timeout("1h").Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// the resource bundle is returned as it is when the timeout expires
+	start := time.Now()
+	rb, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).WaitFor("Available").Timeout("1s").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*rb.Rollout.Phase).To(Equal("Pending"))
+	Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+
+	// the wait returns once the agent reports the resource bundle is available
+	type waitResult struct {
+		rb  *openapi.ResourceBundle
+		err error
+	}
+	results := make(chan waitResult, 1)
+	go func() {
+		rb, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).WaitFor("Available").Timeout("30s").Execute()
+		results <- waitResult{rb: rb, err: err}
+	}()
+
+	time.Sleep(time.Second)
+	_, _, svcErr := h.Env().Services.Resources().UpdateStatus(ctx, &api.Resource{
+		Meta:    api.Meta{ID: resource.ID},
+		Version: 1,
+		Status:  newAvailableStatus(t, resource.ID, 1),
+	})
+	Expect(svcErr).NotTo(HaveOccurred())
+	_, svcErr = h.Env().Services.StatusEvents().Create(ctx, &api.StatusEvent{
+		ResourceID:      resource.ID,
+		StatusEventType: api.StatusUpdateEventType,
+	})
+	Expect(svcErr).NotTo(HaveOccurred())
+
+	var result waitResult
+	Eventually(results, 10*time.Second).Should(Receive(&result))
+	Expect(result.err).NotTo(HaveOccurred())
+	Expect(*result.rb.Rollout.Phase).To(Equal("Available"))
+	Expect(*result.rb.Rollout.ObservedVersion).To(Equal(int32(1)))
+	Expect(*result.rb.Rollout.UpToDate).To(BeTrue())
+	Expect(len(result.rb.Rollout.Manifests)).To(Equal(1))
+	Expect(*result.rb.Rollout.Manifests[0].Kind).To(Equal("Deployment"))
+	Expect(*result.rb.Rollout.Manifests[0].Applied).To(Equal("True"))
+	Expect(*result.rb.Rollout.Manifests[0].Available).To(Equal("True"))
+
+	// an available resource bundle has been applied too, so the wait returns immediately
+	rb, _, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).WaitFor("Applied").Timeout("30s").Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(*rb.Rollout.Phase).To(Equal("Available"))
+}
+
+// newAvailableStatus returns the status of a resource bundle with an available deployment reported by the agent.
+func newAvailableStatus(t *testing.T, resourceID string, version int32) datatypes.JSONMap {
+	conditions := `[{"type":"Applied","status":"True","reason":"AppliedManifestComplete","message":"Apply manifest complete","lastTransitionTime":"2024-05-21T08:56:35Z"},` +
+		`{"type":"Available","status":"True","reason":"ResourceAvailable","message":"Resource is available","lastTransitionTime":"2024-05-21T08:56:35Z"}]`
+	status := fmt.Sprintf(`{"specversion":"1.0","id":"%s","source":"work-agent","datacontenttype":"application/json",`+
+		`"type":"io.open-cluster-management.works.v1alpha1.manifestbundles.status.update_request",`+
+		`"resourceid":"%s","resourceversion":"%d","sequenceid":"1792842398301163520",`+
+		`"data":{"conditions":%s,"resourceStatus":[{"conditions":%s,`+
+		`"resourceMeta":{"ordinal":0,"group":"apps","version":"v1","kind":"Deployment","resource":"deployments","namespace":"default","name":"nginx"}}]}}`,
+		uuid.NewString(), resourceID, version, conditions, conditions)
+
+	jsonMap := datatypes.JSONMap{}
+	if err := json.Unmarshal([]byte(status), &jsonMap); err != nil {
+		t.Fatal(err)
+	}
+	return jsonMap
+}
@@ -1,10 +1,12 @@
 package integration
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"testing"
@@ -18,6 +20,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 	workv1client "open-cluster-management.io/api/client/work/clientset/versioned/typed/work/v1"
@@ -28,7 +31,10 @@ import (
 
 	"github.com/openshift-online/maestro/cmd/maestro/server"
 	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/api/openapi"
+	"github.com/openshift-online/maestro/pkg/auth"
 	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/pkg/db"
 	"github.com/openshift-online/maestro/pkg/errors"
 	"github.com/openshift-online/maestro/pkg/services"
 	"github.com/openshift-online/maestro/test"
@@ -79,6 +85,486 @@ func TestResourceBundleGet(t *testing.T) {
 	// }
 }
 
+func TestResourceBundlePost(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	manifest := map[string]interface{}{}
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(deployName, "default", 1)), &manifest)).NotTo(HaveOccurred())
+
+	// POST responses per openapi spec: 201, 400
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		ConsumerName: &consumer.Name,
+	}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		Manifests: []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	rb, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		ConsumerName: &consumer.Name,
+		Metadata:     map[string]interface{}{"labels": map[string]interface{}{"app": "nginx"}},
+		Manifests:    []map[string]interface{}{manifest},
+		DeleteOption: map[string]interface{}{"propagationPolicy": "Orphan"},
+	}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error posting resource bundle: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	Expect(*rb.Id).NotTo(BeEmpty())
+	Expect(*rb.Name).To(Equal(*rb.Id))
+	Expect(*rb.ConsumerName).To(Equal(consumer.Name))
+	Expect(*rb.Version).To(Equal(int32(1)))
+	Expect(rb.Manifests).To(HaveLen(1))
+	Expect(rb.DeleteOption["propagationPolicy"]).To(Equal("Orphan"))
+	Expect(rb.Metadata["labels"]).To(Equal(map[string]interface{}{"app": "nginx"}))
+
+	resource, svcErr := h.Env().Services.Resources().Get(ctx, *rb.Id)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(resource.Source).To(Equal("maestro"))
+
+	// the resource bundle name must be unique
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesPost(ctx).ResourceBundle(openapi.ResourceBundle{
+		Name:         rb.Name,
+		ConsumerName: &consumer.Name,
+		Manifests:    []map[string]interface{}{manifest},
+	}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 409")
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+}
+
+func TestResourceBundlePatch(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	// PATCH responses per openapi spec: 200, 404 and 409
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, "foo").
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{Version: 1}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 404")
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, deployName, "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	rb, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+
+	// nothing is changed, the version is not increased
+	rb, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{Version: 1, Manifests: rb.Manifests}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error patching resource bundle: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*rb.Version).To(Equal(int32(1)))
+
+	manifest := rb.Manifests[0]
+	Expect(unstructured.SetNestedField(manifest, int64(2), "spec", "replicas")).NotTo(HaveOccurred())
+
+	// the version is not the latest
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+			Version:   2,
+			Manifests: []map[string]interface{}{manifest},
+		}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 409")
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+	// the version is required
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+			Manifests: []map[string]interface{}{manifest},
+		}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// the manifests must not be empty
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+			Version:   1,
+			Manifests: []map[string]interface{}{},
+		}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	rb, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+			Version:   1,
+			Manifests: []map[string]interface{}{manifest},
+		}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error patching resource bundle: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*rb.Version).To(Equal(int32(2)))
+	replicas, _, err := unstructured.NestedFieldNoCopy(rb.Manifests[0], "spec", "replicas")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(replicas).To(BeEquivalentTo(2))
+	// the fields not in the patch request are kept
+	Expect(rb.DeleteOption["propagationPolicy"]).To(Equal("Foreground"))
+	Expect(rb.ManifestConfigs).To(HaveLen(1))
+
+	// the resource bundle is under deletion
+	Expect(h.Env().Services.Resources().MarkAsDeleting(ctx, resource.ID)).NotTo(HaveOccurred())
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{
+			Version:      2,
+			DeleteOption: map[string]interface{}{"propagationPolicy": "Orphan"},
+		}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 409")
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+}
+
+func TestResourceBundleReplace(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	// PUT responses per openapi spec: 200, 400, 404 and 409
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPut(ctx, "foo").
+		ResourceBundle(openapi.ResourceBundle{
+			Version:   openapi.PtrInt32(1),
+			Manifests: []map[string]interface{}{{"apiVersion": "v1", "kind": "ConfigMap"}},
+		}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 404")
+	Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	rb, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	manifest := rb.Manifests[0]
+	Expect(unstructured.SetNestedField(manifest, int64(2), "spec", "replicas")).NotTo(HaveOccurred())
+
+	// the version is required
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPut(ctx, resource.ID).
+		ResourceBundle(openapi.ResourceBundle{Manifests: []map[string]interface{}{manifest}}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// the consumer cannot be changed
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPut(ctx, resource.ID).
+		ResourceBundle(openapi.ResourceBundle{
+			ConsumerName: openapi.PtrString("foo"),
+			Version:      openapi.PtrInt32(1),
+			Manifests:    []map[string]interface{}{manifest},
+		}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// the version is not the latest
+	_, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPut(ctx, resource.ID).
+		ResourceBundle(openapi.ResourceBundle{
+			Version:   openapi.PtrInt32(2),
+			Manifests: []map[string]interface{}{manifest},
+		}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 409")
+	Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+
+	rb, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPut(ctx, resource.ID).
+		ResourceBundle(openapi.ResourceBundle{
+			Version:   openapi.PtrInt32(1),
+			Manifests: []map[string]interface{}{manifest},
+		}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error replacing resource bundle: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(*rb.Version).To(Equal(int32(2)))
+	replicas, _, err := unstructured.NestedFieldNoCopy(rb.Manifests[0], "spec", "replicas")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(replicas).To(BeEquivalentTo(2))
+	// the fields not in the request are removed
+	Expect(rb.DeleteOption).To(BeEmpty())
+	Expect(rb.ManifestConfigs).To(BeEmpty())
+}
+
+func TestResourceBundleBatch(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	toUpdate, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+	toDelete, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	manifest := map[string]interface{}{}
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)), &manifest)).NotTo(HaveOccurred())
+	updatedManifest := map[string]interface{}{}
+	Expect(json.Unmarshal([]byte(h.NewManifestJSON(fmt.Sprintf("nginx-%s", rand.String(5)), "default", 2)), &updatedManifest)).NotTo(HaveOccurred())
+
+	// the operations must not be empty
+	_, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesBatchPost(ctx).
+		ResourceBundleBatchRequest(openapi.ResourceBundleBatchRequest{Operations: []openapi.ResourceBundleBatchOperation{}}).Execute()
+	Expect(err).To(HaveOccurred(), "Expected 400")
+	Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+
+	// the failed operation does not affect the others
+	batch, resp, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesBatchPost(ctx).
+		ResourceBundleBatchRequest(openapi.ResourceBundleBatchRequest{
+			Operations: []openapi.ResourceBundleBatchOperation{
+				{Action: "create", ResourceBundle: &openapi.ResourceBundle{
+					ConsumerName: &consumer.Name,
+					Manifests:    []map[string]interface{}{manifest},
+				}},
+				{Action: "update", Id: &toUpdate.ID, Patch: &openapi.ResourceBundlePatchRequest{
+					Version:   1,
+					Manifests: []map[string]interface{}{updatedManifest},
+				}},
+				{Action: "delete", Id: &toDelete.ID},
+				{Action: "delete", Id: openapi.PtrString("foo")},
+			},
+		}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error posting resource bundle batch: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(batch.Atomic).To(BeFalse())
+	Expect(batch.Items).To(HaveLen(4))
+	Expect(batch.Items[0].Succeeded).To(BeTrue())
+	Expect(*batch.Items[0].ResourceBundle.ConsumerName).To(Equal(consumer.Name))
+	Expect(batch.Items[1].Succeeded).To(BeTrue())
+	Expect(*batch.Items[1].ResourceBundle.Version).To(Equal(int32(2)))
+	Expect(batch.Items[2].Succeeded).To(BeTrue())
+	Expect(batch.Items[3].Succeeded).To(BeFalse())
+	Expect(*batch.Items[3].Error.Code).To(Equal("maestro-7"))
+
+	created, svcErr := h.Env().Services.Resources().Get(ctx, *batch.Items[0].Id)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(created.Version).To(Equal(int32(1)))
+	deleting, svcErr := h.Env().Services.Resources().Get(ctx, toDelete.ID)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(deleting.DeletedAt.Time.IsZero()).To(BeFalse())
+
+	// the events of the applied operations are saved
+	events, svcErr := h.Env().Services.Events().All(ctx)
+	Expect(svcErr).NotTo(HaveOccurred())
+	eventTypes := map[string][]api.EventType{}
+	for _, event := range events {
+		eventTypes[event.SourceID] = append(eventTypes[event.SourceID], event.EventType)
+	}
+	Expect(eventTypes[created.ID]).To(ContainElement(api.CreateEventType))
+	Expect(eventTypes[toUpdate.ID]).To(ContainElement(api.UpdateEventType))
+	Expect(eventTypes[toDelete.ID]).To(ContainElement(api.DeleteEventType))
+
+	// none of the operations are applied if one of them fails in the atomic mode
+	batch, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesBatchPost(ctx).
+		ResourceBundleBatchRequest(openapi.ResourceBundleBatchRequest{
+			Atomic: openapi.PtrBool(true),
+			Operations: []openapi.ResourceBundleBatchOperation{
+				{Action: "update", Id: &toUpdate.ID, Patch: &openapi.ResourceBundlePatchRequest{
+					Version:   2,
+					Manifests: []map[string]interface{}{manifest},
+				}},
+				{Action: "delete", Id: &created.ID},
+				{Action: "update", Id: &toUpdate.ID, Patch: &openapi.ResourceBundlePatchRequest{
+					Version:   1,
+					Manifests: []map[string]interface{}{updatedManifest},
+				}},
+			},
+		}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error posting resource bundle batch: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(batch.Atomic).To(BeTrue())
+	Expect(batch.Items).To(HaveLen(3))
+	for _, item := range batch.Items {
+		Expect(item.Succeeded).To(BeFalse())
+		Expect(*item.Error.Code).To(Equal("maestro-6"))
+	}
+
+	updated, svcErr := h.Env().Services.Resources().Get(ctx, toUpdate.ID)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(updated.Version).To(Equal(int32(2)))
+	created, svcErr = h.Env().Services.Resources().Get(ctx, created.ID)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(created.DeletedAt.Time.IsZero()).To(BeTrue())
+
+	// all of the operations are applied in the atomic mode
+	batch, resp, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesBatchPost(ctx).
+		ResourceBundleBatchRequest(openapi.ResourceBundleBatchRequest{
+			Atomic: openapi.PtrBool(true),
+			Operations: []openapi.ResourceBundleBatchOperation{
+				{Action: "update", Id: &toUpdate.ID, Patch: &openapi.ResourceBundlePatchRequest{
+					Version:   2,
+					Manifests: []map[string]interface{}{manifest},
+				}},
+				{Action: "delete", Id: &created.ID},
+			},
+		}).Execute()
+	Expect(err).NotTo(HaveOccurred(), "Error posting resource bundle batch: %v", err)
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	Expect(batch.Items[0].Succeeded).To(BeTrue())
+	Expect(batch.Items[1].Succeeded).To(BeTrue())
+
+	updated, svcErr = h.Env().Services.Resources().Get(ctx, toUpdate.ID)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(updated.Version).To(Equal(int32(3)))
+	created, svcErr = h.Env().Services.Resources().Get(ctx, created.ID)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(created.DeletedAt.Time.IsZero()).To(BeFalse())
+}
+
+func TestResourceBundleBatchRace(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	labelPatch := func(label string) *api.Resource {
+		return &api.Resource{
+			Meta:      api.Meta{ID: resource.ID},
+			Patch:     []byte(fmt.Sprintf(`{"metadata":{"labels":{%q:"true"}}}`, label)),
+			PatchType: api.MergePatchType,
+		}
+	}
+
+	// the batches hold the lock of the resource until they are committed, so the updates that race with them
+	// are applied to the committed versions and no update is lost.
+	const writers = 5
+	resourceService := h.Env().Services.Resources()
+	var mu sync.Mutex
+	applied := []string{}
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(2)
+		go func(label string) {
+			defer wg.Done()
+			txCtx, err := db.NewContext(ctx, h.Env().Database.SessionFactory)
+			if err != nil {
+				return
+			}
+			results, svcErr := resourceService.Batch(txCtx, []services.ResourceOperation{
+				{Type: api.UpdateEventType, Resource: labelPatch(label)},
+			}, false)
+			// a slow batch keeps its transaction open while the updates race with it
+			time.Sleep(50 * time.Millisecond)
+			db.Resolve(txCtx)
+			if svcErr == nil && results[0].Error == nil {
+				mu.Lock()
+				applied = append(applied, label)
+				mu.Unlock()
+			}
+		}(fmt.Sprintf("batch-%d", i))
+		go func(label string) {
+			defer wg.Done()
+			if _, svcErr := resourceService.Update(ctx, labelPatch(label)); svcErr == nil {
+				mu.Lock()
+				applied = append(applied, label)
+				mu.Unlock()
+			}
+		}(fmt.Sprintf("update-%d", i))
+	}
+	wg.Wait()
+
+	updated, svcErr := resourceService.Get(ctx, resource.ID)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(updated.Version).To(Equal(resource.Version + int32(len(applied))))
+	manifestBundle, err := api.DecodeManifestBundle(updated.Payload)
+	Expect(err).NotTo(HaveOccurred())
+	labels, _ := manifestBundle.Meta["labels"].(map[string]interface{})
+	for _, label := range applied {
+		Expect(labels).To(HaveKeyWithValue(label, "true"))
+	}
+}
+
+func TestResourceBundleWatch(t *testing.T) {
+	h, client := test.RegisterIntegration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	consumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	resource, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	// the resources of other consumers are not watched
+	otherConsumer, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	search := url.QueryEscape(fmt.Sprintf("consumer_name = '%s'", consumer.Name))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.RestURL("/resource-bundles?watch=true&search="+search), nil)
+	Expect(err).NotTo(HaveOccurred())
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ctx.Value(openapi.ContextAccessToken)))
+	resp, err := http.DefaultClient.Do(req)
+	Expect(err).NotTo(HaveOccurred())
+	defer resp.Body.Close()
+	Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+	type watchEvent struct {
+		Type   string                 `json:"type"`
+		Object openapi.ResourceBundle `json:"object"`
+	}
+	events := make(chan watchEvent, 10)
+	go func() {
+		defer close(events)
+		scanner := bufio.NewScanner(resp.Body)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			evt := watchEvent{}
+			if err := json.Unmarshal(scanner.Bytes(), &evt); err != nil {
+				t.Errorf("failed to unmarshal watch event: %v", err)
+				return
+			}
+			events <- evt
+		}
+	}()
+
+	expectEvent := func(eventType, resourceID string) openapi.ResourceBundle {
+		var evt watchEvent
+		Eventually(events, 10*time.Second).Should(Receive(&evt))
+		Expect(evt.Type).To(Equal(eventType))
+		Expect(*evt.Object.Id).To(Equal(resourceID))
+		return evt.Object
+	}
+
+	// the existing resource bundles are sent as ADDED events
+	expectEvent("ADDED", resource.ID)
+
+	_, err = h.CreateResource(uuid.NewString(), otherConsumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+
+	newResource, err := h.CreateResource(uuid.NewString(), consumer.Name, fmt.Sprintf("nginx-%s", rand.String(5)), "default", 1)
+	Expect(err).NotTo(HaveOccurred())
+	expectEvent("ADDED", newResource.ID)
+
+	rb, _, err := client.DefaultAPI.ApiMaestroV1ResourceBundlesIdGet(ctx, resource.ID).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	Expect(unstructured.SetNestedField(rb.Manifests[0], int64(2), "spec", "replicas")).NotTo(HaveOccurred())
+	_, _, err = client.DefaultAPI.ApiMaestroV1ResourceBundlesIdPatch(ctx, resource.ID).
+		ResourceBundlePatchRequest(openapi.ResourceBundlePatchRequest{Version: *rb.Version, Manifests: rb.Manifests}).Execute()
+	Expect(err).NotTo(HaveOccurred())
+	modified := expectEvent("MODIFIED", resource.ID)
+	Expect(*modified.Version).To(Equal(int32(2)))
+
+	// the resource is deleted after the agent confirms the deletion
+	resourceService := h.Env().Services.Resources()
+	Expect(resourceService.MarkAsDeleting(ctx, resource.ID)).NotTo(HaveOccurred())
+	deleting := expectEvent("MODIFIED", resource.ID)
+	Expect(deleting.DeletedAt).NotTo(BeNil())
+
+	Expect(resourceService.Delete(ctx, resource.ID)).NotTo(HaveOccurred())
+	_, svcErr := h.Env().Services.StatusEvents().Create(ctx, &api.StatusEvent{
+		ResourceID:      resource.ID,
+		StatusEventType: api.StatusDeleteEventType,
+	})
+	Expect(svcErr).NotTo(HaveOccurred())
+	expectEvent("DELETED", resource.ID)
+}
+
 func TestResourcePaging(t *testing.T) {
 	h, client := test.RegisterIntegration(t)
 
@@ -134,6 +620,69 @@ func TestResourceListSearch(t *testing.T) {
 	Expect(list.Total).To(Equal(int32(20)))
 }
 
+func TestResourceListScope(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	ctx := context.Background()
+
+	consumer1, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+	consumer2, err := h.CreateConsumer("cluster-" + rand.String(5))
+	Expect(err).NotTo(HaveOccurred())
+
+	resourceService := h.Env().Services.Resources()
+	deployName := fmt.Sprintf("nginx-%s", rand.String(5))
+	sources := map[string]string{}
+	for _, c := range []struct {
+		consumerName string
+		source       string
+	}{
+		{consumer1.Name, "team-a"},
+		{consumer1.Name, "team-b"},
+		{consumer2.Name, "team-a"},
+	} {
+		resource, err := h.NewResource(uuid.NewString(), c.consumerName, deployName, "default", 1, 1)
+		Expect(err).NotTo(HaveOccurred())
+		resource.Source = c.source
+		_, svcErr := resourceService.Create(ctx, resource)
+		Expect(svcErr).NotTo(HaveOccurred())
+		sources[resource.ID] = c.source
+	}
+
+	listWithScope := func(scope *auth.Scope) []api.Resource {
+		listArgs := services.NewListArguments(url.Values{})
+		listArgs.Search = fmt.Sprintf("consumer_name in ('%s', '%s')", consumer1.Name, consumer2.Name)
+		listArgs.Scope = scope
+		var resources []api.Resource
+		_, svcErr := resourceService.ListWithArgs(ctx, "", listArgs, &resources)
+		Expect(svcErr).NotTo(HaveOccurred())
+		return resources
+	}
+
+	Expect(len(listWithScope(nil))).To(Equal(3))
+
+	resources := listWithScope(&auth.Scope{Sources: []string{"team-a"}})
+	Expect(len(resources)).To(Equal(2))
+	for _, resource := range resources {
+		Expect(sources[resource.ID]).To(Equal("team-a"))
+	}
+
+	resources = listWithScope(&auth.Scope{Sources: []string{"team-a"}, Consumers: []string{consumer1.Name}})
+	Expect(len(resources)).To(Equal(1))
+	Expect(resources[0].ConsumerName).To(Equal(consumer1.Name))
+	Expect(resources[0].Source).To(Equal("team-a"))
+
+	Expect(listWithScope(&auth.Scope{Sources: []string{}, Consumers: []string{}})).To(BeEmpty())
+
+	consumers := []api.Consumer{}
+	listArgs := services.NewListArguments(url.Values{})
+	listArgs.Scope = &auth.Scope{Consumers: []string{consumer2.Name}}
+	_, svcErr := h.Env().Services.Generic().List(ctx, "", listArgs, &consumers)
+	Expect(svcErr).NotTo(HaveOccurred())
+	Expect(len(consumers)).To(Equal(1))
+	Expect(consumers[0].Name).To(Equal(consumer2.Name))
+}
+
 func TestUpdateResourceWithRacingRequests(t *testing.T) {
 	h, _ := test.RegisterIntegration(t)
 
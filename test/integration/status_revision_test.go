@@ -0,0 +1,144 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/openshift-online/maestro/pkg/api"
+	"github.com/openshift-online/maestro/pkg/dao"
+	"github.com/openshift-online/maestro/test"
+)
+
+func TestStatusRevisions(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	statusEventDao := dao.NewStatusEventDao(&h.Env().Database.SessionFactory)
+	eventInstanceDao := dao.NewEventInstanceDao(&h.Env().Database.SessionFactory)
+
+	source := "source-" + rand.String(5)
+	resourceID := uuid.NewString()
+
+	updateEvent, err := statusEventDao.Create(ctx, &api.StatusEvent{
+		ResourceID:      resourceID,
+		ResourceSource:  source,
+		StatusEventType: api.StatusUpdateEventType,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(updateEvent.Revision).To(BeNumerically(">", 0))
+
+	deleteEvent, err := statusEventDao.Create(ctx, &api.StatusEvent{
+		ResourceID:      resourceID,
+		ResourceSource:  source,
+		StatusEventType: api.StatusDeleteEventType,
+		Status:          map[string]interface{}{"id": "status"},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(deleteEvent.Revision).To(BeNumerically(">", updateEvent.Revision))
+
+	// the status event carries the revision
+	found, err := statusEventDao.Get(ctx, deleteEvent.ID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(found.Revision).To(Equal(deleteEvent.Revision))
+
+	// only the revisions of the source after the given revision are found
+	revisions, err := statusEventDao.FindRevisionsBySource(ctx, source, 0, 10)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(len(revisions)).To(Equal(2))
+	Expect(revisions[0].Revision).To(Equal(updateEvent.Revision))
+	Expect(revisions[1].Revision).To(Equal(deleteEvent.Revision))
+	Expect(revisions[1].StatusEventType).To(Equal(api.StatusDeleteEventType))
+	Expect(revisions[1].Status).To(HaveKeyWithValue("id", "status"))
+
+	revisions, err = statusEventDao.FindRevisionsBySource(ctx, source, updateEvent.Revision, 10)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(len(revisions)).To(Equal(1))
+	Expect(revisions[0].Revision).To(Equal(deleteEvent.Revision))
+
+	revisions, err = statusEventDao.FindRevisionsBySource(ctx, "source-"+rand.String(5), 0, 10)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(len(revisions)).To(Equal(0))
+
+	// the bookmark is before the status events that are not handled by the instance
+	instanceID := "instance-" + rand.String(5)
+	bookmark, err := statusEventDao.FindBookmarkRevision(ctx, instanceID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(bookmark).To(BeNumerically("<", updateEvent.Revision))
+
+	statusEvents, err := statusEventDao.All(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	for _, statusEvent := range statusEvents {
+		_, err := eventInstanceDao.Create(ctx, &api.EventInstance{
+			EventID:    statusEvent.ID,
+			InstanceID: instanceID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	bookmark, err = statusEventDao.FindBookmarkRevision(ctx, instanceID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(bookmark).To(BeNumerically(">=", deleteEvent.Revision))
+
+	// the latest revision is retained after purging
+	first, err := statusEventDao.FirstRevision(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(first).To(BeNumerically("<=", updateEvent.Revision))
+
+	Expect(statusEventDao.DeleteRevisionsBefore(ctx, time.Now().Add(time.Minute))).NotTo(HaveOccurred())
+
+	first, err = statusEventDao.FirstRevision(ctx)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(first).To(BeNumerically(">=", deleteEvent.Revision))
+}
+
+func TestStatusBookmarkWithRevisionCommittedOutOfOrder(t *testing.T) {
+	h, _ := test.RegisterIntegration(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	statusEventDao := dao.NewStatusEventDao(&h.Env().Database.SessionFactory)
+	eventInstanceDao := dao.NewEventInstanceDao(&h.Env().Database.SessionFactory)
+	instanceID := "instance-" + rand.String(5)
+	source := "source-" + rand.String(5)
+
+	// a status transaction allocates a revision but does not commit yet
+	tx := h.Env().Database.SessionFactory.New(ctx).Begin()
+	Expect(tx.Exec("SELECT pg_current_xact_id()").Error).NotTo(HaveOccurred())
+	pending := &api.StatusRevision{ResourceID: uuid.NewString(), ResourceSource: source, StatusEventType: api.StatusUpdateEventType}
+	Expect(tx.Create(pending).Error).NotTo(HaveOccurred())
+
+	// a later revision is committed and handled first
+	committed, err := statusEventDao.Create(ctx, &api.StatusEvent{
+		ResourceID:      uuid.NewString(),
+		ResourceSource:  source,
+		StatusEventType: api.StatusUpdateEventType,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(committed.Revision).To(BeNumerically(">", pending.Revision))
+	_, err = eventInstanceDao.Create(ctx, &api.EventInstance{EventID: committed.ID, InstanceID: instanceID})
+	Expect(err).NotTo(HaveOccurred())
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		tx.Create(&api.StatusEvent{
+			ResourceID:      pending.ResourceID,
+			ResourceSource:  source,
+			StatusEventType: api.StatusUpdateEventType,
+			Revision:        pending.Revision,
+		})
+		tx.Commit()
+	}()
+
+	// the bookmark waits for the pending transaction and does not pass its unhandled revision
+	bookmark, err := statusEventDao.FindBookmarkRevision(ctx, instanceID)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(bookmark).To(BeNumerically("<", pending.Revision))
+}